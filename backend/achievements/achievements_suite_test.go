@@ -0,0 +1,13 @@
+package achievements_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAchievements(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Achievements Suite")
+}
@@ -0,0 +1,149 @@
+package achievements
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type EventType string
+
+const PHRASE_ADDED EventType = "phrase.added"
+const SESSION_COMPLETED EventType = "session.completed"
+const STREAK_REACHED EventType = "streak.reached"
+
+// the facts a badge can depend on. The totals are known whatever happened,
+// the session ones only when a practice session has just finished
+const (
+	FACT_PHRASES          = "phrases"
+	FACT_SESSIONS         = "sessions"
+	FACT_CARDS            = "cards"
+	FACT_STREAK           = "streak"
+	FACT_SESSION_CARDS    = "sessionCards"
+	FACT_SESSION_ACCURACY = "sessionAccuracy"
+)
+
+var totalFacts = []string{FACT_PHRASES, FACT_SESSIONS, FACT_CARDS, FACT_STREAK}
+
+var eventFacts = map[EventType][]string{
+	PHRASE_ADDED:      totalFacts,
+	STREAK_REACHED:    totalFacts,
+	SESSION_COMPLETED: append([]string{FACT_SESSION_CARDS, FACT_SESSION_ACCURACY}, totalFacts...),
+}
+
+// DefaultRules are the badges that ship with the app. Adding one is
+// a matter of adding it to rules.json, which is compiled into the binary
+//
+//go:embed rules.json
+var DefaultRules string
+
+// Facts are what we know about a user at the moment something happens,
+// by name. Accuracy is a percentage
+type Facts map[string]uint
+
+// Event is something a user just did, with everything we knew afterwards
+type Event struct {
+	Type  EventType
+	Facts Facts
+}
+
+type Condition struct {
+	AtLeast uint `json:"atLeast"`
+}
+
+// Badge is awarded the first time its event happens with every one of
+// its conditions met, e.g. a streak.reached event with a streak of 7
+type Badge struct {
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	On          EventType            `json:"on"`
+	When        map[string]Condition `json:"when"`
+}
+
+func (badge Badge) EarnedBy(event Event) bool {
+	if event.Type != badge.On {
+		return false
+	}
+
+	for fact, condition := range badge.When {
+		if event.Facts[fact] < condition.AtLeast {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Rules are every badge there is, in the order they are shown
+type Rules struct {
+	Badges []Badge
+}
+
+// Evaluate finds the badges the event earns that haven't been awarded yet
+func (rules Rules) Evaluate(event Event, awarded map[string]bool) []Badge {
+	earned := []Badge{}
+	for _, badge := range rules.Badges {
+		if !awarded[badge.ID] && badge.EarnedBy(event) {
+			earned = append(earned, badge)
+		}
+	}
+
+	return earned
+}
+
+// ParseRules reads a JSON list of badges, checking that each one depends
+// on facts that are actually known when its event happens
+func ParseRules(value string) (Rules, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.DisallowUnknownFields()
+
+	badges := []Badge{}
+	if err := decoder.Decode(&badges); err != nil {
+		return Rules{}, fmt.Errorf("malformed achievement rules: %s", err)
+	}
+
+	seen := map[string]bool{}
+	for _, badge := range badges {
+		if badge.ID == "" {
+			return Rules{}, fmt.Errorf("achievement '%s' has no id", badge.Name)
+		}
+		if seen[badge.ID] {
+			return Rules{}, fmt.Errorf("achievement '%s' is defined twice", badge.ID)
+		}
+		seen[badge.ID] = true
+
+		facts, ok := eventFacts[badge.On]
+		if !ok {
+			return Rules{}, fmt.Errorf("achievement '%s' is on unknown event '%s'", badge.ID, badge.On)
+		}
+		if len(badge.When) == 0 {
+			return Rules{}, fmt.Errorf("achievement '%s' has no conditions", badge.ID)
+		}
+
+		names := []string{}
+		for fact := range badge.When {
+			names = append(names, fact)
+		}
+		sort.Strings(names)
+		for _, fact := range names {
+			if !contains(facts, fact) {
+				return Rules{}, fmt.Errorf("achievement '%s' depends on '%s', which isn't known on %s", badge.ID, fact, badge.On)
+			}
+		}
+	}
+
+	return Rules{Badges: badges}, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
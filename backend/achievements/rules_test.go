@@ -0,0 +1,80 @@
+package achievements_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/achievements"
+)
+
+var _ = Describe("Rules", func() {
+	It("parses the default rules", func() {
+		rules, err := ParseRules(DefaultRules)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rules.Badges).NotTo(BeEmpty())
+	})
+
+	Describe("evaluating an event", func() {
+		var rules Rules
+
+		BeforeEach(func() {
+			var err error
+			rules, err = ParseRules(`[
+				{"id": "first-session", "on": "session.completed", "when": {"sessions": {"atLeast": 1}}},
+				{"id": "sharpshooter", "on": "session.completed", "when": {"sessionCards": {"atLeast": 20}, "sessionAccuracy": {"atLeast": 90}}},
+				{"id": "streak-7", "on": "streak.reached", "when": {"streak": {"atLeast": 7}}}
+			]`)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("finds the badges whose conditions are all met", func() {
+			earned := rules.Evaluate(Event{
+				Type:  SESSION_COMPLETED,
+				Facts: Facts{FACT_SESSIONS: 3, FACT_SESSION_CARDS: 25, FACT_SESSION_ACCURACY: 92, FACT_STREAK: 9},
+			}, map[string]bool{})
+
+			ids := []string{}
+			for _, badge := range earned {
+				ids = append(ids, badge.ID)
+			}
+			Expect(ids).To(Equal([]string{"first-session", "sharpshooter"}))
+		})
+
+		It("only awards a badge once", func() {
+			earned := rules.Evaluate(Event{
+				Type:  SESSION_COMPLETED,
+				Facts: Facts{FACT_SESSIONS: 1, FACT_SESSION_CARDS: 10, FACT_SESSION_ACCURACY: 100},
+			}, map[string]bool{"first-session": true})
+
+			Expect(earned).To(BeEmpty())
+		})
+
+		It("treats facts the event doesn't have as zero", func() {
+			earned := rules.Evaluate(Event{Type: STREAK_REACHED}, map[string]bool{})
+			Expect(earned).To(BeEmpty())
+		})
+	})
+
+	It("rejects badges that could never be awarded", func() {
+		_, err := ParseRules(`[{"id": "x", "on": "phrase.deleted", "when": {"phrases": {"atLeast": 1}}}]`)
+		Expect(err).To(MatchError("achievement 'x' is on unknown event 'phrase.deleted'"))
+
+		_, err = ParseRules(`[{"id": "x", "on": "phrase.added", "when": {"sessionAccuracy": {"atLeast": 90}}}]`)
+		Expect(err).To(MatchError("achievement 'x' depends on 'sessionAccuracy', which isn't known on phrase.added"))
+
+		_, err = ParseRules(`[{"id": "x", "on": "phrase.added", "when": {}}]`)
+		Expect(err).To(MatchError("achievement 'x' has no conditions"))
+	})
+
+	It("rejects badges defined twice", func() {
+		_, err := ParseRules(`[
+			{"id": "x", "on": "phrase.added", "when": {"phrases": {"atLeast": 1}}},
+			{"id": "x", "on": "phrase.added", "when": {"phrases": {"atLeast": 2}}}
+		]`)
+		Expect(err).To(MatchError("achievement 'x' is defined twice"))
+	})
+
+	It("rejects conditions it doesn't understand", func() {
+		_, err := ParseRules(`[{"id": "x", "on": "phrase.added", "when": {"phrases": {"atMost": 1}}}]`)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AchievementTotals are the counts the achievement rules look at,
+// across both kinds of phrase
+type AchievementTotals struct {
+	Phrases       uint
+	Sessions      uint
+	CardsReviewed uint
+}
+
+type AwardedAchievement struct {
+	ID        string
+	AwardedAt time.Time
+}
+
+//go:generate counterfeiter . AchievementsRepository
+type AchievementsRepository interface {
+	TotalsForUser(context.Context, uuid.UUID) (AchievementTotals, error)
+	AchievementsForUser(context.Context, uuid.UUID) ([]AwardedAchievement, error)
+
+	// AwardAchievementsForUser ignores achievements the user already has,
+	// so two requests racing to award the same one can't both succeed
+	AwardAchievementsForUser(ctx context.Context, ids []string, userUuid uuid.UUID) error
+}
+
+func NewAchievementsRepository(db *sql.DB) AchievementsRepository {
+	return &achievementsRepo{db: db}
+}
+
+type achievementsRepo struct {
+	db *sql.DB
+}
+
+func (repo *achievementsRepo) TotalsForUser(ctx context.Context, userUuid uuid.UUID) (AchievementTotals, error) {
+	totals := AchievementTotals{}
+	err := tracedQueryRow(
+		ctx,
+		repo.db,
+		`SELECT
+			(SELECT COUNT(*) FROM phrases WHERE user_uuid = ?),
+			(SELECT COUNT(*) FROM practice_sessions WHERE user_uuid = ?),
+			(SELECT COALESCE(SUM(cards_reviewed), 0) FROM practice_sessions WHERE user_uuid = ?)`,
+		userUuid.String(),
+		userUuid.String(),
+		userUuid.String(),
+	).Scan(
+		&totals.Phrases,
+		&totals.Sessions,
+		&totals.CardsReviewed,
+	)
+
+	return totals, err
+}
+
+func (repo *achievementsRepo) AchievementsForUser(ctx context.Context, userUuid uuid.UUID) ([]AwardedAchievement, error) {
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
+		"SELECT achievement_id, awarded_at FROM user_achievements WHERE user_uuid = ? ORDER BY awarded_at, achievement_id",
+		userUuid.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []AwardedAchievement{}
+	for rows.Next() {
+		achievement := AwardedAchievement{}
+		if err := rows.Scan(&achievement.ID, &achievement.AwardedAt); err != nil {
+			return nil, err
+		}
+		results = append(results, achievement)
+	}
+
+	return results, rows.Err()
+}
+
+func (repo *achievementsRepo) AwardAchievementsForUser(ctx context.Context, ids []string, userUuid uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	args := []interface{}{}
+	for _, id := range ids {
+		args = append(args, userUuid.String(), id)
+	}
+
+	_, err := tracedExec(
+		ctx,
+		repo.db,
+		"INSERT IGNORE INTO user_achievements (user_uuid, achievement_id) VALUES "+
+			strings.TrimSuffix(strings.Repeat("(?, ?), ", len(ids)), ", "),
+		args...,
+	)
+
+	return err
+}
@@ -1,17 +1,73 @@
 package api
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
 )
 
+var ErrUserNotFound = errors.New("user not found")
+
 type PhraseCount struct {
 	UserUUID    string `json:"userUuid"`
 	PhraseCount uint   `json:"phraseCount"`
 }
 
+type User struct {
+	Uuid           string     `json:"uuid"`
+	Role           Role       `json:"role"`
+	PhraseCount    uint       `json:"phraseCount"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	LastSeenAt     time.Time  `json:"lastSeenAt"`
+	DisabledAt     *time.Time `json:"disabledAt,omitempty"`
+	DisabledReason string     `json:"disabledReason,omitempty"`
+}
+
+// AdminPhrase is a phrase as moderators see it, whichever direction it is in
+type AdminPhrase struct {
+	Uuid        string     `json:"uuid"`
+	Type        PhraseType `json:"type"`
+	Content     string     `json:"content"`
+	Translation string     `json:"translation"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+type UsersQuery struct {
+	Limit  int
+	Offset int
+}
+
+type DailyCount struct {
+	Day   string `json:"day"`
+	Count uint   `json:"count"`
+}
+
+type Stats struct {
+	TotalUsers        uint                `json:"totalUsers"`
+	DisabledUsers     uint                `json:"disabledUsers"`
+	PhrasesByType     map[PhraseType]uint `json:"phrasesByType"`
+	ActiveUsersPerDay []DailyCount        `json:"activeUsersPerDay"`
+}
+
 //go:generate counterfeiter . AdminRepository
 type AdminRepository interface {
-	PhraseCountByUserUUID() ([]PhraseCount, error)
+	PhraseCountByUserUUID(context.Context) ([]PhraseCount, error)
+	Users(context.Context, UsersQuery) ([]User, error)
+	User(context.Context, uuid.UUID) (User, error)
+	PhrasesForUser(context.Context, uuid.UUID) ([]AdminPhrase, error)
+	PatchPhrase(context.Context, PhrasePatch, uuid.UUID, uuid.UUID) (AdminPhrase, error)
+	DeletePhrase(context.Context, uuid.UUID, uuid.UUID) error
+	DeletePhrasesForUser(context.Context, uuid.UUID) (int64, error)
+	DisableUser(context.Context, uuid.UUID, string) error
+	EnableUser(context.Context, uuid.UUID) error
+	SetRole(context.Context, uuid.UUID, Role, []byte) error
+	Stats(context.Context, time.Time) (Stats, error)
 }
 
 func NewAdminRepository(db *sql.DB) AdminRepository {
@@ -22,8 +78,10 @@ type adminRepo struct {
 	db *sql.DB
 }
 
-func (repo *adminRepo) PhraseCountByUserUUID() ([]PhraseCount, error) {
-	rows, err := repo.db.Query(
+func (repo *adminRepo) PhraseCountByUserUUID(ctx context.Context) ([]PhraseCount, error) {
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
 		"SELECT user_uuid, count(phrase) FROM phrases GROUP BY user_uuid;",
 	)
 	if err != nil {
@@ -46,3 +104,424 @@ func (repo *adminRepo) PhraseCountByUserUUID() ([]PhraseCount, error) {
 
 	return results, nil
 }
+
+const selectUsers = `SELECT users.uuid, users.role, COUNT(phrases.uuid), users.created_at, users.last_seen_at, users.disabled_at, COALESCE(users.disabled_reason, '')
+FROM users LEFT JOIN phrases ON phrases.user_uuid = users.uuid`
+
+// Users lists everyone, most recently active first
+func (repo *adminRepo) Users(ctx context.Context, query UsersQuery) ([]User, error) {
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
+		selectUsers+" GROUP BY users.uuid ORDER BY users.last_seen_at DESC, users.uuid LIMIT ? OFFSET ?",
+		query.Limit,
+		query.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []User{}
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, user)
+	}
+
+	return results, rows.Err()
+}
+
+func (repo *adminRepo) User(ctx context.Context, userUuid uuid.UUID) (User, error) {
+	user, err := scanUser(tracedQueryRow(
+		ctx,
+		repo.db,
+		selectUsers+" WHERE users.uuid = ? GROUP BY users.uuid",
+		userUuid.String(),
+	))
+	if err == sql.ErrNoRows {
+		return User{}, ErrUserNotFound
+	}
+
+	return user, err
+}
+
+type scanner interface {
+	Scan(...interface{}) error
+}
+
+func scanUser(row scanner) (User, error) {
+	user := User{}
+	var disabledAt sql.NullTime
+	err := row.Scan(
+		&user.Uuid,
+		&user.Role,
+		&user.PhraseCount,
+		&user.CreatedAt,
+		&user.LastSeenAt,
+		&disabledAt,
+		&user.DisabledReason,
+	)
+	if disabledAt.Valid {
+		user.DisabledAt = &disabledAt.Time
+	}
+
+	return user, err
+}
+
+const selectAdminPhrases = "SELECT uuid, phrase_type, phrase, translation, created_at, updated_at FROM phrases"
+
+func (repo *adminRepo) PhrasesForUser(ctx context.Context, userUuid uuid.UUID) ([]AdminPhrase, error) {
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
+		selectAdminPhrases+" WHERE user_uuid = ? ORDER BY created_at, uuid",
+		userUuid.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []AdminPhrase{}
+	for rows.Next() {
+		phrase, err := scanAdminPhrase(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, phrase)
+	}
+
+	return results, rows.Err()
+}
+
+func scanAdminPhrase(row scanner) (AdminPhrase, error) {
+	phrase := AdminPhrase{}
+	err := row.Scan(
+		&phrase.Uuid,
+		&phrase.Type,
+		&phrase.Content,
+		&phrase.Translation,
+		&phrase.CreatedAt,
+		&phrase.UpdatedAt,
+	)
+
+	return phrase, err
+}
+
+func (repo *adminRepo) PatchPhrase(ctx context.Context, patch PhrasePatch, phraseUuid uuid.UUID, userUuid uuid.UUID) (AdminPhrase, error) {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return AdminPhrase{}, err
+	}
+	defer tx.Rollback()
+
+	before, err := snapshotPhrase(ctx, tx, phraseUuid.String(), userUuid.String())
+	if err != nil {
+		return AdminPhrase{}, err
+	}
+	if before == nil {
+		return AdminPhrase{}, ErrPhraseNotFound
+	}
+
+	assignments := []string{}
+	args := []interface{}{}
+	if patch.Content != nil {
+		assignments = append(assignments, "phrase = ?", "normalized_phrase = ?", "duplicate_key = NULL")
+		args = append(args, *patch.Content, NormalizePhrase(*patch.Content))
+	}
+	if patch.Translation != nil {
+		assignments = append(assignments, "translation = ?")
+		args = append(args, *patch.Translation)
+	}
+
+	if len(assignments) > 0 {
+		args = append(args, phraseUuid.String(), userUuid.String())
+		_, err = tracedExec(
+			ctx,
+			tx,
+			fmt.Sprintf("UPDATE phrases SET %s WHERE uuid = ? AND user_uuid = ?", strings.Join(assignments, ", ")),
+			args...,
+		)
+		if err != nil {
+			return AdminPhrase{}, err
+		}
+	}
+	if patch.Content != nil {
+		err = claimDuplicateKeyIfFree(ctx, tx, phraseUuid.String())
+		if err != nil {
+			return AdminPhrase{}, err
+		}
+	}
+
+	phrase, err := scanAdminPhrase(tracedQueryRow(
+		ctx,
+		tx,
+		selectAdminPhrases+" WHERE uuid = ? AND user_uuid = ?",
+		phraseUuid.String(),
+		userUuid.String(),
+	))
+	if err == sql.ErrNoRows {
+		return AdminPhrase{}, ErrPhraseNotFound
+	}
+	if err != nil {
+		return AdminPhrase{}, err
+	}
+
+	if len(assignments) > 0 {
+		after := phraseSnapshot{Type: phrase.Type, Content: phrase.Content, Translation: phrase.Translation}
+		err = recordAudit(ctx, tx, auditRecord{
+			action:     AUDIT_PHRASE_UPDATE,
+			userUuid:   userUuid.String(),
+			phraseUuid: phraseUuid.String(),
+			before:     before,
+			after:      after,
+		})
+		if err != nil {
+			return AdminPhrase{}, err
+		}
+		err = recordChangedRevision(ctx, tx, phraseUuid.String(), userUuid.String(), before, after)
+		if err != nil {
+			return AdminPhrase{}, err
+		}
+	}
+
+	return phrase, tx.Commit()
+}
+
+func (repo *adminRepo) DeletePhrase(ctx context.Context, phraseUuid uuid.UUID, userUuid uuid.UUID) error {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := snapshotPhrase(ctx, tx, phraseUuid.String(), userUuid.String())
+	if err != nil {
+		return err
+	}
+	if before == nil {
+		return ErrPhraseNotFound
+	}
+
+	_, err = tracedExec(
+		ctx,
+		tx,
+		"DELETE FROM phrases WHERE uuid = ? AND user_uuid = ?",
+		phraseUuid.String(),
+		userUuid.String(),
+	)
+	if err != nil {
+		return err
+	}
+	err = deletePhraseHistory(ctx, tx, userUuid.String(), []string{phraseUuid.String()})
+	if err != nil {
+		return err
+	}
+
+	err = recordAudit(ctx, tx, auditRecord{
+		action:     AUDIT_PHRASE_DELETE,
+		userUuid:   userUuid.String(),
+		phraseUuid: phraseUuid.String(),
+		before:     before,
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeletePhrasesForUser leaves an entry in the audit log for every phrase it deletes,
+// and takes their revisions and examples with them
+func (repo *adminRepo) DeletePhrasesForUser(ctx context.Context, userUuid uuid.UUID) (int64, error) {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tracedQuery(
+		ctx,
+		tx,
+		"SELECT uuid, phrase_type, phrase, translation FROM phrases WHERE user_uuid = ? FOR UPDATE",
+		userUuid.String(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	records := []auditRecord{}
+	for rows.Next() {
+		record := auditRecord{action: AUDIT_PHRASE_DELETE, userUuid: userUuid.String()}
+		snapshot := phraseSnapshot{}
+		if err := rows.Scan(&record.phraseUuid, &snapshot.Type, &snapshot.Content, &snapshot.Translation); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		record.before = snapshot
+		records = append(records, record)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	_, err = tracedExec(ctx, tx, "DELETE FROM phrases WHERE user_uuid = ?", userUuid.String())
+	if err != nil {
+		return 0, err
+	}
+	deleted := []string{}
+	for _, record := range records {
+		deleted = append(deleted, record.phraseUuid)
+	}
+	if err = deletePhraseHistory(ctx, tx, userUuid.String(), deleted); err != nil {
+		return 0, err
+	}
+
+	for _, record := range records {
+		if err := recordAudit(ctx, tx, record); err != nil {
+			return 0, err
+		}
+	}
+
+	return int64(len(records)), tx.Commit()
+}
+
+// DisableUser works on users who haven't been seen yet too,
+// and keeps the original date when the user is already disabled
+func (repo *adminRepo) DisableUser(ctx context.Context, userUuid uuid.UUID, reason string) error {
+	return repo.changeAccount(
+		ctx,
+		userUuid,
+		AUDIT_USER_DISABLE,
+		`INSERT INTO users (uuid, disabled_at, disabled_reason) VALUES (?, CURRENT_TIMESTAMP(6), ?)
+		ON DUPLICATE KEY UPDATE disabled_at = COALESCE(disabled_at, VALUES(disabled_at)), disabled_reason = VALUES(disabled_reason)`,
+		userUuid.String(),
+		reason,
+	)
+}
+
+func (repo *adminRepo) EnableUser(ctx context.Context, userUuid uuid.UUID) error {
+	return repo.changeAccount(
+		ctx,
+		userUuid,
+		AUDIT_USER_ENABLE,
+		"UPDATE users SET disabled_at = NULL, disabled_reason = NULL WHERE uuid = ?",
+		userUuid.String(),
+	)
+}
+
+// SetRole replaces the user's staff key hash along with their role,
+// so a nil hash leaves them without one
+func (repo *adminRepo) SetRole(ctx context.Context, userUuid uuid.UUID, role Role, keyHash []byte) error {
+	return repo.changeAccount(
+		ctx,
+		userUuid,
+		AUDIT_USER_ROLE,
+		"INSERT INTO users (uuid, role, staff_key_hash) VALUES (?, ?, ?) "+
+			"ON DUPLICATE KEY UPDATE role = VALUES(role), staff_key_hash = VALUES(staff_key_hash)",
+		userUuid.String(),
+		string(role),
+		keyHash,
+	)
+}
+
+func (repo *adminRepo) changeAccount(ctx context.Context, userUuid uuid.UUID, action AuditAction, statement string, args ...interface{}) error {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	before, err := snapshotAccount(ctx, tx, userUuid.String())
+	if err != nil {
+		return err
+	}
+
+	if _, err = tracedExec(ctx, tx, statement, args...); err != nil {
+		return err
+	}
+
+	after, err := snapshotAccount(ctx, tx, userUuid.String())
+	if err != nil {
+		return err
+	}
+
+	record := auditRecord{action: action, userUuid: userUuid.String()}
+	// leave nil snapshots out entirely, rather than logging "null" as JSON
+	if before != nil {
+		record.before = before
+	}
+	if after != nil {
+		record.after = after
+	}
+	if err = recordAudit(ctx, tx, record); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Stats counts active users per day from since onwards
+func (repo *adminRepo) Stats(ctx context.Context, since time.Time) (Stats, error) {
+	stats := Stats{
+		PhrasesByType: map[PhraseType]uint{
+			FRENCH_TO_ENGLISH: 0,
+			ENGLISH_TO_FRENCH: 0,
+		},
+		ActiveUsersPerDay: []DailyCount{},
+	}
+
+	err := tracedQueryRow(
+		ctx,
+		repo.db,
+		"SELECT COUNT(*), COUNT(disabled_at) FROM users",
+	).Scan(&stats.TotalUsers, &stats.DisabledUsers)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	rows, err := tracedQuery(ctx, repo.db, "SELECT phrase_type, COUNT(*) FROM phrases GROUP BY phrase_type")
+	if err != nil {
+		return Stats{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var phraseType PhraseType
+		var count uint
+		if err := rows.Scan(&phraseType, &count); err != nil {
+			return Stats{}, err
+		}
+		stats.PhrasesByType[phraseType] = count
+	}
+	if err := rows.Err(); err != nil {
+		return Stats{}, err
+	}
+
+	activity, err := tracedQuery(
+		ctx,
+		repo.db,
+		"SELECT CAST(day AS CHAR), COUNT(*) FROM user_activity WHERE day >= ? GROUP BY day ORDER BY day",
+		since.Format("2006-01-02"),
+	)
+	if err != nil {
+		return Stats{}, err
+	}
+	defer activity.Close()
+
+	for activity.Next() {
+		day := DailyCount{}
+		if err := activity.Scan(&day.Day, &day.Count); err != nil {
+			return Stats{}, err
+		}
+		stats.ActiveUsersPerDay = append(stats.ActiveUsersPerDay, day)
+	}
+
+	return stats, activity.Err()
+}
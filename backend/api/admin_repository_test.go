@@ -0,0 +1,112 @@
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+var _ = Describe("AdminRepository", func() {
+	var db *sql.DB
+	var mock sqlmock.Sqlmock
+	var subject AdminRepository
+
+	userUuid := uuid.Must(uuid.Parse("f2f282d9-f738-463c-ab2d-27fcb5645bca"))
+	phraseUuid := uuid.Must(uuid.Parse("f56b84af-7b95-40ff-b360-888169fb7f12"))
+	otherPhraseUuid := uuid.Must(uuid.Parse("67d6547d-99ac-4053-8713-e63410af9dc1"))
+
+	BeforeEach(func() {
+		var err error
+		db, mock, err = sqlmock.New()
+		Expect(err).NotTo(HaveOccurred())
+		subject = NewAdminRepository(db)
+	})
+
+	AfterEach(func() {
+		Expect(mock.ExpectationsWereMet()).To(Succeed())
+		db.Close()
+	})
+
+	Describe("DeletePhrase", func() {
+		expectSnapshot := func() {
+			mock.ExpectQuery("SELECT phrase_type, phrase, translation FROM phrases WHERE uuid = \\? AND user_uuid = \\? FOR UPDATE").
+				WithArgs(phraseUuid.String(), userUuid.String()).
+				WillReturnRows(sqlmock.NewRows([]string{"phrase_type", "phrase", "translation"}).
+					AddRow(string(FRENCH_TO_ENGLISH), "le chat", "the cat"))
+			mock.ExpectExec("DELETE FROM phrases WHERE uuid = \\? AND user_uuid = \\?").
+				WithArgs(phraseUuid.String(), userUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+
+		It("deletes the phrase's revisions and examples in the same transaction", func() {
+			mock.ExpectBegin()
+			expectSnapshot()
+			mock.ExpectExec("DELETE FROM phrase_revisions WHERE user_uuid = \\? AND phrase_uuid IN \\(\\?\\)").
+				WithArgs(userUuid.String(), phraseUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectExec("DELETE FROM phrase_examples WHERE user_uuid = \\? AND phrase_uuid IN \\(\\?\\)").
+				WithArgs(userUuid.String(), phraseUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("INSERT INTO audit_log").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			Expect(subject.DeletePhrase(context.Background(), phraseUuid, userUuid)).To(Succeed())
+		})
+
+		It("keeps the phrase when its history can't be deleted", func() {
+			mock.ExpectBegin()
+			expectSnapshot()
+			mock.ExpectExec("DELETE FROM phrase_revisions").WillReturnError(errors.New("lock wait timeout"))
+			mock.ExpectRollback()
+
+			err := subject.DeletePhrase(context.Background(), phraseUuid, userUuid)
+			Expect(err).To(MatchError("lock wait timeout"))
+		})
+	})
+
+	Describe("DeletePhrasesForUser", func() {
+		It("deletes the revisions and examples of every phrase it deletes", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT uuid, phrase_type, phrase, translation FROM phrases WHERE user_uuid = \\? FOR UPDATE").
+				WithArgs(userUuid.String()).
+				WillReturnRows(sqlmock.NewRows([]string{"uuid", "phrase_type", "phrase", "translation"}).
+					AddRow(phraseUuid.String(), string(FRENCH_TO_ENGLISH), "le chat", "the cat").
+					AddRow(otherPhraseUuid.String(), string(ENGLISH_TO_FRENCH), "the dog", "le chien"))
+			mock.ExpectExec("DELETE FROM phrases WHERE user_uuid = \\?").
+				WithArgs(userUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectExec("DELETE FROM phrase_revisions WHERE user_uuid = \\? AND phrase_uuid IN \\(\\?, \\?\\)").
+				WithArgs(userUuid.String(), phraseUuid.String(), otherPhraseUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 4))
+			mock.ExpectExec("DELETE FROM phrase_examples WHERE user_uuid = \\? AND phrase_uuid IN \\(\\?, \\?\\)").
+				WithArgs(userUuid.String(), phraseUuid.String(), otherPhraseUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec("INSERT INTO audit_log").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("INSERT INTO audit_log").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			deleted, err := subject.DeletePhrasesForUser(context.Background(), userUuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(Equal(int64(2)))
+		})
+
+		It("has nothing else to delete when the user has no phrases", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT uuid, phrase_type, phrase, translation FROM phrases").
+				WillReturnRows(sqlmock.NewRows([]string{"uuid", "phrase_type", "phrase", "translation"}))
+			mock.ExpectExec("DELETE FROM phrases WHERE user_uuid = \\?").WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectCommit()
+
+			deleted, err := subject.DeletePhrasesForUser(context.Background(), userUuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeZero())
+		})
+	})
+})
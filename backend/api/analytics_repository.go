@@ -0,0 +1,238 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type Granularity string
+
+const GRANULARITY_DAY Granularity = "day"
+const GRANULARITY_WEEK Granularity = "week"
+const GRANULARITY_MONTH Granularity = "month"
+
+// periodStarts turn a date or datetime column into the first day of its
+// period, the same way Granularity.periodStart does. Weeks start on Monday
+var periodStarts = map[Granularity]string{
+	GRANULARITY_DAY:   "DATE(%s)",
+	GRANULARITY_WEEK:  "DATE_SUB(DATE(%[1]s), INTERVAL WEEKDAY(%[1]s) DAY)",
+	GRANULARITY_MONTH: "DATE_FORMAT(%s, '%%Y-%%m-01')",
+}
+
+func ParseGranularity(value string) (Granularity, error) {
+	granularity := Granularity(value)
+	if _, ok := periodStarts[granularity]; !ok {
+		return "", fmt.Errorf("granularity must be one of day, week or month")
+	}
+
+	return granularity, nil
+}
+
+func (granularity Granularity) periodStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch granularity {
+	case GRANULARITY_WEEK:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case GRANULARITY_MONTH:
+		return day.AddDate(0, 0, 1-day.Day())
+	default:
+		return day
+	}
+}
+
+func (granularity Granularity) next(periodStart time.Time) time.Time {
+	switch granularity {
+	case GRANULARITY_WEEK:
+		return periodStart.AddDate(0, 0, 7)
+	case GRANULARITY_MONTH:
+		return periodStart.AddDate(0, 1, 0)
+	default:
+		return periodStart.AddDate(0, 0, 1)
+	}
+}
+
+// SeriesQuery covers the days From to To, both included. The range is
+// widened to whole periods, so the first and last weeks or months
+// aren't cut short
+type SeriesQuery struct {
+	From        time.Time
+	To          time.Time
+	Granularity Granularity
+}
+
+// SeriesPoint counts something over the period starting on Period.
+// Periods where nothing happened are there with a zero count
+type SeriesPoint struct {
+	Period string `json:"period"`
+	Count  uint   `json:"count"`
+}
+
+// RetentionCohort is everyone who signed up in the week starting on Week.
+// Active[i] is how many of them were active i weeks later,
+// so Active[0] is the week they signed up
+type RetentionCohort struct {
+	Week   string `json:"week"`
+	Size   uint   `json:"size"`
+	Active []uint `json:"active"`
+}
+
+//go:generate counterfeiter . AnalyticsRepository
+type AnalyticsRepository interface {
+	NewPhrases(context.Context, SeriesQuery) ([]SeriesPoint, error)
+	ActiveUsers(context.Context, SeriesQuery) ([]SeriesPoint, error)
+	PracticeSessions(context.Context, SeriesQuery) ([]SeriesPoint, error)
+	RetentionCohorts(ctx context.Context, from time.Time, to time.Time) ([]RetentionCohort, error)
+}
+
+func NewAnalyticsRepository(db *sql.DB) AnalyticsRepository {
+	return &analyticsRepo{db: db}
+}
+
+type analyticsRepo struct {
+	db *sql.DB
+}
+
+// phrases that have since been deleted or merged away don't count
+func (repo *analyticsRepo) NewPhrases(ctx context.Context, query SeriesQuery) ([]SeriesPoint, error) {
+	return repo.series(ctx, query, "COUNT(*)", "phrases", "created_at")
+}
+
+func (repo *analyticsRepo) ActiveUsers(ctx context.Context, query SeriesQuery) ([]SeriesPoint, error) {
+	return repo.series(ctx, query, "COUNT(DISTINCT user_uuid)", "user_activity", "day")
+}
+
+func (repo *analyticsRepo) PracticeSessions(ctx context.Context, query SeriesQuery) ([]SeriesPoint, error) {
+	return repo.series(ctx, query, "COUNT(*)", "practice_sessions", "finished_at")
+}
+
+func (repo *analyticsRepo) series(ctx context.Context, query SeriesQuery, aggregate string, table string, column string) ([]SeriesPoint, error) {
+	periodStart, ok := periodStarts[query.Granularity]
+	if !ok {
+		return nil, fmt.Errorf("unknown granularity '%s'", query.Granularity)
+	}
+
+	from := query.Granularity.periodStart(query.From)
+	until := query.Granularity.next(query.Granularity.periodStart(query.To))
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
+		fmt.Sprintf(
+			"SELECT CAST(%s AS CHAR), %s FROM %s WHERE %s >= ? AND %s < ? GROUP BY 1",
+			fmt.Sprintf(periodStart, column),
+			aggregate,
+			table,
+			column,
+			column,
+		),
+		from.Format("2006-01-02"),
+		until.Format("2006-01-02"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	counts := map[string]uint{}
+	for rows.Next() {
+		var period string
+		var count uint
+		if err := rows.Scan(&period, &count); err != nil {
+			return nil, err
+		}
+		counts[period] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	points := []SeriesPoint{}
+	for period := from; period.Before(until); period = query.Granularity.next(period) {
+		day := period.Format("2006-01-02")
+		points = append(points, SeriesPoint{Period: day, Count: counts[day]})
+	}
+
+	return points, nil
+}
+
+// RetentionCohorts follows the users who signed up between from and to,
+// one cohort per week, up to and including the week of to
+func (repo *analyticsRepo) RetentionCohorts(ctx context.Context, from time.Time, to time.Time) ([]RetentionCohort, error) {
+	start := GRANULARITY_WEEK.periodStart(from)
+	until := GRANULARITY_WEEK.next(GRANULARITY_WEEK.periodStart(to))
+	cohort := fmt.Sprintf(periodStarts[GRANULARITY_WEEK], "created_at")
+
+	sizes, err := tracedQuery(
+		ctx,
+		repo.db,
+		"SELECT CAST("+cohort+" AS CHAR), COUNT(*) FROM users WHERE created_at >= ? AND created_at < ? GROUP BY 1",
+		start.Format("2006-01-02"),
+		until.Format("2006-01-02"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer sizes.Close()
+
+	cohorts := map[string]*RetentionCohort{}
+	results := []RetentionCohort{}
+	for week := start; week.Before(until); week = GRANULARITY_WEEK.next(week) {
+		weeks := int(until.Sub(week).Hours()/24) / 7
+		results = append(results, RetentionCohort{
+			Week:   week.Format("2006-01-02"),
+			Active: make([]uint, weeks),
+		})
+	}
+	for i := range results {
+		cohorts[results[i].Week] = &results[i]
+	}
+
+	for sizes.Next() {
+		var week string
+		var size uint
+		if err := sizes.Scan(&week, &size); err != nil {
+			return nil, err
+		}
+		if c, ok := cohorts[week]; ok {
+			c.Size = size
+		}
+	}
+	if err := sizes.Err(); err != nil {
+		return nil, err
+	}
+
+	activity, err := tracedQuery(
+		ctx,
+		repo.db,
+		`SELECT CAST(cohorts.week AS CHAR), DATEDIFF(user_activity.day, cohorts.week) DIV 7, COUNT(DISTINCT cohorts.uuid)
+		FROM (SELECT uuid, `+cohort+` AS week FROM users WHERE created_at >= ? AND created_at < ?) cohorts
+		JOIN user_activity ON user_activity.user_uuid = cohorts.uuid
+		WHERE user_activity.day >= cohorts.week AND user_activity.day < ?
+		GROUP BY 1, 2`,
+		start.Format("2006-01-02"),
+		until.Format("2006-01-02"),
+		until.Format("2006-01-02"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer activity.Close()
+
+	for activity.Next() {
+		var week string
+		var offset int
+		var count uint
+		if err := activity.Scan(&week, &offset, &count); err != nil {
+			return nil, err
+		}
+		if c, ok := cohorts[week]; ok && offset >= 0 && offset < len(c.Active) {
+			c.Active[offset] = count
+		}
+	}
+
+	return results, activity.Err()
+}
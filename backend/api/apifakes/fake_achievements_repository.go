@@ -0,0 +1,236 @@
+// This file was generated by counterfeiter
+package apifakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+type FakeAchievementsRepository struct {
+	TotalsForUserStub        func(context.Context, uuid.UUID) (api.AchievementTotals, error)
+	totalsForUserMutex       sync.RWMutex
+	totalsForUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	totalsForUserReturns struct {
+		result1 api.AchievementTotals
+		result2 error
+	}
+	totalsForUserReturnsOnCall map[int]struct {
+		result1 api.AchievementTotals
+		result2 error
+	}
+	AchievementsForUserStub        func(context.Context, uuid.UUID) ([]api.AwardedAchievement, error)
+	achievementsForUserMutex       sync.RWMutex
+	achievementsForUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	achievementsForUserReturns struct {
+		result1 []api.AwardedAchievement
+		result2 error
+	}
+	achievementsForUserReturnsOnCall map[int]struct {
+		result1 []api.AwardedAchievement
+		result2 error
+	}
+	AwardAchievementsForUserStub        func(context.Context, []string, uuid.UUID) error
+	awardAchievementsForUserMutex       sync.RWMutex
+	awardAchievementsForUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 uuid.UUID
+	}
+	awardAchievementsForUserReturns struct {
+		result1 error
+	}
+	awardAchievementsForUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAchievementsRepository) TotalsForUser(arg1 context.Context, arg2 uuid.UUID) (api.AchievementTotals, error) {
+	fake.totalsForUserMutex.Lock()
+	ret, specificReturn := fake.totalsForUserReturnsOnCall[len(fake.totalsForUserArgsForCall)]
+	fake.totalsForUserArgsForCall = append(fake.totalsForUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("TotalsForUser", []interface{}{arg1, arg2})
+	fake.totalsForUserMutex.Unlock()
+	if fake.TotalsForUserStub != nil {
+		return fake.TotalsForUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.totalsForUserReturns.result1, fake.totalsForUserReturns.result2
+}
+
+func (fake *FakeAchievementsRepository) TotalsForUserCallCount() int {
+	fake.totalsForUserMutex.RLock()
+	defer fake.totalsForUserMutex.RUnlock()
+	return len(fake.totalsForUserArgsForCall)
+}
+
+func (fake *FakeAchievementsRepository) TotalsForUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.totalsForUserMutex.RLock()
+	defer fake.totalsForUserMutex.RUnlock()
+	return fake.totalsForUserArgsForCall[i].arg1, fake.totalsForUserArgsForCall[i].arg2
+}
+
+func (fake *FakeAchievementsRepository) TotalsForUserReturns(result1 api.AchievementTotals, result2 error) {
+	fake.TotalsForUserStub = nil
+	fake.totalsForUserReturns = struct {
+		result1 api.AchievementTotals
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAchievementsRepository) TotalsForUserReturnsOnCall(i int, result1 api.AchievementTotals, result2 error) {
+	fake.TotalsForUserStub = nil
+	if fake.totalsForUserReturnsOnCall == nil {
+		fake.totalsForUserReturnsOnCall = make(map[int]struct {
+			result1 api.AchievementTotals
+			result2 error
+		})
+	}
+	fake.totalsForUserReturnsOnCall[i] = struct {
+		result1 api.AchievementTotals
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAchievementsRepository) AchievementsForUser(arg1 context.Context, arg2 uuid.UUID) ([]api.AwardedAchievement, error) {
+	fake.achievementsForUserMutex.Lock()
+	ret, specificReturn := fake.achievementsForUserReturnsOnCall[len(fake.achievementsForUserArgsForCall)]
+	fake.achievementsForUserArgsForCall = append(fake.achievementsForUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("AchievementsForUser", []interface{}{arg1, arg2})
+	fake.achievementsForUserMutex.Unlock()
+	if fake.AchievementsForUserStub != nil {
+		return fake.AchievementsForUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.achievementsForUserReturns.result1, fake.achievementsForUserReturns.result2
+}
+
+func (fake *FakeAchievementsRepository) AchievementsForUserCallCount() int {
+	fake.achievementsForUserMutex.RLock()
+	defer fake.achievementsForUserMutex.RUnlock()
+	return len(fake.achievementsForUserArgsForCall)
+}
+
+func (fake *FakeAchievementsRepository) AchievementsForUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.achievementsForUserMutex.RLock()
+	defer fake.achievementsForUserMutex.RUnlock()
+	return fake.achievementsForUserArgsForCall[i].arg1, fake.achievementsForUserArgsForCall[i].arg2
+}
+
+func (fake *FakeAchievementsRepository) AchievementsForUserReturns(result1 []api.AwardedAchievement, result2 error) {
+	fake.AchievementsForUserStub = nil
+	fake.achievementsForUserReturns = struct {
+		result1 []api.AwardedAchievement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAchievementsRepository) AchievementsForUserReturnsOnCall(i int, result1 []api.AwardedAchievement, result2 error) {
+	fake.AchievementsForUserStub = nil
+	if fake.achievementsForUserReturnsOnCall == nil {
+		fake.achievementsForUserReturnsOnCall = make(map[int]struct {
+			result1 []api.AwardedAchievement
+			result2 error
+		})
+	}
+	fake.achievementsForUserReturnsOnCall[i] = struct {
+		result1 []api.AwardedAchievement
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAchievementsRepository) AwardAchievementsForUser(arg1 context.Context, arg2 []string, arg3 uuid.UUID) error {
+	fake.awardAchievementsForUserMutex.Lock()
+	ret, specificReturn := fake.awardAchievementsForUserReturnsOnCall[len(fake.awardAchievementsForUserArgsForCall)]
+	fake.awardAchievementsForUserArgsForCall = append(fake.awardAchievementsForUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AwardAchievementsForUser", []interface{}{arg1, arg2, arg3})
+	fake.awardAchievementsForUserMutex.Unlock()
+	if fake.AwardAchievementsForUserStub != nil {
+		return fake.AwardAchievementsForUserStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.awardAchievementsForUserReturns.result1
+}
+
+func (fake *FakeAchievementsRepository) AwardAchievementsForUserCallCount() int {
+	fake.awardAchievementsForUserMutex.RLock()
+	defer fake.awardAchievementsForUserMutex.RUnlock()
+	return len(fake.awardAchievementsForUserArgsForCall)
+}
+
+func (fake *FakeAchievementsRepository) AwardAchievementsForUserArgsForCall(i int) (context.Context, []string, uuid.UUID) {
+	fake.awardAchievementsForUserMutex.RLock()
+	defer fake.awardAchievementsForUserMutex.RUnlock()
+	return fake.awardAchievementsForUserArgsForCall[i].arg1, fake.awardAchievementsForUserArgsForCall[i].arg2, fake.awardAchievementsForUserArgsForCall[i].arg3
+}
+
+func (fake *FakeAchievementsRepository) AwardAchievementsForUserReturns(result1 error) {
+	fake.AwardAchievementsForUserStub = nil
+	fake.awardAchievementsForUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAchievementsRepository) AwardAchievementsForUserReturnsOnCall(i int, result1 error) {
+	fake.AwardAchievementsForUserStub = nil
+	if fake.awardAchievementsForUserReturnsOnCall == nil {
+		fake.awardAchievementsForUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.awardAchievementsForUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAchievementsRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.totalsForUserMutex.RLock()
+	defer fake.totalsForUserMutex.RUnlock()
+	fake.achievementsForUserMutex.RLock()
+	defer fake.achievementsForUserMutex.RUnlock()
+	fake.awardAchievementsForUserMutex.RLock()
+	defer fake.awardAchievementsForUserMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAchievementsRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ api.AchievementsRepository = new(FakeAchievementsRepository)
@@ -2,16 +2,21 @@
 package apifakes
 
 import (
+	"context"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/tjarratt/doit-etre-rad/backend/api"
 )
 
 type FakeAdminRepository struct {
-	PhraseCountByUserUUIDStub        func() ([]api.PhraseCount, error)
+	PhraseCountByUserUUIDStub        func(context.Context) ([]api.PhraseCount, error)
 	phraseCountByUserUUIDMutex       sync.RWMutex
-	phraseCountByUserUUIDArgsForCall []struct{}
-	phraseCountByUserUUIDReturns     struct {
+	phraseCountByUserUUIDArgsForCall []struct {
+		arg1 context.Context
+	}
+	phraseCountByUserUUIDReturns struct {
 		result1 []api.PhraseCount
 		result2 error
 	}
@@ -19,18 +24,158 @@ type FakeAdminRepository struct {
 		result1 []api.PhraseCount
 		result2 error
 	}
+	UsersStub        func(context.Context, api.UsersQuery) ([]api.User, error)
+	usersMutex       sync.RWMutex
+	usersArgsForCall []struct {
+		arg1 context.Context
+		arg2 api.UsersQuery
+	}
+	usersReturns struct {
+		result1 []api.User
+		result2 error
+	}
+	usersReturnsOnCall map[int]struct {
+		result1 []api.User
+		result2 error
+	}
+	UserStub        func(context.Context, uuid.UUID) (api.User, error)
+	userMutex       sync.RWMutex
+	userArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	userReturns struct {
+		result1 api.User
+		result2 error
+	}
+	userReturnsOnCall map[int]struct {
+		result1 api.User
+		result2 error
+	}
+	PhrasesForUserStub        func(context.Context, uuid.UUID) ([]api.AdminPhrase, error)
+	phrasesForUserMutex       sync.RWMutex
+	phrasesForUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	phrasesForUserReturns struct {
+		result1 []api.AdminPhrase
+		result2 error
+	}
+	phrasesForUserReturnsOnCall map[int]struct {
+		result1 []api.AdminPhrase
+		result2 error
+	}
+	PatchPhraseStub        func(context.Context, api.PhrasePatch, uuid.UUID, uuid.UUID) (api.AdminPhrase, error)
+	patchPhraseMutex       sync.RWMutex
+	patchPhraseArgsForCall []struct {
+		arg1 context.Context
+		arg2 api.PhrasePatch
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	patchPhraseReturns struct {
+		result1 api.AdminPhrase
+		result2 error
+	}
+	patchPhraseReturnsOnCall map[int]struct {
+		result1 api.AdminPhrase
+		result2 error
+	}
+	DeletePhraseStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	deletePhraseMutex       sync.RWMutex
+	deletePhraseArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	deletePhraseReturns struct {
+		result1 error
+	}
+	deletePhraseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeletePhrasesForUserStub        func(context.Context, uuid.UUID) (int64, error)
+	deletePhrasesForUserMutex       sync.RWMutex
+	deletePhrasesForUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	deletePhrasesForUserReturns struct {
+		result1 int64
+		result2 error
+	}
+	deletePhrasesForUserReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	DisableUserStub        func(context.Context, uuid.UUID, string) error
+	disableUserMutex       sync.RWMutex
+	disableUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	disableUserReturns struct {
+		result1 error
+	}
+	disableUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EnableUserStub        func(context.Context, uuid.UUID) error
+	enableUserMutex       sync.RWMutex
+	enableUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	enableUserReturns struct {
+		result1 error
+	}
+	enableUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetRoleStub        func(context.Context, uuid.UUID, api.Role, []byte) error
+	setRoleMutex       sync.RWMutex
+	setRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 api.Role
+		arg4 []byte
+	}
+	setRoleReturns struct {
+		result1 error
+	}
+	setRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StatsStub        func(context.Context, time.Time) (api.Stats, error)
+	statsMutex       sync.RWMutex
+	statsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	statsReturns struct {
+		result1 api.Stats
+		result2 error
+	}
+	statsReturnsOnCall map[int]struct {
+		result1 api.Stats
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAdminRepository) PhraseCountByUserUUID() ([]api.PhraseCount, error) {
+func (fake *FakeAdminRepository) PhraseCountByUserUUID(arg1 context.Context) ([]api.PhraseCount, error) {
 	fake.phraseCountByUserUUIDMutex.Lock()
 	ret, specificReturn := fake.phraseCountByUserUUIDReturnsOnCall[len(fake.phraseCountByUserUUIDArgsForCall)]
-	fake.phraseCountByUserUUIDArgsForCall = append(fake.phraseCountByUserUUIDArgsForCall, struct{}{})
-	fake.recordInvocation("PhraseCountByUserUUID", []interface{}{})
+	fake.phraseCountByUserUUIDArgsForCall = append(fake.phraseCountByUserUUIDArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("PhraseCountByUserUUID", []interface{}{arg1})
 	fake.phraseCountByUserUUIDMutex.Unlock()
 	if fake.PhraseCountByUserUUIDStub != nil {
-		return fake.PhraseCountByUserUUIDStub()
+		return fake.PhraseCountByUserUUIDStub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -44,6 +189,12 @@ func (fake *FakeAdminRepository) PhraseCountByUserUUIDCallCount() int {
 	return len(fake.phraseCountByUserUUIDArgsForCall)
 }
 
+func (fake *FakeAdminRepository) PhraseCountByUserUUIDArgsForCall(i int) context.Context {
+	fake.phraseCountByUserUUIDMutex.RLock()
+	defer fake.phraseCountByUserUUIDMutex.RUnlock()
+	return fake.phraseCountByUserUUIDArgsForCall[i].arg1
+}
+
 func (fake *FakeAdminRepository) PhraseCountByUserUUIDReturns(result1 []api.PhraseCount, result2 error) {
 	fake.PhraseCountByUserUUIDStub = nil
 	fake.phraseCountByUserUUIDReturns = struct {
@@ -66,11 +217,545 @@ func (fake *FakeAdminRepository) PhraseCountByUserUUIDReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeAdminRepository) Users(arg1 context.Context, arg2 api.UsersQuery) ([]api.User, error) {
+	fake.usersMutex.Lock()
+	ret, specificReturn := fake.usersReturnsOnCall[len(fake.usersArgsForCall)]
+	fake.usersArgsForCall = append(fake.usersArgsForCall, struct {
+		arg1 context.Context
+		arg2 api.UsersQuery
+	}{arg1, arg2})
+	fake.recordInvocation("Users", []interface{}{arg1, arg2})
+	fake.usersMutex.Unlock()
+	if fake.UsersStub != nil {
+		return fake.UsersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.usersReturns.result1, fake.usersReturns.result2
+}
+
+func (fake *FakeAdminRepository) UsersCallCount() int {
+	fake.usersMutex.RLock()
+	defer fake.usersMutex.RUnlock()
+	return len(fake.usersArgsForCall)
+}
+
+func (fake *FakeAdminRepository) UsersArgsForCall(i int) (context.Context, api.UsersQuery) {
+	fake.usersMutex.RLock()
+	defer fake.usersMutex.RUnlock()
+	return fake.usersArgsForCall[i].arg1, fake.usersArgsForCall[i].arg2
+}
+
+func (fake *FakeAdminRepository) UsersReturns(result1 []api.User, result2 error) {
+	fake.UsersStub = nil
+	fake.usersReturns = struct {
+		result1 []api.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) UsersReturnsOnCall(i int, result1 []api.User, result2 error) {
+	fake.UsersStub = nil
+	if fake.usersReturnsOnCall == nil {
+		fake.usersReturnsOnCall = make(map[int]struct {
+			result1 []api.User
+			result2 error
+		})
+	}
+	fake.usersReturnsOnCall[i] = struct {
+		result1 []api.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) User(arg1 context.Context, arg2 uuid.UUID) (api.User, error) {
+	fake.userMutex.Lock()
+	ret, specificReturn := fake.userReturnsOnCall[len(fake.userArgsForCall)]
+	fake.userArgsForCall = append(fake.userArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("User", []interface{}{arg1, arg2})
+	fake.userMutex.Unlock()
+	if fake.UserStub != nil {
+		return fake.UserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.userReturns.result1, fake.userReturns.result2
+}
+
+func (fake *FakeAdminRepository) UserCallCount() int {
+	fake.userMutex.RLock()
+	defer fake.userMutex.RUnlock()
+	return len(fake.userArgsForCall)
+}
+
+func (fake *FakeAdminRepository) UserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.userMutex.RLock()
+	defer fake.userMutex.RUnlock()
+	return fake.userArgsForCall[i].arg1, fake.userArgsForCall[i].arg2
+}
+
+func (fake *FakeAdminRepository) UserReturns(result1 api.User, result2 error) {
+	fake.UserStub = nil
+	fake.userReturns = struct {
+		result1 api.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) UserReturnsOnCall(i int, result1 api.User, result2 error) {
+	fake.UserStub = nil
+	if fake.userReturnsOnCall == nil {
+		fake.userReturnsOnCall = make(map[int]struct {
+			result1 api.User
+			result2 error
+		})
+	}
+	fake.userReturnsOnCall[i] = struct {
+		result1 api.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) PhrasesForUser(arg1 context.Context, arg2 uuid.UUID) ([]api.AdminPhrase, error) {
+	fake.phrasesForUserMutex.Lock()
+	ret, specificReturn := fake.phrasesForUserReturnsOnCall[len(fake.phrasesForUserArgsForCall)]
+	fake.phrasesForUserArgsForCall = append(fake.phrasesForUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("PhrasesForUser", []interface{}{arg1, arg2})
+	fake.phrasesForUserMutex.Unlock()
+	if fake.PhrasesForUserStub != nil {
+		return fake.PhrasesForUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.phrasesForUserReturns.result1, fake.phrasesForUserReturns.result2
+}
+
+func (fake *FakeAdminRepository) PhrasesForUserCallCount() int {
+	fake.phrasesForUserMutex.RLock()
+	defer fake.phrasesForUserMutex.RUnlock()
+	return len(fake.phrasesForUserArgsForCall)
+}
+
+func (fake *FakeAdminRepository) PhrasesForUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.phrasesForUserMutex.RLock()
+	defer fake.phrasesForUserMutex.RUnlock()
+	return fake.phrasesForUserArgsForCall[i].arg1, fake.phrasesForUserArgsForCall[i].arg2
+}
+
+func (fake *FakeAdminRepository) PhrasesForUserReturns(result1 []api.AdminPhrase, result2 error) {
+	fake.PhrasesForUserStub = nil
+	fake.phrasesForUserReturns = struct {
+		result1 []api.AdminPhrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) PhrasesForUserReturnsOnCall(i int, result1 []api.AdminPhrase, result2 error) {
+	fake.PhrasesForUserStub = nil
+	if fake.phrasesForUserReturnsOnCall == nil {
+		fake.phrasesForUserReturnsOnCall = make(map[int]struct {
+			result1 []api.AdminPhrase
+			result2 error
+		})
+	}
+	fake.phrasesForUserReturnsOnCall[i] = struct {
+		result1 []api.AdminPhrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) PatchPhrase(arg1 context.Context, arg2 api.PhrasePatch, arg3 uuid.UUID, arg4 uuid.UUID) (api.AdminPhrase, error) {
+	fake.patchPhraseMutex.Lock()
+	ret, specificReturn := fake.patchPhraseReturnsOnCall[len(fake.patchPhraseArgsForCall)]
+	fake.patchPhraseArgsForCall = append(fake.patchPhraseArgsForCall, struct {
+		arg1 context.Context
+		arg2 api.PhrasePatch
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("PatchPhrase", []interface{}{arg1, arg2, arg3, arg4})
+	fake.patchPhraseMutex.Unlock()
+	if fake.PatchPhraseStub != nil {
+		return fake.PatchPhraseStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.patchPhraseReturns.result1, fake.patchPhraseReturns.result2
+}
+
+func (fake *FakeAdminRepository) PatchPhraseCallCount() int {
+	fake.patchPhraseMutex.RLock()
+	defer fake.patchPhraseMutex.RUnlock()
+	return len(fake.patchPhraseArgsForCall)
+}
+
+func (fake *FakeAdminRepository) PatchPhraseArgsForCall(i int) (context.Context, api.PhrasePatch, uuid.UUID, uuid.UUID) {
+	fake.patchPhraseMutex.RLock()
+	defer fake.patchPhraseMutex.RUnlock()
+	return fake.patchPhraseArgsForCall[i].arg1, fake.patchPhraseArgsForCall[i].arg2, fake.patchPhraseArgsForCall[i].arg3, fake.patchPhraseArgsForCall[i].arg4
+}
+
+func (fake *FakeAdminRepository) PatchPhraseReturns(result1 api.AdminPhrase, result2 error) {
+	fake.PatchPhraseStub = nil
+	fake.patchPhraseReturns = struct {
+		result1 api.AdminPhrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) PatchPhraseReturnsOnCall(i int, result1 api.AdminPhrase, result2 error) {
+	fake.PatchPhraseStub = nil
+	if fake.patchPhraseReturnsOnCall == nil {
+		fake.patchPhraseReturnsOnCall = make(map[int]struct {
+			result1 api.AdminPhrase
+			result2 error
+		})
+	}
+	fake.patchPhraseReturnsOnCall[i] = struct {
+		result1 api.AdminPhrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) DeletePhrase(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.deletePhraseMutex.Lock()
+	ret, specificReturn := fake.deletePhraseReturnsOnCall[len(fake.deletePhraseArgsForCall)]
+	fake.deletePhraseArgsForCall = append(fake.deletePhraseArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeletePhrase", []interface{}{arg1, arg2, arg3})
+	fake.deletePhraseMutex.Unlock()
+	if fake.DeletePhraseStub != nil {
+		return fake.DeletePhraseStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deletePhraseReturns.result1
+}
+
+func (fake *FakeAdminRepository) DeletePhraseCallCount() int {
+	fake.deletePhraseMutex.RLock()
+	defer fake.deletePhraseMutex.RUnlock()
+	return len(fake.deletePhraseArgsForCall)
+}
+
+func (fake *FakeAdminRepository) DeletePhraseArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.deletePhraseMutex.RLock()
+	defer fake.deletePhraseMutex.RUnlock()
+	return fake.deletePhraseArgsForCall[i].arg1, fake.deletePhraseArgsForCall[i].arg2, fake.deletePhraseArgsForCall[i].arg3
+}
+
+func (fake *FakeAdminRepository) DeletePhraseReturns(result1 error) {
+	fake.DeletePhraseStub = nil
+	fake.deletePhraseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAdminRepository) DeletePhraseReturnsOnCall(i int, result1 error) {
+	fake.DeletePhraseStub = nil
+	if fake.deletePhraseReturnsOnCall == nil {
+		fake.deletePhraseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deletePhraseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAdminRepository) DeletePhrasesForUser(arg1 context.Context, arg2 uuid.UUID) (int64, error) {
+	fake.deletePhrasesForUserMutex.Lock()
+	ret, specificReturn := fake.deletePhrasesForUserReturnsOnCall[len(fake.deletePhrasesForUserArgsForCall)]
+	fake.deletePhrasesForUserArgsForCall = append(fake.deletePhrasesForUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("DeletePhrasesForUser", []interface{}{arg1, arg2})
+	fake.deletePhrasesForUserMutex.Unlock()
+	if fake.DeletePhrasesForUserStub != nil {
+		return fake.DeletePhrasesForUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deletePhrasesForUserReturns.result1, fake.deletePhrasesForUserReturns.result2
+}
+
+func (fake *FakeAdminRepository) DeletePhrasesForUserCallCount() int {
+	fake.deletePhrasesForUserMutex.RLock()
+	defer fake.deletePhrasesForUserMutex.RUnlock()
+	return len(fake.deletePhrasesForUserArgsForCall)
+}
+
+func (fake *FakeAdminRepository) DeletePhrasesForUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deletePhrasesForUserMutex.RLock()
+	defer fake.deletePhrasesForUserMutex.RUnlock()
+	return fake.deletePhrasesForUserArgsForCall[i].arg1, fake.deletePhrasesForUserArgsForCall[i].arg2
+}
+
+func (fake *FakeAdminRepository) DeletePhrasesForUserReturns(result1 int64, result2 error) {
+	fake.DeletePhrasesForUserStub = nil
+	fake.deletePhrasesForUserReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) DeletePhrasesForUserReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.DeletePhrasesForUserStub = nil
+	if fake.deletePhrasesForUserReturnsOnCall == nil {
+		fake.deletePhrasesForUserReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.deletePhrasesForUserReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) DisableUser(arg1 context.Context, arg2 uuid.UUID, arg3 string) error {
+	fake.disableUserMutex.Lock()
+	ret, specificReturn := fake.disableUserReturnsOnCall[len(fake.disableUserArgsForCall)]
+	fake.disableUserArgsForCall = append(fake.disableUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DisableUser", []interface{}{arg1, arg2, arg3})
+	fake.disableUserMutex.Unlock()
+	if fake.DisableUserStub != nil {
+		return fake.DisableUserStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.disableUserReturns.result1
+}
+
+func (fake *FakeAdminRepository) DisableUserCallCount() int {
+	fake.disableUserMutex.RLock()
+	defer fake.disableUserMutex.RUnlock()
+	return len(fake.disableUserArgsForCall)
+}
+
+func (fake *FakeAdminRepository) DisableUserArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.disableUserMutex.RLock()
+	defer fake.disableUserMutex.RUnlock()
+	return fake.disableUserArgsForCall[i].arg1, fake.disableUserArgsForCall[i].arg2, fake.disableUserArgsForCall[i].arg3
+}
+
+func (fake *FakeAdminRepository) DisableUserReturns(result1 error) {
+	fake.DisableUserStub = nil
+	fake.disableUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAdminRepository) DisableUserReturnsOnCall(i int, result1 error) {
+	fake.DisableUserStub = nil
+	if fake.disableUserReturnsOnCall == nil {
+		fake.disableUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAdminRepository) EnableUser(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.enableUserMutex.Lock()
+	ret, specificReturn := fake.enableUserReturnsOnCall[len(fake.enableUserArgsForCall)]
+	fake.enableUserArgsForCall = append(fake.enableUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("EnableUser", []interface{}{arg1, arg2})
+	fake.enableUserMutex.Unlock()
+	if fake.EnableUserStub != nil {
+		return fake.EnableUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.enableUserReturns.result1
+}
+
+func (fake *FakeAdminRepository) EnableUserCallCount() int {
+	fake.enableUserMutex.RLock()
+	defer fake.enableUserMutex.RUnlock()
+	return len(fake.enableUserArgsForCall)
+}
+
+func (fake *FakeAdminRepository) EnableUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.enableUserMutex.RLock()
+	defer fake.enableUserMutex.RUnlock()
+	return fake.enableUserArgsForCall[i].arg1, fake.enableUserArgsForCall[i].arg2
+}
+
+func (fake *FakeAdminRepository) EnableUserReturns(result1 error) {
+	fake.EnableUserStub = nil
+	fake.enableUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAdminRepository) EnableUserReturnsOnCall(i int, result1 error) {
+	fake.EnableUserStub = nil
+	if fake.enableUserReturnsOnCall == nil {
+		fake.enableUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.enableUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAdminRepository) SetRole(arg1 context.Context, arg2 uuid.UUID, arg3 api.Role, arg4 []byte) error {
+	fake.setRoleMutex.Lock()
+	ret, specificReturn := fake.setRoleReturnsOnCall[len(fake.setRoleArgsForCall)]
+	fake.setRoleArgsForCall = append(fake.setRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 api.Role
+		arg4 []byte
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SetRole", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setRoleMutex.Unlock()
+	if fake.SetRoleStub != nil {
+		return fake.SetRoleStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.setRoleReturns.result1
+}
+
+func (fake *FakeAdminRepository) SetRoleCallCount() int {
+	fake.setRoleMutex.RLock()
+	defer fake.setRoleMutex.RUnlock()
+	return len(fake.setRoleArgsForCall)
+}
+
+func (fake *FakeAdminRepository) SetRoleArgsForCall(i int) (context.Context, uuid.UUID, api.Role, []byte) {
+	fake.setRoleMutex.RLock()
+	defer fake.setRoleMutex.RUnlock()
+	return fake.setRoleArgsForCall[i].arg1, fake.setRoleArgsForCall[i].arg2, fake.setRoleArgsForCall[i].arg3, fake.setRoleArgsForCall[i].arg4
+}
+
+func (fake *FakeAdminRepository) SetRoleReturns(result1 error) {
+	fake.SetRoleStub = nil
+	fake.setRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAdminRepository) SetRoleReturnsOnCall(i int, result1 error) {
+	fake.SetRoleStub = nil
+	if fake.setRoleReturnsOnCall == nil {
+		fake.setRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAdminRepository) Stats(arg1 context.Context, arg2 time.Time) (api.Stats, error) {
+	fake.statsMutex.Lock()
+	ret, specificReturn := fake.statsReturnsOnCall[len(fake.statsArgsForCall)]
+	fake.statsArgsForCall = append(fake.statsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	fake.recordInvocation("Stats", []interface{}{arg1, arg2})
+	fake.statsMutex.Unlock()
+	if fake.StatsStub != nil {
+		return fake.StatsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.statsReturns.result1, fake.statsReturns.result2
+}
+
+func (fake *FakeAdminRepository) StatsCallCount() int {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return len(fake.statsArgsForCall)
+}
+
+func (fake *FakeAdminRepository) StatsArgsForCall(i int) (context.Context, time.Time) {
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
+	return fake.statsArgsForCall[i].arg1, fake.statsArgsForCall[i].arg2
+}
+
+func (fake *FakeAdminRepository) StatsReturns(result1 api.Stats, result2 error) {
+	fake.StatsStub = nil
+	fake.statsReturns = struct {
+		result1 api.Stats
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdminRepository) StatsReturnsOnCall(i int, result1 api.Stats, result2 error) {
+	fake.StatsStub = nil
+	if fake.statsReturnsOnCall == nil {
+		fake.statsReturnsOnCall = make(map[int]struct {
+			result1 api.Stats
+			result2 error
+		})
+	}
+	fake.statsReturnsOnCall[i] = struct {
+		result1 api.Stats
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAdminRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.phraseCountByUserUUIDMutex.RLock()
 	defer fake.phraseCountByUserUUIDMutex.RUnlock()
+	fake.usersMutex.RLock()
+	defer fake.usersMutex.RUnlock()
+	fake.userMutex.RLock()
+	defer fake.userMutex.RUnlock()
+	fake.phrasesForUserMutex.RLock()
+	defer fake.phrasesForUserMutex.RUnlock()
+	fake.patchPhraseMutex.RLock()
+	defer fake.patchPhraseMutex.RUnlock()
+	fake.deletePhraseMutex.RLock()
+	defer fake.deletePhraseMutex.RUnlock()
+	fake.deletePhrasesForUserMutex.RLock()
+	defer fake.deletePhrasesForUserMutex.RUnlock()
+	fake.disableUserMutex.RLock()
+	defer fake.disableUserMutex.RUnlock()
+	fake.enableUserMutex.RLock()
+	defer fake.enableUserMutex.RUnlock()
+	fake.setRoleMutex.RLock()
+	defer fake.setRoleMutex.RUnlock()
+	fake.statsMutex.RLock()
+	defer fake.statsMutex.RUnlock()
 	return fake.invocations
 }
 
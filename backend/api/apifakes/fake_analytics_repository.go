@@ -0,0 +1,309 @@
+// This file was generated by counterfeiter
+package apifakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+type FakeAnalyticsRepository struct {
+	NewPhrasesStub        func(context.Context, api.SeriesQuery) ([]api.SeriesPoint, error)
+	newPhrasesMutex       sync.RWMutex
+	newPhrasesArgsForCall []struct {
+		arg1 context.Context
+		arg2 api.SeriesQuery
+	}
+	newPhrasesReturns struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}
+	newPhrasesReturnsOnCall map[int]struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}
+	ActiveUsersStub        func(context.Context, api.SeriesQuery) ([]api.SeriesPoint, error)
+	activeUsersMutex       sync.RWMutex
+	activeUsersArgsForCall []struct {
+		arg1 context.Context
+		arg2 api.SeriesQuery
+	}
+	activeUsersReturns struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}
+	activeUsersReturnsOnCall map[int]struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}
+	PracticeSessionsStub        func(context.Context, api.SeriesQuery) ([]api.SeriesPoint, error)
+	practiceSessionsMutex       sync.RWMutex
+	practiceSessionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 api.SeriesQuery
+	}
+	practiceSessionsReturns struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}
+	practiceSessionsReturnsOnCall map[int]struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}
+	RetentionCohortsStub        func(context.Context, time.Time, time.Time) ([]api.RetentionCohort, error)
+	retentionCohortsMutex       sync.RWMutex
+	retentionCohortsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Time
+	}
+	retentionCohortsReturns struct {
+		result1 []api.RetentionCohort
+		result2 error
+	}
+	retentionCohortsReturnsOnCall map[int]struct {
+		result1 []api.RetentionCohort
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAnalyticsRepository) NewPhrases(arg1 context.Context, arg2 api.SeriesQuery) ([]api.SeriesPoint, error) {
+	fake.newPhrasesMutex.Lock()
+	ret, specificReturn := fake.newPhrasesReturnsOnCall[len(fake.newPhrasesArgsForCall)]
+	fake.newPhrasesArgsForCall = append(fake.newPhrasesArgsForCall, struct {
+		arg1 context.Context
+		arg2 api.SeriesQuery
+	}{arg1, arg2})
+	fake.recordInvocation("NewPhrases", []interface{}{arg1, arg2})
+	fake.newPhrasesMutex.Unlock()
+	if fake.NewPhrasesStub != nil {
+		return fake.NewPhrasesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.newPhrasesReturns.result1, fake.newPhrasesReturns.result2
+}
+
+func (fake *FakeAnalyticsRepository) NewPhrasesCallCount() int {
+	fake.newPhrasesMutex.RLock()
+	defer fake.newPhrasesMutex.RUnlock()
+	return len(fake.newPhrasesArgsForCall)
+}
+
+func (fake *FakeAnalyticsRepository) NewPhrasesArgsForCall(i int) (context.Context, api.SeriesQuery) {
+	fake.newPhrasesMutex.RLock()
+	defer fake.newPhrasesMutex.RUnlock()
+	return fake.newPhrasesArgsForCall[i].arg1, fake.newPhrasesArgsForCall[i].arg2
+}
+
+func (fake *FakeAnalyticsRepository) NewPhrasesReturns(result1 []api.SeriesPoint, result2 error) {
+	fake.NewPhrasesStub = nil
+	fake.newPhrasesReturns = struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAnalyticsRepository) NewPhrasesReturnsOnCall(i int, result1 []api.SeriesPoint, result2 error) {
+	fake.NewPhrasesStub = nil
+	if fake.newPhrasesReturnsOnCall == nil {
+		fake.newPhrasesReturnsOnCall = make(map[int]struct {
+			result1 []api.SeriesPoint
+			result2 error
+		})
+	}
+	fake.newPhrasesReturnsOnCall[i] = struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAnalyticsRepository) ActiveUsers(arg1 context.Context, arg2 api.SeriesQuery) ([]api.SeriesPoint, error) {
+	fake.activeUsersMutex.Lock()
+	ret, specificReturn := fake.activeUsersReturnsOnCall[len(fake.activeUsersArgsForCall)]
+	fake.activeUsersArgsForCall = append(fake.activeUsersArgsForCall, struct {
+		arg1 context.Context
+		arg2 api.SeriesQuery
+	}{arg1, arg2})
+	fake.recordInvocation("ActiveUsers", []interface{}{arg1, arg2})
+	fake.activeUsersMutex.Unlock()
+	if fake.ActiveUsersStub != nil {
+		return fake.ActiveUsersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.activeUsersReturns.result1, fake.activeUsersReturns.result2
+}
+
+func (fake *FakeAnalyticsRepository) ActiveUsersCallCount() int {
+	fake.activeUsersMutex.RLock()
+	defer fake.activeUsersMutex.RUnlock()
+	return len(fake.activeUsersArgsForCall)
+}
+
+func (fake *FakeAnalyticsRepository) ActiveUsersArgsForCall(i int) (context.Context, api.SeriesQuery) {
+	fake.activeUsersMutex.RLock()
+	defer fake.activeUsersMutex.RUnlock()
+	return fake.activeUsersArgsForCall[i].arg1, fake.activeUsersArgsForCall[i].arg2
+}
+
+func (fake *FakeAnalyticsRepository) ActiveUsersReturns(result1 []api.SeriesPoint, result2 error) {
+	fake.ActiveUsersStub = nil
+	fake.activeUsersReturns = struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAnalyticsRepository) ActiveUsersReturnsOnCall(i int, result1 []api.SeriesPoint, result2 error) {
+	fake.ActiveUsersStub = nil
+	if fake.activeUsersReturnsOnCall == nil {
+		fake.activeUsersReturnsOnCall = make(map[int]struct {
+			result1 []api.SeriesPoint
+			result2 error
+		})
+	}
+	fake.activeUsersReturnsOnCall[i] = struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAnalyticsRepository) PracticeSessions(arg1 context.Context, arg2 api.SeriesQuery) ([]api.SeriesPoint, error) {
+	fake.practiceSessionsMutex.Lock()
+	ret, specificReturn := fake.practiceSessionsReturnsOnCall[len(fake.practiceSessionsArgsForCall)]
+	fake.practiceSessionsArgsForCall = append(fake.practiceSessionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 api.SeriesQuery
+	}{arg1, arg2})
+	fake.recordInvocation("PracticeSessions", []interface{}{arg1, arg2})
+	fake.practiceSessionsMutex.Unlock()
+	if fake.PracticeSessionsStub != nil {
+		return fake.PracticeSessionsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.practiceSessionsReturns.result1, fake.practiceSessionsReturns.result2
+}
+
+func (fake *FakeAnalyticsRepository) PracticeSessionsCallCount() int {
+	fake.practiceSessionsMutex.RLock()
+	defer fake.practiceSessionsMutex.RUnlock()
+	return len(fake.practiceSessionsArgsForCall)
+}
+
+func (fake *FakeAnalyticsRepository) PracticeSessionsArgsForCall(i int) (context.Context, api.SeriesQuery) {
+	fake.practiceSessionsMutex.RLock()
+	defer fake.practiceSessionsMutex.RUnlock()
+	return fake.practiceSessionsArgsForCall[i].arg1, fake.practiceSessionsArgsForCall[i].arg2
+}
+
+func (fake *FakeAnalyticsRepository) PracticeSessionsReturns(result1 []api.SeriesPoint, result2 error) {
+	fake.PracticeSessionsStub = nil
+	fake.practiceSessionsReturns = struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAnalyticsRepository) PracticeSessionsReturnsOnCall(i int, result1 []api.SeriesPoint, result2 error) {
+	fake.PracticeSessionsStub = nil
+	if fake.practiceSessionsReturnsOnCall == nil {
+		fake.practiceSessionsReturnsOnCall = make(map[int]struct {
+			result1 []api.SeriesPoint
+			result2 error
+		})
+	}
+	fake.practiceSessionsReturnsOnCall[i] = struct {
+		result1 []api.SeriesPoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAnalyticsRepository) RetentionCohorts(arg1 context.Context, arg2 time.Time, arg3 time.Time) ([]api.RetentionCohort, error) {
+	fake.retentionCohortsMutex.Lock()
+	ret, specificReturn := fake.retentionCohortsReturnsOnCall[len(fake.retentionCohortsArgsForCall)]
+	fake.retentionCohortsArgsForCall = append(fake.retentionCohortsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RetentionCohorts", []interface{}{arg1, arg2, arg3})
+	fake.retentionCohortsMutex.Unlock()
+	if fake.RetentionCohortsStub != nil {
+		return fake.RetentionCohortsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.retentionCohortsReturns.result1, fake.retentionCohortsReturns.result2
+}
+
+func (fake *FakeAnalyticsRepository) RetentionCohortsCallCount() int {
+	fake.retentionCohortsMutex.RLock()
+	defer fake.retentionCohortsMutex.RUnlock()
+	return len(fake.retentionCohortsArgsForCall)
+}
+
+func (fake *FakeAnalyticsRepository) RetentionCohortsArgsForCall(i int) (context.Context, time.Time, time.Time) {
+	fake.retentionCohortsMutex.RLock()
+	defer fake.retentionCohortsMutex.RUnlock()
+	return fake.retentionCohortsArgsForCall[i].arg1, fake.retentionCohortsArgsForCall[i].arg2, fake.retentionCohortsArgsForCall[i].arg3
+}
+
+func (fake *FakeAnalyticsRepository) RetentionCohortsReturns(result1 []api.RetentionCohort, result2 error) {
+	fake.RetentionCohortsStub = nil
+	fake.retentionCohortsReturns = struct {
+		result1 []api.RetentionCohort
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAnalyticsRepository) RetentionCohortsReturnsOnCall(i int, result1 []api.RetentionCohort, result2 error) {
+	fake.RetentionCohortsStub = nil
+	if fake.retentionCohortsReturnsOnCall == nil {
+		fake.retentionCohortsReturnsOnCall = make(map[int]struct {
+			result1 []api.RetentionCohort
+			result2 error
+		})
+	}
+	fake.retentionCohortsReturnsOnCall[i] = struct {
+		result1 []api.RetentionCohort
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAnalyticsRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.newPhrasesMutex.RLock()
+	defer fake.newPhrasesMutex.RUnlock()
+	fake.activeUsersMutex.RLock()
+	defer fake.activeUsersMutex.RUnlock()
+	fake.practiceSessionsMutex.RLock()
+	defer fake.practiceSessionsMutex.RUnlock()
+	fake.retentionCohortsMutex.RLock()
+	defer fake.retentionCohortsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAnalyticsRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ api.AnalyticsRepository = new(FakeAnalyticsRepository)
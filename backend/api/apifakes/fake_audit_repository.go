@@ -0,0 +1,102 @@
+// This file was generated by counterfeiter
+package apifakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+type FakeAuditRepository struct {
+	EntriesStub        func(context.Context, api.AuditQuery) ([]api.AuditEntry, error)
+	entriesMutex       sync.RWMutex
+	entriesArgsForCall []struct {
+		arg1 context.Context
+		arg2 api.AuditQuery
+	}
+	entriesReturns struct {
+		result1 []api.AuditEntry
+		result2 error
+	}
+	entriesReturnsOnCall map[int]struct {
+		result1 []api.AuditEntry
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditRepository) Entries(arg1 context.Context, arg2 api.AuditQuery) ([]api.AuditEntry, error) {
+	fake.entriesMutex.Lock()
+	ret, specificReturn := fake.entriesReturnsOnCall[len(fake.entriesArgsForCall)]
+	fake.entriesArgsForCall = append(fake.entriesArgsForCall, struct {
+		arg1 context.Context
+		arg2 api.AuditQuery
+	}{arg1, arg2})
+	fake.recordInvocation("Entries", []interface{}{arg1, arg2})
+	fake.entriesMutex.Unlock()
+	if fake.EntriesStub != nil {
+		return fake.EntriesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.entriesReturns.result1, fake.entriesReturns.result2
+}
+
+func (fake *FakeAuditRepository) EntriesCallCount() int {
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	return len(fake.entriesArgsForCall)
+}
+
+func (fake *FakeAuditRepository) EntriesArgsForCall(i int) (context.Context, api.AuditQuery) {
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	return fake.entriesArgsForCall[i].arg1, fake.entriesArgsForCall[i].arg2
+}
+
+func (fake *FakeAuditRepository) EntriesReturns(result1 []api.AuditEntry, result2 error) {
+	fake.EntriesStub = nil
+	fake.entriesReturns = struct {
+		result1 []api.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditRepository) EntriesReturnsOnCall(i int, result1 []api.AuditEntry, result2 error) {
+	fake.EntriesStub = nil
+	if fake.entriesReturnsOnCall == nil {
+		fake.entriesReturnsOnCall = make(map[int]struct {
+			result1 []api.AuditEntry
+			result2 error
+		})
+	}
+	fake.entriesReturnsOnCall[i] = struct {
+		result1 []api.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAuditRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ api.AuditRepository = new(FakeAuditRepository)
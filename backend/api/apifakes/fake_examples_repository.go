@@ -0,0 +1,245 @@
+// This file was generated by counterfeiter
+package apifakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+type FakeExamplesRepository struct {
+	ExamplesForPhraseStub        func(context.Context, uuid.UUID, uuid.UUID) ([]string, error)
+	examplesForPhraseMutex       sync.RWMutex
+	examplesForPhraseArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	examplesForPhraseReturns struct {
+		result1 []string
+		result2 error
+	}
+	examplesForPhraseReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	ReplaceExamplesForPhraseStub        func(context.Context, uuid.UUID, []string, uuid.UUID) ([]string, error)
+	replaceExamplesForPhraseMutex       sync.RWMutex
+	replaceExamplesForPhraseArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 []string
+		arg4 uuid.UUID
+	}
+	replaceExamplesForPhraseReturns struct {
+		result1 []string
+		result2 error
+	}
+	replaceExamplesForPhraseReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	ExamplesForUserStub        func(context.Context, uuid.UUID) ([]api.PhraseExample, error)
+	examplesForUserMutex       sync.RWMutex
+	examplesForUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	examplesForUserReturns struct {
+		result1 []api.PhraseExample
+		result2 error
+	}
+	examplesForUserReturnsOnCall map[int]struct {
+		result1 []api.PhraseExample
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeExamplesRepository) ExamplesForPhrase(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]string, error) {
+	fake.examplesForPhraseMutex.Lock()
+	ret, specificReturn := fake.examplesForPhraseReturnsOnCall[len(fake.examplesForPhraseArgsForCall)]
+	fake.examplesForPhraseArgsForCall = append(fake.examplesForPhraseArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ExamplesForPhrase", []interface{}{arg1, arg2, arg3})
+	fake.examplesForPhraseMutex.Unlock()
+	if fake.ExamplesForPhraseStub != nil {
+		return fake.ExamplesForPhraseStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.examplesForPhraseReturns.result1, fake.examplesForPhraseReturns.result2
+}
+
+func (fake *FakeExamplesRepository) ExamplesForPhraseCallCount() int {
+	fake.examplesForPhraseMutex.RLock()
+	defer fake.examplesForPhraseMutex.RUnlock()
+	return len(fake.examplesForPhraseArgsForCall)
+}
+
+func (fake *FakeExamplesRepository) ExamplesForPhraseArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.examplesForPhraseMutex.RLock()
+	defer fake.examplesForPhraseMutex.RUnlock()
+	return fake.examplesForPhraseArgsForCall[i].arg1, fake.examplesForPhraseArgsForCall[i].arg2, fake.examplesForPhraseArgsForCall[i].arg3
+}
+
+func (fake *FakeExamplesRepository) ExamplesForPhraseReturns(result1 []string, result2 error) {
+	fake.ExamplesForPhraseStub = nil
+	fake.examplesForPhraseReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeExamplesRepository) ExamplesForPhraseReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.ExamplesForPhraseStub = nil
+	if fake.examplesForPhraseReturnsOnCall == nil {
+		fake.examplesForPhraseReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.examplesForPhraseReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeExamplesRepository) ReplaceExamplesForPhrase(arg1 context.Context, arg2 uuid.UUID, arg3 []string, arg4 uuid.UUID) ([]string, error) {
+	fake.replaceExamplesForPhraseMutex.Lock()
+	ret, specificReturn := fake.replaceExamplesForPhraseReturnsOnCall[len(fake.replaceExamplesForPhraseArgsForCall)]
+	fake.replaceExamplesForPhraseArgsForCall = append(fake.replaceExamplesForPhraseArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 []string
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("ReplaceExamplesForPhrase", []interface{}{arg1, arg2, arg3, arg4})
+	fake.replaceExamplesForPhraseMutex.Unlock()
+	if fake.ReplaceExamplesForPhraseStub != nil {
+		return fake.ReplaceExamplesForPhraseStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.replaceExamplesForPhraseReturns.result1, fake.replaceExamplesForPhraseReturns.result2
+}
+
+func (fake *FakeExamplesRepository) ReplaceExamplesForPhraseCallCount() int {
+	fake.replaceExamplesForPhraseMutex.RLock()
+	defer fake.replaceExamplesForPhraseMutex.RUnlock()
+	return len(fake.replaceExamplesForPhraseArgsForCall)
+}
+
+func (fake *FakeExamplesRepository) ReplaceExamplesForPhraseArgsForCall(i int) (context.Context, uuid.UUID, []string, uuid.UUID) {
+	fake.replaceExamplesForPhraseMutex.RLock()
+	defer fake.replaceExamplesForPhraseMutex.RUnlock()
+	return fake.replaceExamplesForPhraseArgsForCall[i].arg1, fake.replaceExamplesForPhraseArgsForCall[i].arg2, fake.replaceExamplesForPhraseArgsForCall[i].arg3, fake.replaceExamplesForPhraseArgsForCall[i].arg4
+}
+
+func (fake *FakeExamplesRepository) ReplaceExamplesForPhraseReturns(result1 []string, result2 error) {
+	fake.ReplaceExamplesForPhraseStub = nil
+	fake.replaceExamplesForPhraseReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeExamplesRepository) ReplaceExamplesForPhraseReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.ReplaceExamplesForPhraseStub = nil
+	if fake.replaceExamplesForPhraseReturnsOnCall == nil {
+		fake.replaceExamplesForPhraseReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.replaceExamplesForPhraseReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeExamplesRepository) ExamplesForUser(arg1 context.Context, arg2 uuid.UUID) ([]api.PhraseExample, error) {
+	fake.examplesForUserMutex.Lock()
+	ret, specificReturn := fake.examplesForUserReturnsOnCall[len(fake.examplesForUserArgsForCall)]
+	fake.examplesForUserArgsForCall = append(fake.examplesForUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("ExamplesForUser", []interface{}{arg1, arg2})
+	fake.examplesForUserMutex.Unlock()
+	if fake.ExamplesForUserStub != nil {
+		return fake.ExamplesForUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.examplesForUserReturns.result1, fake.examplesForUserReturns.result2
+}
+
+func (fake *FakeExamplesRepository) ExamplesForUserCallCount() int {
+	fake.examplesForUserMutex.RLock()
+	defer fake.examplesForUserMutex.RUnlock()
+	return len(fake.examplesForUserArgsForCall)
+}
+
+func (fake *FakeExamplesRepository) ExamplesForUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.examplesForUserMutex.RLock()
+	defer fake.examplesForUserMutex.RUnlock()
+	return fake.examplesForUserArgsForCall[i].arg1, fake.examplesForUserArgsForCall[i].arg2
+}
+
+func (fake *FakeExamplesRepository) ExamplesForUserReturns(result1 []api.PhraseExample, result2 error) {
+	fake.ExamplesForUserStub = nil
+	fake.examplesForUserReturns = struct {
+		result1 []api.PhraseExample
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeExamplesRepository) ExamplesForUserReturnsOnCall(i int, result1 []api.PhraseExample, result2 error) {
+	fake.ExamplesForUserStub = nil
+	if fake.examplesForUserReturnsOnCall == nil {
+		fake.examplesForUserReturnsOnCall = make(map[int]struct {
+			result1 []api.PhraseExample
+			result2 error
+		})
+	}
+	fake.examplesForUserReturnsOnCall[i] = struct {
+		result1 []api.PhraseExample
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeExamplesRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.examplesForPhraseMutex.RLock()
+	defer fake.examplesForPhraseMutex.RUnlock()
+	fake.replaceExamplesForPhraseMutex.RLock()
+	defer fake.replaceExamplesForPhraseMutex.RUnlock()
+	fake.examplesForUserMutex.RLock()
+	defer fake.examplesForUserMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeExamplesRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ api.ExamplesRepository = new(FakeExamplesRepository)
@@ -2,6 +2,7 @@
 package apifakes
 
 import (
+	"context"
 	"sync"
 
 	"github.com/google/uuid"
@@ -9,47 +10,111 @@ import (
 )
 
 type FakePhrasesRepository struct {
-	PhrasesForUserWithUUIDStub        func(uuid.UUID) ([]api.Phrase, error)
+	PhrasesForUserWithUUIDStub        func(context.Context, uuid.UUID, api.PhrasesQuery) (api.PhrasesPage, error)
 	phrasesForUserWithUUIDMutex       sync.RWMutex
 	phrasesForUserWithUUIDArgsForCall []struct {
-		arg1 uuid.UUID
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 api.PhrasesQuery
 	}
 	phrasesForUserWithUUIDReturns struct {
-		result1 []api.Phrase
+		result1 api.PhrasesPage
 		result2 error
 	}
 	phrasesForUserWithUUIDReturnsOnCall map[int]struct {
-		result1 []api.Phrase
+		result1 api.PhrasesPage
 		result2 error
 	}
-	AddPhraseForUserWithUUIDStub        func(string, string, uuid.UUID) (api.Phrase, error)
-	addPhraseForUserWithUUIDMutex       sync.RWMutex
-	addPhraseForUserWithUUIDArgsForCall []struct {
-		arg1 string
-		arg2 string
+	PhraseForUserWithUUIDStub        func(context.Context, uuid.UUID, uuid.UUID) (api.Phrase, error)
+	phraseForUserWithUUIDMutex       sync.RWMutex
+	phraseForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
 		arg3 uuid.UUID
 	}
-	addPhraseForUserWithUUIDReturns struct {
+	phraseForUserWithUUIDReturns struct {
 		result1 api.Phrase
 		result2 error
 	}
-	addPhraseForUserWithUUIDReturnsOnCall map[int]struct {
+	phraseForUserWithUUIDReturnsOnCall map[int]struct {
 		result1 api.Phrase
 		result2 error
 	}
-	UpdatePhraseForUserWithUUIDStub        func(string, string, uuid.UUID, uuid.UUID) (api.Phrase, error)
-	updatePhraseForUserWithUUIDMutex       sync.RWMutex
-	updatePhraseForUserWithUUIDArgsForCall []struct {
-		arg1 string
-		arg2 string
+	SyncPhrasesForUserWithUUIDStub        func(context.Context, []api.PhraseSync, uuid.UUID) ([]api.SyncedPhrase, error)
+	syncPhrasesForUserWithUUIDMutex       sync.RWMutex
+	syncPhrasesForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 []api.PhraseSync
 		arg3 uuid.UUID
+	}
+	syncPhrasesForUserWithUUIDReturns struct {
+		result1 []api.SyncedPhrase
+		result2 error
+	}
+	syncPhrasesForUserWithUUIDReturnsOnCall map[int]struct {
+		result1 []api.SyncedPhrase
+		result2 error
+	}
+	PatchPhraseForUserWithUUIDStub        func(context.Context, api.PhrasePatch, uuid.UUID, uuid.UUID) (api.Phrase, error)
+	patchPhraseForUserWithUUIDMutex       sync.RWMutex
+	patchPhraseForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 api.PhrasePatch
+		arg3 uuid.UUID
+		arg4 uuid.UUID
+	}
+	patchPhraseForUserWithUUIDReturns struct {
+		result1 api.Phrase
+		result2 error
+	}
+	patchPhraseForUserWithUUIDReturnsOnCall map[int]struct {
+		result1 api.Phrase
+		result2 error
+	}
+	MergePhrasesForUserWithUUIDStub        func(context.Context, uuid.UUID, []uuid.UUID, uuid.UUID) (api.Phrase, error)
+	mergePhrasesForUserWithUUIDMutex       sync.RWMutex
+	mergePhrasesForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 []uuid.UUID
+		arg4 uuid.UUID
+	}
+	mergePhrasesForUserWithUUIDReturns struct {
+		result1 api.Phrase
+		result2 error
+	}
+	mergePhrasesForUserWithUUIDReturnsOnCall map[int]struct {
+		result1 api.Phrase
+		result2 error
+	}
+	PhraseHistoryForUserWithUUIDStub        func(context.Context, uuid.UUID, uuid.UUID) ([]api.PhraseRevision, error)
+	phraseHistoryForUserWithUUIDMutex       sync.RWMutex
+	phraseHistoryForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	phraseHistoryForUserWithUUIDReturns struct {
+		result1 []api.PhraseRevision
+		result2 error
+	}
+	phraseHistoryForUserWithUUIDReturnsOnCall map[int]struct {
+		result1 []api.PhraseRevision
+		result2 error
+	}
+	RevertPhraseForUserWithUUIDStub        func(context.Context, uuid.UUID, uint, uuid.UUID) (api.Phrase, error)
+	revertPhraseForUserWithUUIDMutex       sync.RWMutex
+	revertPhraseForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uint
 		arg4 uuid.UUID
 	}
-	updatePhraseForUserWithUUIDReturns struct {
+	revertPhraseForUserWithUUIDReturns struct {
 		result1 api.Phrase
 		result2 error
 	}
-	updatePhraseForUserWithUUIDReturnsOnCall map[int]struct {
+	revertPhraseForUserWithUUIDReturnsOnCall map[int]struct {
 		result1 api.Phrase
 		result2 error
 	}
@@ -57,16 +122,18 @@ type FakePhrasesRepository struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakePhrasesRepository) PhrasesForUserWithUUID(arg1 uuid.UUID) ([]api.Phrase, error) {
+func (fake *FakePhrasesRepository) PhrasesForUserWithUUID(arg1 context.Context, arg2 uuid.UUID, arg3 api.PhrasesQuery) (api.PhrasesPage, error) {
 	fake.phrasesForUserWithUUIDMutex.Lock()
 	ret, specificReturn := fake.phrasesForUserWithUUIDReturnsOnCall[len(fake.phrasesForUserWithUUIDArgsForCall)]
 	fake.phrasesForUserWithUUIDArgsForCall = append(fake.phrasesForUserWithUUIDArgsForCall, struct {
-		arg1 uuid.UUID
-	}{arg1})
-	fake.recordInvocation("PhrasesForUserWithUUID", []interface{}{arg1})
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 api.PhrasesQuery
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PhrasesForUserWithUUID", []interface{}{arg1, arg2, arg3})
 	fake.phrasesForUserWithUUIDMutex.Unlock()
 	if fake.PhrasesForUserWithUUIDStub != nil {
-		return fake.PhrasesForUserWithUUIDStub(arg1)
+		return fake.PhrasesForUserWithUUIDStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -80,136 +147,350 @@ func (fake *FakePhrasesRepository) PhrasesForUserWithUUIDCallCount() int {
 	return len(fake.phrasesForUserWithUUIDArgsForCall)
 }
 
-func (fake *FakePhrasesRepository) PhrasesForUserWithUUIDArgsForCall(i int) uuid.UUID {
+func (fake *FakePhrasesRepository) PhrasesForUserWithUUIDArgsForCall(i int) (context.Context, uuid.UUID, api.PhrasesQuery) {
 	fake.phrasesForUserWithUUIDMutex.RLock()
 	defer fake.phrasesForUserWithUUIDMutex.RUnlock()
-	return fake.phrasesForUserWithUUIDArgsForCall[i].arg1
+	return fake.phrasesForUserWithUUIDArgsForCall[i].arg1, fake.phrasesForUserWithUUIDArgsForCall[i].arg2, fake.phrasesForUserWithUUIDArgsForCall[i].arg3
 }
 
-func (fake *FakePhrasesRepository) PhrasesForUserWithUUIDReturns(result1 []api.Phrase, result2 error) {
+func (fake *FakePhrasesRepository) PhrasesForUserWithUUIDReturns(result1 api.PhrasesPage, result2 error) {
 	fake.PhrasesForUserWithUUIDStub = nil
 	fake.phrasesForUserWithUUIDReturns = struct {
-		result1 []api.Phrase
+		result1 api.PhrasesPage
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePhrasesRepository) PhrasesForUserWithUUIDReturnsOnCall(i int, result1 []api.Phrase, result2 error) {
+func (fake *FakePhrasesRepository) PhrasesForUserWithUUIDReturnsOnCall(i int, result1 api.PhrasesPage, result2 error) {
 	fake.PhrasesForUserWithUUIDStub = nil
 	if fake.phrasesForUserWithUUIDReturnsOnCall == nil {
 		fake.phrasesForUserWithUUIDReturnsOnCall = make(map[int]struct {
-			result1 []api.Phrase
+			result1 api.PhrasesPage
 			result2 error
 		})
 	}
 	fake.phrasesForUserWithUUIDReturnsOnCall[i] = struct {
-		result1 []api.Phrase
+		result1 api.PhrasesPage
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePhrasesRepository) AddPhraseForUserWithUUID(arg1 string, arg2 string, arg3 uuid.UUID) (api.Phrase, error) {
-	fake.addPhraseForUserWithUUIDMutex.Lock()
-	ret, specificReturn := fake.addPhraseForUserWithUUIDReturnsOnCall[len(fake.addPhraseForUserWithUUIDArgsForCall)]
-	fake.addPhraseForUserWithUUIDArgsForCall = append(fake.addPhraseForUserWithUUIDArgsForCall, struct {
-		arg1 string
-		arg2 string
+func (fake *FakePhrasesRepository) PhraseForUserWithUUID(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (api.Phrase, error) {
+	fake.phraseForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.phraseForUserWithUUIDReturnsOnCall[len(fake.phraseForUserWithUUIDArgsForCall)]
+	fake.phraseForUserWithUUIDArgsForCall = append(fake.phraseForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
 		arg3 uuid.UUID
 	}{arg1, arg2, arg3})
-	fake.recordInvocation("AddPhraseForUserWithUUID", []interface{}{arg1, arg2, arg3})
-	fake.addPhraseForUserWithUUIDMutex.Unlock()
-	if fake.AddPhraseForUserWithUUIDStub != nil {
-		return fake.AddPhraseForUserWithUUIDStub(arg1, arg2, arg3)
+	fake.recordInvocation("PhraseForUserWithUUID", []interface{}{arg1, arg2, arg3})
+	fake.phraseForUserWithUUIDMutex.Unlock()
+	if fake.PhraseForUserWithUUIDStub != nil {
+		return fake.PhraseForUserWithUUIDStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.addPhraseForUserWithUUIDReturns.result1, fake.addPhraseForUserWithUUIDReturns.result2
+	return fake.phraseForUserWithUUIDReturns.result1, fake.phraseForUserWithUUIDReturns.result2
 }
 
-func (fake *FakePhrasesRepository) AddPhraseForUserWithUUIDCallCount() int {
-	fake.addPhraseForUserWithUUIDMutex.RLock()
-	defer fake.addPhraseForUserWithUUIDMutex.RUnlock()
-	return len(fake.addPhraseForUserWithUUIDArgsForCall)
+func (fake *FakePhrasesRepository) PhraseForUserWithUUIDCallCount() int {
+	fake.phraseForUserWithUUIDMutex.RLock()
+	defer fake.phraseForUserWithUUIDMutex.RUnlock()
+	return len(fake.phraseForUserWithUUIDArgsForCall)
 }
 
-func (fake *FakePhrasesRepository) AddPhraseForUserWithUUIDArgsForCall(i int) (string, string, uuid.UUID) {
-	fake.addPhraseForUserWithUUIDMutex.RLock()
-	defer fake.addPhraseForUserWithUUIDMutex.RUnlock()
-	return fake.addPhraseForUserWithUUIDArgsForCall[i].arg1, fake.addPhraseForUserWithUUIDArgsForCall[i].arg2, fake.addPhraseForUserWithUUIDArgsForCall[i].arg3
+func (fake *FakePhrasesRepository) PhraseForUserWithUUIDArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.phraseForUserWithUUIDMutex.RLock()
+	defer fake.phraseForUserWithUUIDMutex.RUnlock()
+	return fake.phraseForUserWithUUIDArgsForCall[i].arg1, fake.phraseForUserWithUUIDArgsForCall[i].arg2, fake.phraseForUserWithUUIDArgsForCall[i].arg3
 }
 
-func (fake *FakePhrasesRepository) AddPhraseForUserWithUUIDReturns(result1 api.Phrase, result2 error) {
-	fake.AddPhraseForUserWithUUIDStub = nil
-	fake.addPhraseForUserWithUUIDReturns = struct {
+func (fake *FakePhrasesRepository) PhraseForUserWithUUIDReturns(result1 api.Phrase, result2 error) {
+	fake.PhraseForUserWithUUIDStub = nil
+	fake.phraseForUserWithUUIDReturns = struct {
 		result1 api.Phrase
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePhrasesRepository) AddPhraseForUserWithUUIDReturnsOnCall(i int, result1 api.Phrase, result2 error) {
-	fake.AddPhraseForUserWithUUIDStub = nil
-	if fake.addPhraseForUserWithUUIDReturnsOnCall == nil {
-		fake.addPhraseForUserWithUUIDReturnsOnCall = make(map[int]struct {
+func (fake *FakePhrasesRepository) PhraseForUserWithUUIDReturnsOnCall(i int, result1 api.Phrase, result2 error) {
+	fake.PhraseForUserWithUUIDStub = nil
+	if fake.phraseForUserWithUUIDReturnsOnCall == nil {
+		fake.phraseForUserWithUUIDReturnsOnCall = make(map[int]struct {
 			result1 api.Phrase
 			result2 error
 		})
 	}
-	fake.addPhraseForUserWithUUIDReturnsOnCall[i] = struct {
+	fake.phraseForUserWithUUIDReturnsOnCall[i] = struct {
 		result1 api.Phrase
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePhrasesRepository) UpdatePhraseForUserWithUUID(arg1 string, arg2 string, arg3 uuid.UUID, arg4 uuid.UUID) (api.Phrase, error) {
-	fake.updatePhraseForUserWithUUIDMutex.Lock()
-	ret, specificReturn := fake.updatePhraseForUserWithUUIDReturnsOnCall[len(fake.updatePhraseForUserWithUUIDArgsForCall)]
-	fake.updatePhraseForUserWithUUIDArgsForCall = append(fake.updatePhraseForUserWithUUIDArgsForCall, struct {
-		arg1 string
-		arg2 string
+func (fake *FakePhrasesRepository) SyncPhrasesForUserWithUUID(arg1 context.Context, arg2 []api.PhraseSync, arg3 uuid.UUID) ([]api.SyncedPhrase, error) {
+	fake.syncPhrasesForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.syncPhrasesForUserWithUUIDReturnsOnCall[len(fake.syncPhrasesForUserWithUUIDArgsForCall)]
+	fake.syncPhrasesForUserWithUUIDArgsForCall = append(fake.syncPhrasesForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 []api.PhraseSync
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SyncPhrasesForUserWithUUID", []interface{}{arg1, arg2, arg3})
+	fake.syncPhrasesForUserWithUUIDMutex.Unlock()
+	if fake.SyncPhrasesForUserWithUUIDStub != nil {
+		return fake.SyncPhrasesForUserWithUUIDStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.syncPhrasesForUserWithUUIDReturns.result1, fake.syncPhrasesForUserWithUUIDReturns.result2
+}
+
+func (fake *FakePhrasesRepository) SyncPhrasesForUserWithUUIDCallCount() int {
+	fake.syncPhrasesForUserWithUUIDMutex.RLock()
+	defer fake.syncPhrasesForUserWithUUIDMutex.RUnlock()
+	return len(fake.syncPhrasesForUserWithUUIDArgsForCall)
+}
+
+func (fake *FakePhrasesRepository) SyncPhrasesForUserWithUUIDArgsForCall(i int) (context.Context, []api.PhraseSync, uuid.UUID) {
+	fake.syncPhrasesForUserWithUUIDMutex.RLock()
+	defer fake.syncPhrasesForUserWithUUIDMutex.RUnlock()
+	return fake.syncPhrasesForUserWithUUIDArgsForCall[i].arg1, fake.syncPhrasesForUserWithUUIDArgsForCall[i].arg2, fake.syncPhrasesForUserWithUUIDArgsForCall[i].arg3
+}
+
+func (fake *FakePhrasesRepository) SyncPhrasesForUserWithUUIDReturns(result1 []api.SyncedPhrase, result2 error) {
+	fake.SyncPhrasesForUserWithUUIDStub = nil
+	fake.syncPhrasesForUserWithUUIDReturns = struct {
+		result1 []api.SyncedPhrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePhrasesRepository) SyncPhrasesForUserWithUUIDReturnsOnCall(i int, result1 []api.SyncedPhrase, result2 error) {
+	fake.SyncPhrasesForUserWithUUIDStub = nil
+	if fake.syncPhrasesForUserWithUUIDReturnsOnCall == nil {
+		fake.syncPhrasesForUserWithUUIDReturnsOnCall = make(map[int]struct {
+			result1 []api.SyncedPhrase
+			result2 error
+		})
+	}
+	fake.syncPhrasesForUserWithUUIDReturnsOnCall[i] = struct {
+		result1 []api.SyncedPhrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePhrasesRepository) PatchPhraseForUserWithUUID(arg1 context.Context, arg2 api.PhrasePatch, arg3 uuid.UUID, arg4 uuid.UUID) (api.Phrase, error) {
+	fake.patchPhraseForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.patchPhraseForUserWithUUIDReturnsOnCall[len(fake.patchPhraseForUserWithUUIDArgsForCall)]
+	fake.patchPhraseForUserWithUUIDArgsForCall = append(fake.patchPhraseForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 api.PhrasePatch
 		arg3 uuid.UUID
 		arg4 uuid.UUID
 	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("UpdatePhraseForUserWithUUID", []interface{}{arg1, arg2, arg3, arg4})
-	fake.updatePhraseForUserWithUUIDMutex.Unlock()
-	if fake.UpdatePhraseForUserWithUUIDStub != nil {
-		return fake.UpdatePhraseForUserWithUUIDStub(arg1, arg2, arg3, arg4)
+	fake.recordInvocation("PatchPhraseForUserWithUUID", []interface{}{arg1, arg2, arg3, arg4})
+	fake.patchPhraseForUserWithUUIDMutex.Unlock()
+	if fake.PatchPhraseForUserWithUUIDStub != nil {
+		return fake.PatchPhraseForUserWithUUIDStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.patchPhraseForUserWithUUIDReturns.result1, fake.patchPhraseForUserWithUUIDReturns.result2
+}
+
+func (fake *FakePhrasesRepository) PatchPhraseForUserWithUUIDCallCount() int {
+	fake.patchPhraseForUserWithUUIDMutex.RLock()
+	defer fake.patchPhraseForUserWithUUIDMutex.RUnlock()
+	return len(fake.patchPhraseForUserWithUUIDArgsForCall)
+}
+
+func (fake *FakePhrasesRepository) PatchPhraseForUserWithUUIDArgsForCall(i int) (context.Context, api.PhrasePatch, uuid.UUID, uuid.UUID) {
+	fake.patchPhraseForUserWithUUIDMutex.RLock()
+	defer fake.patchPhraseForUserWithUUIDMutex.RUnlock()
+	return fake.patchPhraseForUserWithUUIDArgsForCall[i].arg1, fake.patchPhraseForUserWithUUIDArgsForCall[i].arg2, fake.patchPhraseForUserWithUUIDArgsForCall[i].arg3, fake.patchPhraseForUserWithUUIDArgsForCall[i].arg4
+}
+
+func (fake *FakePhrasesRepository) PatchPhraseForUserWithUUIDReturns(result1 api.Phrase, result2 error) {
+	fake.PatchPhraseForUserWithUUIDStub = nil
+	fake.patchPhraseForUserWithUUIDReturns = struct {
+		result1 api.Phrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePhrasesRepository) PatchPhraseForUserWithUUIDReturnsOnCall(i int, result1 api.Phrase, result2 error) {
+	fake.PatchPhraseForUserWithUUIDStub = nil
+	if fake.patchPhraseForUserWithUUIDReturnsOnCall == nil {
+		fake.patchPhraseForUserWithUUIDReturnsOnCall = make(map[int]struct {
+			result1 api.Phrase
+			result2 error
+		})
+	}
+	fake.patchPhraseForUserWithUUIDReturnsOnCall[i] = struct {
+		result1 api.Phrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePhrasesRepository) MergePhrasesForUserWithUUID(arg1 context.Context, arg2 uuid.UUID, arg3 []uuid.UUID, arg4 uuid.UUID) (api.Phrase, error) {
+	fake.mergePhrasesForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.mergePhrasesForUserWithUUIDReturnsOnCall[len(fake.mergePhrasesForUserWithUUIDArgsForCall)]
+	fake.mergePhrasesForUserWithUUIDArgsForCall = append(fake.mergePhrasesForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 []uuid.UUID
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("MergePhrasesForUserWithUUID", []interface{}{arg1, arg2, arg3, arg4})
+	fake.mergePhrasesForUserWithUUIDMutex.Unlock()
+	if fake.MergePhrasesForUserWithUUIDStub != nil {
+		return fake.MergePhrasesForUserWithUUIDStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.mergePhrasesForUserWithUUIDReturns.result1, fake.mergePhrasesForUserWithUUIDReturns.result2
+}
+
+func (fake *FakePhrasesRepository) MergePhrasesForUserWithUUIDCallCount() int {
+	fake.mergePhrasesForUserWithUUIDMutex.RLock()
+	defer fake.mergePhrasesForUserWithUUIDMutex.RUnlock()
+	return len(fake.mergePhrasesForUserWithUUIDArgsForCall)
+}
+
+func (fake *FakePhrasesRepository) MergePhrasesForUserWithUUIDArgsForCall(i int) (context.Context, uuid.UUID, []uuid.UUID, uuid.UUID) {
+	fake.mergePhrasesForUserWithUUIDMutex.RLock()
+	defer fake.mergePhrasesForUserWithUUIDMutex.RUnlock()
+	return fake.mergePhrasesForUserWithUUIDArgsForCall[i].arg1, fake.mergePhrasesForUserWithUUIDArgsForCall[i].arg2, fake.mergePhrasesForUserWithUUIDArgsForCall[i].arg3, fake.mergePhrasesForUserWithUUIDArgsForCall[i].arg4
+}
+
+func (fake *FakePhrasesRepository) MergePhrasesForUserWithUUIDReturns(result1 api.Phrase, result2 error) {
+	fake.MergePhrasesForUserWithUUIDStub = nil
+	fake.mergePhrasesForUserWithUUIDReturns = struct {
+		result1 api.Phrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePhrasesRepository) MergePhrasesForUserWithUUIDReturnsOnCall(i int, result1 api.Phrase, result2 error) {
+	fake.MergePhrasesForUserWithUUIDStub = nil
+	if fake.mergePhrasesForUserWithUUIDReturnsOnCall == nil {
+		fake.mergePhrasesForUserWithUUIDReturnsOnCall = make(map[int]struct {
+			result1 api.Phrase
+			result2 error
+		})
+	}
+	fake.mergePhrasesForUserWithUUIDReturnsOnCall[i] = struct {
+		result1 api.Phrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePhrasesRepository) PhraseHistoryForUserWithUUID(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) ([]api.PhraseRevision, error) {
+	fake.phraseHistoryForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.phraseHistoryForUserWithUUIDReturnsOnCall[len(fake.phraseHistoryForUserWithUUIDArgsForCall)]
+	fake.phraseHistoryForUserWithUUIDArgsForCall = append(fake.phraseHistoryForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PhraseHistoryForUserWithUUID", []interface{}{arg1, arg2, arg3})
+	fake.phraseHistoryForUserWithUUIDMutex.Unlock()
+	if fake.PhraseHistoryForUserWithUUIDStub != nil {
+		return fake.PhraseHistoryForUserWithUUIDStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.phraseHistoryForUserWithUUIDReturns.result1, fake.phraseHistoryForUserWithUUIDReturns.result2
+}
+
+func (fake *FakePhrasesRepository) PhraseHistoryForUserWithUUIDCallCount() int {
+	fake.phraseHistoryForUserWithUUIDMutex.RLock()
+	defer fake.phraseHistoryForUserWithUUIDMutex.RUnlock()
+	return len(fake.phraseHistoryForUserWithUUIDArgsForCall)
+}
+
+func (fake *FakePhrasesRepository) PhraseHistoryForUserWithUUIDArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.phraseHistoryForUserWithUUIDMutex.RLock()
+	defer fake.phraseHistoryForUserWithUUIDMutex.RUnlock()
+	return fake.phraseHistoryForUserWithUUIDArgsForCall[i].arg1, fake.phraseHistoryForUserWithUUIDArgsForCall[i].arg2, fake.phraseHistoryForUserWithUUIDArgsForCall[i].arg3
+}
+
+func (fake *FakePhrasesRepository) PhraseHistoryForUserWithUUIDReturns(result1 []api.PhraseRevision, result2 error) {
+	fake.PhraseHistoryForUserWithUUIDStub = nil
+	fake.phraseHistoryForUserWithUUIDReturns = struct {
+		result1 []api.PhraseRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePhrasesRepository) PhraseHistoryForUserWithUUIDReturnsOnCall(i int, result1 []api.PhraseRevision, result2 error) {
+	fake.PhraseHistoryForUserWithUUIDStub = nil
+	if fake.phraseHistoryForUserWithUUIDReturnsOnCall == nil {
+		fake.phraseHistoryForUserWithUUIDReturnsOnCall = make(map[int]struct {
+			result1 []api.PhraseRevision
+			result2 error
+		})
+	}
+	fake.phraseHistoryForUserWithUUIDReturnsOnCall[i] = struct {
+		result1 []api.PhraseRevision
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePhrasesRepository) RevertPhraseForUserWithUUID(arg1 context.Context, arg2 uuid.UUID, arg3 uint, arg4 uuid.UUID) (api.Phrase, error) {
+	fake.revertPhraseForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.revertPhraseForUserWithUUIDReturnsOnCall[len(fake.revertPhraseForUserWithUUIDArgsForCall)]
+	fake.revertPhraseForUserWithUUIDArgsForCall = append(fake.revertPhraseForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uint
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RevertPhraseForUserWithUUID", []interface{}{arg1, arg2, arg3, arg4})
+	fake.revertPhraseForUserWithUUIDMutex.Unlock()
+	if fake.RevertPhraseForUserWithUUIDStub != nil {
+		return fake.RevertPhraseForUserWithUUIDStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.updatePhraseForUserWithUUIDReturns.result1, fake.updatePhraseForUserWithUUIDReturns.result2
+	return fake.revertPhraseForUserWithUUIDReturns.result1, fake.revertPhraseForUserWithUUIDReturns.result2
 }
 
-func (fake *FakePhrasesRepository) UpdatePhraseForUserWithUUIDCallCount() int {
-	fake.updatePhraseForUserWithUUIDMutex.RLock()
-	defer fake.updatePhraseForUserWithUUIDMutex.RUnlock()
-	return len(fake.updatePhraseForUserWithUUIDArgsForCall)
+func (fake *FakePhrasesRepository) RevertPhraseForUserWithUUIDCallCount() int {
+	fake.revertPhraseForUserWithUUIDMutex.RLock()
+	defer fake.revertPhraseForUserWithUUIDMutex.RUnlock()
+	return len(fake.revertPhraseForUserWithUUIDArgsForCall)
 }
 
-func (fake *FakePhrasesRepository) UpdatePhraseForUserWithUUIDArgsForCall(i int) (string, string, uuid.UUID, uuid.UUID) {
-	fake.updatePhraseForUserWithUUIDMutex.RLock()
-	defer fake.updatePhraseForUserWithUUIDMutex.RUnlock()
-	return fake.updatePhraseForUserWithUUIDArgsForCall[i].arg1, fake.updatePhraseForUserWithUUIDArgsForCall[i].arg2, fake.updatePhraseForUserWithUUIDArgsForCall[i].arg3, fake.updatePhraseForUserWithUUIDArgsForCall[i].arg4
+func (fake *FakePhrasesRepository) RevertPhraseForUserWithUUIDArgsForCall(i int) (context.Context, uuid.UUID, uint, uuid.UUID) {
+	fake.revertPhraseForUserWithUUIDMutex.RLock()
+	defer fake.revertPhraseForUserWithUUIDMutex.RUnlock()
+	return fake.revertPhraseForUserWithUUIDArgsForCall[i].arg1, fake.revertPhraseForUserWithUUIDArgsForCall[i].arg2, fake.revertPhraseForUserWithUUIDArgsForCall[i].arg3, fake.revertPhraseForUserWithUUIDArgsForCall[i].arg4
 }
 
-func (fake *FakePhrasesRepository) UpdatePhraseForUserWithUUIDReturns(result1 api.Phrase, result2 error) {
-	fake.UpdatePhraseForUserWithUUIDStub = nil
-	fake.updatePhraseForUserWithUUIDReturns = struct {
+func (fake *FakePhrasesRepository) RevertPhraseForUserWithUUIDReturns(result1 api.Phrase, result2 error) {
+	fake.RevertPhraseForUserWithUUIDStub = nil
+	fake.revertPhraseForUserWithUUIDReturns = struct {
 		result1 api.Phrase
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakePhrasesRepository) UpdatePhraseForUserWithUUIDReturnsOnCall(i int, result1 api.Phrase, result2 error) {
-	fake.UpdatePhraseForUserWithUUIDStub = nil
-	if fake.updatePhraseForUserWithUUIDReturnsOnCall == nil {
-		fake.updatePhraseForUserWithUUIDReturnsOnCall = make(map[int]struct {
+func (fake *FakePhrasesRepository) RevertPhraseForUserWithUUIDReturnsOnCall(i int, result1 api.Phrase, result2 error) {
+	fake.RevertPhraseForUserWithUUIDStub = nil
+	if fake.revertPhraseForUserWithUUIDReturnsOnCall == nil {
+		fake.revertPhraseForUserWithUUIDReturnsOnCall = make(map[int]struct {
 			result1 api.Phrase
 			result2 error
 		})
 	}
-	fake.updatePhraseForUserWithUUIDReturnsOnCall[i] = struct {
+	fake.revertPhraseForUserWithUUIDReturnsOnCall[i] = struct {
 		result1 api.Phrase
 		result2 error
 	}{result1, result2}
@@ -220,10 +501,18 @@ func (fake *FakePhrasesRepository) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.phrasesForUserWithUUIDMutex.RLock()
 	defer fake.phrasesForUserWithUUIDMutex.RUnlock()
-	fake.addPhraseForUserWithUUIDMutex.RLock()
-	defer fake.addPhraseForUserWithUUIDMutex.RUnlock()
-	fake.updatePhraseForUserWithUUIDMutex.RLock()
-	defer fake.updatePhraseForUserWithUUIDMutex.RUnlock()
+	fake.phraseForUserWithUUIDMutex.RLock()
+	defer fake.phraseForUserWithUUIDMutex.RUnlock()
+	fake.syncPhrasesForUserWithUUIDMutex.RLock()
+	defer fake.syncPhrasesForUserWithUUIDMutex.RUnlock()
+	fake.patchPhraseForUserWithUUIDMutex.RLock()
+	defer fake.patchPhraseForUserWithUUIDMutex.RUnlock()
+	fake.mergePhrasesForUserWithUUIDMutex.RLock()
+	defer fake.mergePhrasesForUserWithUUIDMutex.RUnlock()
+	fake.phraseHistoryForUserWithUUIDMutex.RLock()
+	defer fake.phraseHistoryForUserWithUUIDMutex.RUnlock()
+	fake.revertPhraseForUserWithUUIDMutex.RLock()
+	defer fake.revertPhraseForUserWithUUIDMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -0,0 +1,100 @@
+// This file was generated by counterfeiter
+package apifakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+type FakePracticeRepository struct {
+	RecordSessionForUserWithUUIDStub        func(context.Context, api.PracticeSession, uuid.UUID) error
+	recordSessionForUserWithUUIDMutex       sync.RWMutex
+	recordSessionForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 api.PracticeSession
+		arg3 uuid.UUID
+	}
+	recordSessionForUserWithUUIDReturns struct {
+		result1 error
+	}
+	recordSessionForUserWithUUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePracticeRepository) RecordSessionForUserWithUUID(arg1 context.Context, arg2 api.PracticeSession, arg3 uuid.UUID) error {
+	fake.recordSessionForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.recordSessionForUserWithUUIDReturnsOnCall[len(fake.recordSessionForUserWithUUIDArgsForCall)]
+	fake.recordSessionForUserWithUUIDArgsForCall = append(fake.recordSessionForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 api.PracticeSession
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RecordSessionForUserWithUUID", []interface{}{arg1, arg2, arg3})
+	fake.recordSessionForUserWithUUIDMutex.Unlock()
+	if fake.RecordSessionForUserWithUUIDStub != nil {
+		return fake.RecordSessionForUserWithUUIDStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.recordSessionForUserWithUUIDReturns.result1
+}
+
+func (fake *FakePracticeRepository) RecordSessionForUserWithUUIDCallCount() int {
+	fake.recordSessionForUserWithUUIDMutex.RLock()
+	defer fake.recordSessionForUserWithUUIDMutex.RUnlock()
+	return len(fake.recordSessionForUserWithUUIDArgsForCall)
+}
+
+func (fake *FakePracticeRepository) RecordSessionForUserWithUUIDArgsForCall(i int) (context.Context, api.PracticeSession, uuid.UUID) {
+	fake.recordSessionForUserWithUUIDMutex.RLock()
+	defer fake.recordSessionForUserWithUUIDMutex.RUnlock()
+	return fake.recordSessionForUserWithUUIDArgsForCall[i].arg1, fake.recordSessionForUserWithUUIDArgsForCall[i].arg2, fake.recordSessionForUserWithUUIDArgsForCall[i].arg3
+}
+
+func (fake *FakePracticeRepository) RecordSessionForUserWithUUIDReturns(result1 error) {
+	fake.RecordSessionForUserWithUUIDStub = nil
+	fake.recordSessionForUserWithUUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePracticeRepository) RecordSessionForUserWithUUIDReturnsOnCall(i int, result1 error) {
+	fake.RecordSessionForUserWithUUIDStub = nil
+	if fake.recordSessionForUserWithUUIDReturnsOnCall == nil {
+		fake.recordSessionForUserWithUUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordSessionForUserWithUUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePracticeRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.recordSessionForUserWithUUIDMutex.RLock()
+	defer fake.recordSessionForUserWithUUIDMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePracticeRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ api.PracticeRepository = new(FakePracticeRepository)
@@ -0,0 +1,241 @@
+// This file was generated by counterfeiter
+package apifakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+type FakeProgressRepository struct {
+	SettingsForUserStub        func(context.Context, uuid.UUID) (api.UserSettings, error)
+	settingsForUserMutex       sync.RWMutex
+	settingsForUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	settingsForUserReturns struct {
+		result1 api.UserSettings
+		result2 error
+	}
+	settingsForUserReturnsOnCall map[int]struct {
+		result1 api.UserSettings
+		result2 error
+	}
+	UpdateSettingsForUserStub        func(context.Context, api.SettingsPatch, uuid.UUID) (api.UserSettings, error)
+	updateSettingsForUserMutex       sync.RWMutex
+	updateSettingsForUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 api.SettingsPatch
+		arg3 uuid.UUID
+	}
+	updateSettingsForUserReturns struct {
+		result1 api.UserSettings
+		result2 error
+	}
+	updateSettingsForUserReturnsOnCall map[int]struct {
+		result1 api.UserSettings
+		result2 error
+	}
+	ProgressForUserStub        func(context.Context, uuid.UUID) ([]api.DailyProgress, error)
+	progressForUserMutex       sync.RWMutex
+	progressForUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	progressForUserReturns struct {
+		result1 []api.DailyProgress
+		result2 error
+	}
+	progressForUserReturnsOnCall map[int]struct {
+		result1 []api.DailyProgress
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeProgressRepository) SettingsForUser(arg1 context.Context, arg2 uuid.UUID) (api.UserSettings, error) {
+	fake.settingsForUserMutex.Lock()
+	ret, specificReturn := fake.settingsForUserReturnsOnCall[len(fake.settingsForUserArgsForCall)]
+	fake.settingsForUserArgsForCall = append(fake.settingsForUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("SettingsForUser", []interface{}{arg1, arg2})
+	fake.settingsForUserMutex.Unlock()
+	if fake.SettingsForUserStub != nil {
+		return fake.SettingsForUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.settingsForUserReturns.result1, fake.settingsForUserReturns.result2
+}
+
+func (fake *FakeProgressRepository) SettingsForUserCallCount() int {
+	fake.settingsForUserMutex.RLock()
+	defer fake.settingsForUserMutex.RUnlock()
+	return len(fake.settingsForUserArgsForCall)
+}
+
+func (fake *FakeProgressRepository) SettingsForUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.settingsForUserMutex.RLock()
+	defer fake.settingsForUserMutex.RUnlock()
+	return fake.settingsForUserArgsForCall[i].arg1, fake.settingsForUserArgsForCall[i].arg2
+}
+
+func (fake *FakeProgressRepository) SettingsForUserReturns(result1 api.UserSettings, result2 error) {
+	fake.SettingsForUserStub = nil
+	fake.settingsForUserReturns = struct {
+		result1 api.UserSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProgressRepository) SettingsForUserReturnsOnCall(i int, result1 api.UserSettings, result2 error) {
+	fake.SettingsForUserStub = nil
+	if fake.settingsForUserReturnsOnCall == nil {
+		fake.settingsForUserReturnsOnCall = make(map[int]struct {
+			result1 api.UserSettings
+			result2 error
+		})
+	}
+	fake.settingsForUserReturnsOnCall[i] = struct {
+		result1 api.UserSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProgressRepository) UpdateSettingsForUser(arg1 context.Context, arg2 api.SettingsPatch, arg3 uuid.UUID) (api.UserSettings, error) {
+	fake.updateSettingsForUserMutex.Lock()
+	ret, specificReturn := fake.updateSettingsForUserReturnsOnCall[len(fake.updateSettingsForUserArgsForCall)]
+	fake.updateSettingsForUserArgsForCall = append(fake.updateSettingsForUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 api.SettingsPatch
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateSettingsForUser", []interface{}{arg1, arg2, arg3})
+	fake.updateSettingsForUserMutex.Unlock()
+	if fake.UpdateSettingsForUserStub != nil {
+		return fake.UpdateSettingsForUserStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateSettingsForUserReturns.result1, fake.updateSettingsForUserReturns.result2
+}
+
+func (fake *FakeProgressRepository) UpdateSettingsForUserCallCount() int {
+	fake.updateSettingsForUserMutex.RLock()
+	defer fake.updateSettingsForUserMutex.RUnlock()
+	return len(fake.updateSettingsForUserArgsForCall)
+}
+
+func (fake *FakeProgressRepository) UpdateSettingsForUserArgsForCall(i int) (context.Context, api.SettingsPatch, uuid.UUID) {
+	fake.updateSettingsForUserMutex.RLock()
+	defer fake.updateSettingsForUserMutex.RUnlock()
+	return fake.updateSettingsForUserArgsForCall[i].arg1, fake.updateSettingsForUserArgsForCall[i].arg2, fake.updateSettingsForUserArgsForCall[i].arg3
+}
+
+func (fake *FakeProgressRepository) UpdateSettingsForUserReturns(result1 api.UserSettings, result2 error) {
+	fake.UpdateSettingsForUserStub = nil
+	fake.updateSettingsForUserReturns = struct {
+		result1 api.UserSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProgressRepository) UpdateSettingsForUserReturnsOnCall(i int, result1 api.UserSettings, result2 error) {
+	fake.UpdateSettingsForUserStub = nil
+	if fake.updateSettingsForUserReturnsOnCall == nil {
+		fake.updateSettingsForUserReturnsOnCall = make(map[int]struct {
+			result1 api.UserSettings
+			result2 error
+		})
+	}
+	fake.updateSettingsForUserReturnsOnCall[i] = struct {
+		result1 api.UserSettings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProgressRepository) ProgressForUser(arg1 context.Context, arg2 uuid.UUID) ([]api.DailyProgress, error) {
+	fake.progressForUserMutex.Lock()
+	ret, specificReturn := fake.progressForUserReturnsOnCall[len(fake.progressForUserArgsForCall)]
+	fake.progressForUserArgsForCall = append(fake.progressForUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("ProgressForUser", []interface{}{arg1, arg2})
+	fake.progressForUserMutex.Unlock()
+	if fake.ProgressForUserStub != nil {
+		return fake.ProgressForUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.progressForUserReturns.result1, fake.progressForUserReturns.result2
+}
+
+func (fake *FakeProgressRepository) ProgressForUserCallCount() int {
+	fake.progressForUserMutex.RLock()
+	defer fake.progressForUserMutex.RUnlock()
+	return len(fake.progressForUserArgsForCall)
+}
+
+func (fake *FakeProgressRepository) ProgressForUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.progressForUserMutex.RLock()
+	defer fake.progressForUserMutex.RUnlock()
+	return fake.progressForUserArgsForCall[i].arg1, fake.progressForUserArgsForCall[i].arg2
+}
+
+func (fake *FakeProgressRepository) ProgressForUserReturns(result1 []api.DailyProgress, result2 error) {
+	fake.ProgressForUserStub = nil
+	fake.progressForUserReturns = struct {
+		result1 []api.DailyProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProgressRepository) ProgressForUserReturnsOnCall(i int, result1 []api.DailyProgress, result2 error) {
+	fake.ProgressForUserStub = nil
+	if fake.progressForUserReturnsOnCall == nil {
+		fake.progressForUserReturnsOnCall = make(map[int]struct {
+			result1 []api.DailyProgress
+			result2 error
+		})
+	}
+	fake.progressForUserReturnsOnCall[i] = struct {
+		result1 []api.DailyProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProgressRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.settingsForUserMutex.RLock()
+	defer fake.settingsForUserMutex.RUnlock()
+	fake.updateSettingsForUserMutex.RLock()
+	defer fake.updateSettingsForUserMutex.RUnlock()
+	fake.progressForUserMutex.RLock()
+	defer fake.progressForUserMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeProgressRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ api.ProgressRepository = new(FakeProgressRepository)
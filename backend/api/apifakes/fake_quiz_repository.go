@@ -0,0 +1,381 @@
+// This file was generated by counterfeiter
+package apifakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+type FakeQuizRepository struct {
+	PhrasesForQuizStub        func(context.Context, uuid.UUID) ([]api.Phrase, error)
+	phrasesForQuizMutex       sync.RWMutex
+	phrasesForQuizArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	phrasesForQuizReturns struct {
+		result1 []api.Phrase
+		result2 error
+	}
+	phrasesForQuizReturnsOnCall map[int]struct {
+		result1 []api.Phrase
+		result2 error
+	}
+	CreateQuizForUserWithUUIDStub        func(context.Context, []api.QuizQuestion, uuid.UUID) (api.Quiz, error)
+	createQuizForUserWithUUIDMutex       sync.RWMutex
+	createQuizForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 []api.QuizQuestion
+		arg3 uuid.UUID
+	}
+	createQuizForUserWithUUIDReturns struct {
+		result1 api.Quiz
+		result2 error
+	}
+	createQuizForUserWithUUIDReturnsOnCall map[int]struct {
+		result1 api.Quiz
+		result2 error
+	}
+	QuizForUserWithUUIDStub        func(context.Context, uuid.UUID, uuid.UUID) (api.Quiz, error)
+	quizForUserWithUUIDMutex       sync.RWMutex
+	quizForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	quizForUserWithUUIDReturns struct {
+		result1 api.Quiz
+		result2 error
+	}
+	quizForUserWithUUIDReturnsOnCall map[int]struct {
+		result1 api.Quiz
+		result2 error
+	}
+	SubmitQuizForUserWithUUIDStub        func(context.Context, uuid.UUID, api.PracticeSession, []api.PhraseReview, uuid.UUID) error
+	submitQuizForUserWithUUIDMutex       sync.RWMutex
+	submitQuizForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 api.PracticeSession
+		arg4 []api.PhraseReview
+		arg5 uuid.UUID
+	}
+	submitQuizForUserWithUUIDReturns struct {
+		result1 error
+	}
+	submitQuizForUserWithUUIDReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteExpiredQuizzesStub        func(context.Context, time.Time) (int64, error)
+	deleteExpiredQuizzesMutex       sync.RWMutex
+	deleteExpiredQuizzesArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	deleteExpiredQuizzesReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteExpiredQuizzesReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeQuizRepository) PhrasesForQuiz(arg1 context.Context, arg2 uuid.UUID) ([]api.Phrase, error) {
+	fake.phrasesForQuizMutex.Lock()
+	ret, specificReturn := fake.phrasesForQuizReturnsOnCall[len(fake.phrasesForQuizArgsForCall)]
+	fake.phrasesForQuizArgsForCall = append(fake.phrasesForQuizArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("PhrasesForQuiz", []interface{}{arg1, arg2})
+	fake.phrasesForQuizMutex.Unlock()
+	if fake.PhrasesForQuizStub != nil {
+		return fake.PhrasesForQuizStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.phrasesForQuizReturns.result1, fake.phrasesForQuizReturns.result2
+}
+
+func (fake *FakeQuizRepository) PhrasesForQuizCallCount() int {
+	fake.phrasesForQuizMutex.RLock()
+	defer fake.phrasesForQuizMutex.RUnlock()
+	return len(fake.phrasesForQuizArgsForCall)
+}
+
+func (fake *FakeQuizRepository) PhrasesForQuizArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.phrasesForQuizMutex.RLock()
+	defer fake.phrasesForQuizMutex.RUnlock()
+	return fake.phrasesForQuizArgsForCall[i].arg1, fake.phrasesForQuizArgsForCall[i].arg2
+}
+
+func (fake *FakeQuizRepository) PhrasesForQuizReturns(result1 []api.Phrase, result2 error) {
+	fake.PhrasesForQuizStub = nil
+	fake.phrasesForQuizReturns = struct {
+		result1 []api.Phrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQuizRepository) PhrasesForQuizReturnsOnCall(i int, result1 []api.Phrase, result2 error) {
+	fake.PhrasesForQuizStub = nil
+	if fake.phrasesForQuizReturnsOnCall == nil {
+		fake.phrasesForQuizReturnsOnCall = make(map[int]struct {
+			result1 []api.Phrase
+			result2 error
+		})
+	}
+	fake.phrasesForQuizReturnsOnCall[i] = struct {
+		result1 []api.Phrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQuizRepository) CreateQuizForUserWithUUID(arg1 context.Context, arg2 []api.QuizQuestion, arg3 uuid.UUID) (api.Quiz, error) {
+	fake.createQuizForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.createQuizForUserWithUUIDReturnsOnCall[len(fake.createQuizForUserWithUUIDArgsForCall)]
+	fake.createQuizForUserWithUUIDArgsForCall = append(fake.createQuizForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 []api.QuizQuestion
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateQuizForUserWithUUID", []interface{}{arg1, arg2, arg3})
+	fake.createQuizForUserWithUUIDMutex.Unlock()
+	if fake.CreateQuizForUserWithUUIDStub != nil {
+		return fake.CreateQuizForUserWithUUIDStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.createQuizForUserWithUUIDReturns.result1, fake.createQuizForUserWithUUIDReturns.result2
+}
+
+func (fake *FakeQuizRepository) CreateQuizForUserWithUUIDCallCount() int {
+	fake.createQuizForUserWithUUIDMutex.RLock()
+	defer fake.createQuizForUserWithUUIDMutex.RUnlock()
+	return len(fake.createQuizForUserWithUUIDArgsForCall)
+}
+
+func (fake *FakeQuizRepository) CreateQuizForUserWithUUIDArgsForCall(i int) (context.Context, []api.QuizQuestion, uuid.UUID) {
+	fake.createQuizForUserWithUUIDMutex.RLock()
+	defer fake.createQuizForUserWithUUIDMutex.RUnlock()
+	return fake.createQuizForUserWithUUIDArgsForCall[i].arg1, fake.createQuizForUserWithUUIDArgsForCall[i].arg2, fake.createQuizForUserWithUUIDArgsForCall[i].arg3
+}
+
+func (fake *FakeQuizRepository) CreateQuizForUserWithUUIDReturns(result1 api.Quiz, result2 error) {
+	fake.CreateQuizForUserWithUUIDStub = nil
+	fake.createQuizForUserWithUUIDReturns = struct {
+		result1 api.Quiz
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQuizRepository) CreateQuizForUserWithUUIDReturnsOnCall(i int, result1 api.Quiz, result2 error) {
+	fake.CreateQuizForUserWithUUIDStub = nil
+	if fake.createQuizForUserWithUUIDReturnsOnCall == nil {
+		fake.createQuizForUserWithUUIDReturnsOnCall = make(map[int]struct {
+			result1 api.Quiz
+			result2 error
+		})
+	}
+	fake.createQuizForUserWithUUIDReturnsOnCall[i] = struct {
+		result1 api.Quiz
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQuizRepository) QuizForUserWithUUID(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) (api.Quiz, error) {
+	fake.quizForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.quizForUserWithUUIDReturnsOnCall[len(fake.quizForUserWithUUIDArgsForCall)]
+	fake.quizForUserWithUUIDArgsForCall = append(fake.quizForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("QuizForUserWithUUID", []interface{}{arg1, arg2, arg3})
+	fake.quizForUserWithUUIDMutex.Unlock()
+	if fake.QuizForUserWithUUIDStub != nil {
+		return fake.QuizForUserWithUUIDStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.quizForUserWithUUIDReturns.result1, fake.quizForUserWithUUIDReturns.result2
+}
+
+func (fake *FakeQuizRepository) QuizForUserWithUUIDCallCount() int {
+	fake.quizForUserWithUUIDMutex.RLock()
+	defer fake.quizForUserWithUUIDMutex.RUnlock()
+	return len(fake.quizForUserWithUUIDArgsForCall)
+}
+
+func (fake *FakeQuizRepository) QuizForUserWithUUIDArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.quizForUserWithUUIDMutex.RLock()
+	defer fake.quizForUserWithUUIDMutex.RUnlock()
+	return fake.quizForUserWithUUIDArgsForCall[i].arg1, fake.quizForUserWithUUIDArgsForCall[i].arg2, fake.quizForUserWithUUIDArgsForCall[i].arg3
+}
+
+func (fake *FakeQuizRepository) QuizForUserWithUUIDReturns(result1 api.Quiz, result2 error) {
+	fake.QuizForUserWithUUIDStub = nil
+	fake.quizForUserWithUUIDReturns = struct {
+		result1 api.Quiz
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQuizRepository) QuizForUserWithUUIDReturnsOnCall(i int, result1 api.Quiz, result2 error) {
+	fake.QuizForUserWithUUIDStub = nil
+	if fake.quizForUserWithUUIDReturnsOnCall == nil {
+		fake.quizForUserWithUUIDReturnsOnCall = make(map[int]struct {
+			result1 api.Quiz
+			result2 error
+		})
+	}
+	fake.quizForUserWithUUIDReturnsOnCall[i] = struct {
+		result1 api.Quiz
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQuizRepository) SubmitQuizForUserWithUUID(arg1 context.Context, arg2 uuid.UUID, arg3 api.PracticeSession, arg4 []api.PhraseReview, arg5 uuid.UUID) error {
+	fake.submitQuizForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.submitQuizForUserWithUUIDReturnsOnCall[len(fake.submitQuizForUserWithUUIDArgsForCall)]
+	fake.submitQuizForUserWithUUIDArgsForCall = append(fake.submitQuizForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 api.PracticeSession
+		arg4 []api.PhraseReview
+		arg5 uuid.UUID
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("SubmitQuizForUserWithUUID", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.submitQuizForUserWithUUIDMutex.Unlock()
+	if fake.SubmitQuizForUserWithUUIDStub != nil {
+		return fake.SubmitQuizForUserWithUUIDStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.submitQuizForUserWithUUIDReturns.result1
+}
+
+func (fake *FakeQuizRepository) SubmitQuizForUserWithUUIDCallCount() int {
+	fake.submitQuizForUserWithUUIDMutex.RLock()
+	defer fake.submitQuizForUserWithUUIDMutex.RUnlock()
+	return len(fake.submitQuizForUserWithUUIDArgsForCall)
+}
+
+func (fake *FakeQuizRepository) SubmitQuizForUserWithUUIDArgsForCall(i int) (context.Context, uuid.UUID, api.PracticeSession, []api.PhraseReview, uuid.UUID) {
+	fake.submitQuizForUserWithUUIDMutex.RLock()
+	defer fake.submitQuizForUserWithUUIDMutex.RUnlock()
+	return fake.submitQuizForUserWithUUIDArgsForCall[i].arg1, fake.submitQuizForUserWithUUIDArgsForCall[i].arg2, fake.submitQuizForUserWithUUIDArgsForCall[i].arg3, fake.submitQuizForUserWithUUIDArgsForCall[i].arg4, fake.submitQuizForUserWithUUIDArgsForCall[i].arg5
+}
+
+func (fake *FakeQuizRepository) SubmitQuizForUserWithUUIDReturns(result1 error) {
+	fake.SubmitQuizForUserWithUUIDStub = nil
+	fake.submitQuizForUserWithUUIDReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQuizRepository) SubmitQuizForUserWithUUIDReturnsOnCall(i int, result1 error) {
+	fake.SubmitQuizForUserWithUUIDStub = nil
+	if fake.submitQuizForUserWithUUIDReturnsOnCall == nil {
+		fake.submitQuizForUserWithUUIDReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.submitQuizForUserWithUUIDReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeQuizRepository) DeleteExpiredQuizzes(arg1 context.Context, arg2 time.Time) (int64, error) {
+	fake.deleteExpiredQuizzesMutex.Lock()
+	ret, specificReturn := fake.deleteExpiredQuizzesReturnsOnCall[len(fake.deleteExpiredQuizzesArgsForCall)]
+	fake.deleteExpiredQuizzesArgsForCall = append(fake.deleteExpiredQuizzesArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteExpiredQuizzes", []interface{}{arg1, arg2})
+	fake.deleteExpiredQuizzesMutex.Unlock()
+	if fake.DeleteExpiredQuizzesStub != nil {
+		return fake.DeleteExpiredQuizzesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.deleteExpiredQuizzesReturns.result1, fake.deleteExpiredQuizzesReturns.result2
+}
+
+func (fake *FakeQuizRepository) DeleteExpiredQuizzesCallCount() int {
+	fake.deleteExpiredQuizzesMutex.RLock()
+	defer fake.deleteExpiredQuizzesMutex.RUnlock()
+	return len(fake.deleteExpiredQuizzesArgsForCall)
+}
+
+func (fake *FakeQuizRepository) DeleteExpiredQuizzesArgsForCall(i int) (context.Context, time.Time) {
+	fake.deleteExpiredQuizzesMutex.RLock()
+	defer fake.deleteExpiredQuizzesMutex.RUnlock()
+	return fake.deleteExpiredQuizzesArgsForCall[i].arg1, fake.deleteExpiredQuizzesArgsForCall[i].arg2
+}
+
+func (fake *FakeQuizRepository) DeleteExpiredQuizzesReturns(result1 int64, result2 error) {
+	fake.DeleteExpiredQuizzesStub = nil
+	fake.deleteExpiredQuizzesReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQuizRepository) DeleteExpiredQuizzesReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.DeleteExpiredQuizzesStub = nil
+	if fake.deleteExpiredQuizzesReturnsOnCall == nil {
+		fake.deleteExpiredQuizzesReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.deleteExpiredQuizzesReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQuizRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.phrasesForQuizMutex.RLock()
+	defer fake.phrasesForQuizMutex.RUnlock()
+	fake.createQuizForUserWithUUIDMutex.RLock()
+	defer fake.createQuizForUserWithUUIDMutex.RUnlock()
+	fake.quizForUserWithUUIDMutex.RLock()
+	defer fake.quizForUserWithUUIDMutex.RUnlock()
+	fake.submitQuizForUserWithUUIDMutex.RLock()
+	defer fake.submitQuizForUserWithUUIDMutex.RUnlock()
+	fake.deleteExpiredQuizzesMutex.RLock()
+	defer fake.deleteExpiredQuizzesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeQuizRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ api.QuizRepository = new(FakeQuizRepository)
@@ -0,0 +1,103 @@
+// This file was generated by counterfeiter
+package apifakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+type FakeSearchRepository struct {
+	AllPhrasesForUserWithUUIDStub        func(context.Context, uuid.UUID) ([]api.SearchablePhrase, error)
+	allPhrasesForUserWithUUIDMutex       sync.RWMutex
+	allPhrasesForUserWithUUIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	allPhrasesForUserWithUUIDReturns struct {
+		result1 []api.SearchablePhrase
+		result2 error
+	}
+	allPhrasesForUserWithUUIDReturnsOnCall map[int]struct {
+		result1 []api.SearchablePhrase
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSearchRepository) AllPhrasesForUserWithUUID(arg1 context.Context, arg2 uuid.UUID) ([]api.SearchablePhrase, error) {
+	fake.allPhrasesForUserWithUUIDMutex.Lock()
+	ret, specificReturn := fake.allPhrasesForUserWithUUIDReturnsOnCall[len(fake.allPhrasesForUserWithUUIDArgsForCall)]
+	fake.allPhrasesForUserWithUUIDArgsForCall = append(fake.allPhrasesForUserWithUUIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("AllPhrasesForUserWithUUID", []interface{}{arg1, arg2})
+	fake.allPhrasesForUserWithUUIDMutex.Unlock()
+	if fake.AllPhrasesForUserWithUUIDStub != nil {
+		return fake.AllPhrasesForUserWithUUIDStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.allPhrasesForUserWithUUIDReturns.result1, fake.allPhrasesForUserWithUUIDReturns.result2
+}
+
+func (fake *FakeSearchRepository) AllPhrasesForUserWithUUIDCallCount() int {
+	fake.allPhrasesForUserWithUUIDMutex.RLock()
+	defer fake.allPhrasesForUserWithUUIDMutex.RUnlock()
+	return len(fake.allPhrasesForUserWithUUIDArgsForCall)
+}
+
+func (fake *FakeSearchRepository) AllPhrasesForUserWithUUIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.allPhrasesForUserWithUUIDMutex.RLock()
+	defer fake.allPhrasesForUserWithUUIDMutex.RUnlock()
+	return fake.allPhrasesForUserWithUUIDArgsForCall[i].arg1, fake.allPhrasesForUserWithUUIDArgsForCall[i].arg2
+}
+
+func (fake *FakeSearchRepository) AllPhrasesForUserWithUUIDReturns(result1 []api.SearchablePhrase, result2 error) {
+	fake.AllPhrasesForUserWithUUIDStub = nil
+	fake.allPhrasesForUserWithUUIDReturns = struct {
+		result1 []api.SearchablePhrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSearchRepository) AllPhrasesForUserWithUUIDReturnsOnCall(i int, result1 []api.SearchablePhrase, result2 error) {
+	fake.AllPhrasesForUserWithUUIDStub = nil
+	if fake.allPhrasesForUserWithUUIDReturnsOnCall == nil {
+		fake.allPhrasesForUserWithUUIDReturnsOnCall = make(map[int]struct {
+			result1 []api.SearchablePhrase
+			result2 error
+		})
+	}
+	fake.allPhrasesForUserWithUUIDReturnsOnCall[i] = struct {
+		result1 []api.SearchablePhrase
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSearchRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.allPhrasesForUserWithUUIDMutex.RLock()
+	defer fake.allPhrasesForUserWithUUIDMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSearchRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ api.SearchRepository = new(FakeSearchRepository)
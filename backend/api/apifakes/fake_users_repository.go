@@ -0,0 +1,176 @@
+// This file was generated by counterfeiter
+package apifakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+type FakeUsersRepository struct {
+	RecordActivityStub        func(context.Context, uuid.UUID) (bool, error)
+	recordActivityMutex       sync.RWMutex
+	recordActivityArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	recordActivityReturns struct {
+		result1 bool
+		result2 error
+	}
+	recordActivityReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	StaffRoleForUserStub        func(context.Context, uuid.UUID) (api.Role, []byte, error)
+	staffRoleForUserMutex       sync.RWMutex
+	staffRoleForUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	staffRoleForUserReturns struct {
+		result1 api.Role
+		result2 []byte
+		result3 error
+	}
+	staffRoleForUserReturnsOnCall map[int]struct {
+		result1 api.Role
+		result2 []byte
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUsersRepository) RecordActivity(arg1 context.Context, arg2 uuid.UUID) (bool, error) {
+	fake.recordActivityMutex.Lock()
+	ret, specificReturn := fake.recordActivityReturnsOnCall[len(fake.recordActivityArgsForCall)]
+	fake.recordActivityArgsForCall = append(fake.recordActivityArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("RecordActivity", []interface{}{arg1, arg2})
+	fake.recordActivityMutex.Unlock()
+	if fake.RecordActivityStub != nil {
+		return fake.RecordActivityStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.recordActivityReturns.result1, fake.recordActivityReturns.result2
+}
+
+func (fake *FakeUsersRepository) RecordActivityCallCount() int {
+	fake.recordActivityMutex.RLock()
+	defer fake.recordActivityMutex.RUnlock()
+	return len(fake.recordActivityArgsForCall)
+}
+
+func (fake *FakeUsersRepository) RecordActivityArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.recordActivityMutex.RLock()
+	defer fake.recordActivityMutex.RUnlock()
+	return fake.recordActivityArgsForCall[i].arg1, fake.recordActivityArgsForCall[i].arg2
+}
+
+func (fake *FakeUsersRepository) RecordActivityReturns(result1 bool, result2 error) {
+	fake.RecordActivityStub = nil
+	fake.recordActivityReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUsersRepository) RecordActivityReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.RecordActivityStub = nil
+	if fake.recordActivityReturnsOnCall == nil {
+		fake.recordActivityReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.recordActivityReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUsersRepository) StaffRoleForUser(arg1 context.Context, arg2 uuid.UUID) (api.Role, []byte, error) {
+	fake.staffRoleForUserMutex.Lock()
+	ret, specificReturn := fake.staffRoleForUserReturnsOnCall[len(fake.staffRoleForUserArgsForCall)]
+	fake.staffRoleForUserArgsForCall = append(fake.staffRoleForUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	fake.recordInvocation("StaffRoleForUser", []interface{}{arg1, arg2})
+	fake.staffRoleForUserMutex.Unlock()
+	if fake.StaffRoleForUserStub != nil {
+		return fake.StaffRoleForUserStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.staffRoleForUserReturns.result1, fake.staffRoleForUserReturns.result2, fake.staffRoleForUserReturns.result3
+}
+
+func (fake *FakeUsersRepository) StaffRoleForUserCallCount() int {
+	fake.staffRoleForUserMutex.RLock()
+	defer fake.staffRoleForUserMutex.RUnlock()
+	return len(fake.staffRoleForUserArgsForCall)
+}
+
+func (fake *FakeUsersRepository) StaffRoleForUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.staffRoleForUserMutex.RLock()
+	defer fake.staffRoleForUserMutex.RUnlock()
+	return fake.staffRoleForUserArgsForCall[i].arg1, fake.staffRoleForUserArgsForCall[i].arg2
+}
+
+func (fake *FakeUsersRepository) StaffRoleForUserReturns(result1 api.Role, result2 []byte, result3 error) {
+	fake.StaffRoleForUserStub = nil
+	fake.staffRoleForUserReturns = struct {
+		result1 api.Role
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUsersRepository) StaffRoleForUserReturnsOnCall(i int, result1 api.Role, result2 []byte, result3 error) {
+	fake.StaffRoleForUserStub = nil
+	if fake.staffRoleForUserReturnsOnCall == nil {
+		fake.staffRoleForUserReturnsOnCall = make(map[int]struct {
+			result1 api.Role
+			result2 []byte
+			result3 error
+		})
+	}
+	fake.staffRoleForUserReturnsOnCall[i] = struct {
+		result1 api.Role
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUsersRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.recordActivityMutex.RLock()
+	defer fake.recordActivityMutex.RUnlock()
+	fake.staffRoleForUserMutex.RLock()
+	defer fake.staffRoleForUserMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeUsersRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ api.UsersRepository = new(FakeUsersRepository)
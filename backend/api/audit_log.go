@@ -0,0 +1,282 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditAction string
+
+const AUDIT_PHRASE_CREATE AuditAction = "phrase.create"
+const AUDIT_PHRASE_UPDATE AuditAction = "phrase.update"
+const AUDIT_PHRASE_MERGE AuditAction = "phrase.merge"
+const AUDIT_PHRASE_DELETE AuditAction = "phrase.delete"
+const AUDIT_PHRASE_REVERT AuditAction = "phrase.revert"
+const AUDIT_PHRASE_EXAMPLES AuditAction = "phrase.examples"
+const AUDIT_USER_DISABLE AuditAction = "user.disable"
+const AUDIT_USER_ENABLE AuditAction = "user.enable"
+const AUDIT_USER_ROLE AuditAction = "user.role"
+
+// Actor is who a change is being made by, as far as the audit log
+// and phrase history are concerned. The whole thing is empty for changes
+// made outside of a request, like `main grant-role`
+type Actor struct {
+	UserUUID  *uuid.UUID
+	Role      Role
+	Device    string
+	RequestID string
+}
+
+type actorKey struct{}
+
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
+
+type AuditEntry struct {
+	ID         uint64          `json:"id"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	ActorUUID  string          `json:"actorUuid,omitempty"`
+	ActorRole  Role            `json:"actorRole,omitempty"`
+	Action     AuditAction     `json:"action"`
+	UserUUID   string          `json:"userUuid,omitempty"`
+	PhraseUUID string          `json:"phraseUuid,omitempty"`
+	Before     json.RawMessage `json:"before"`
+	After      json.RawMessage `json:"after"`
+	RequestID  string          `json:"requestId,omitempty"`
+}
+
+// AuditQuery filters the audit log; empty fields match everything.
+// Entries come newest first, and Before (an entry id) fetches the next page
+type AuditQuery struct {
+	UserUUID   string
+	PhraseUUID string
+	ActorUUID  string
+	Action     AuditAction
+	Since      *time.Time
+	Until      *time.Time
+	Before     uint64
+	Limit      int
+}
+
+//go:generate counterfeiter . AuditRepository
+type AuditRepository interface {
+	Entries(context.Context, AuditQuery) ([]AuditEntry, error)
+}
+
+func NewAuditRepository(db *sql.DB) AuditRepository {
+	return &auditRepo{db: db}
+}
+
+type auditRepo struct {
+	db *sql.DB
+}
+
+func (repo *auditRepo) Entries(ctx context.Context, query AuditQuery) ([]AuditEntry, error) {
+	clauses := []string{"TRUE"}
+	args := []interface{}{}
+	for _, filter := range []struct {
+		column string
+		value  string
+	}{
+		{"user_uuid", query.UserUUID},
+		{"phrase_uuid", query.PhraseUUID},
+		{"actor_uuid", query.ActorUUID},
+		{"action", string(query.Action)},
+	} {
+		if filter.value != "" {
+			clauses = append(clauses, filter.column+" = ?")
+			args = append(args, filter.value)
+		}
+	}
+	if query.Since != nil {
+		clauses = append(clauses, "created_at >= ?")
+		args = append(args, *query.Since)
+	}
+	if query.Until != nil {
+		clauses = append(clauses, "created_at < ?")
+		args = append(args, *query.Until)
+	}
+	if query.Before > 0 {
+		clauses = append(clauses, "id < ?")
+		args = append(args, query.Before)
+	}
+	args = append(args, query.Limit)
+
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
+		`SELECT id, created_at, COALESCE(actor_uuid, ''), actor_role, action, COALESCE(user_uuid, ''), COALESCE(phrase_uuid, ''), before_value, after_value, request_id
+		FROM audit_log WHERE `+strings.Join(clauses, " AND ")+" ORDER BY id DESC LIMIT ?",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []AuditEntry{}
+	for rows.Next() {
+		entry := AuditEntry{}
+		var before, after sql.NullString
+		if err := rows.Scan(
+			&entry.ID,
+			&entry.CreatedAt,
+			&entry.ActorUUID,
+			&entry.ActorRole,
+			&entry.Action,
+			&entry.UserUUID,
+			&entry.PhraseUUID,
+			&before,
+			&after,
+			&entry.RequestID,
+		); err != nil {
+			return nil, err
+		}
+		if before.Valid {
+			entry.Before = json.RawMessage(before.String)
+		}
+		if after.Valid {
+			entry.After = json.RawMessage(after.String)
+		}
+		results = append(results, entry)
+	}
+
+	return results, rows.Err()
+}
+
+type auditRecord struct {
+	action     AuditAction
+	userUuid   string
+	phraseUuid string
+	before     interface{}
+	after      interface{}
+}
+
+// recordAudit appends to the audit log inside the transaction making the change,
+// so there is never a change without an entry or an entry without a change.
+// Nothing ever updates or deletes from the audit log
+func recordAudit(ctx context.Context, tx statementRunner, record auditRecord) error {
+	actor := ActorFromContext(ctx)
+	var actorUuid interface{}
+	if actor.UserUUID != nil {
+		actorUuid = actor.UserUUID.String()
+	}
+
+	before, err := auditValue(record.before)
+	if err != nil {
+		return err
+	}
+	after, err := auditValue(record.after)
+	if err != nil {
+		return err
+	}
+
+	_, err = tracedExec(
+		ctx,
+		tx,
+		`INSERT INTO audit_log (actor_uuid, actor_role, action, user_uuid, phrase_uuid, before_value, after_value, request_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		actorUuid,
+		string(actor.Role),
+		string(record.action),
+		nullIfEmpty(record.userUuid),
+		nullIfEmpty(record.phraseUuid),
+		before,
+		after,
+		actor.RequestID,
+	)
+
+	return err
+}
+
+func auditValue(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(encoded), nil
+}
+
+func nullIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+
+	return value
+}
+
+// phraseSnapshot is what the audit log keeps of a phrase before and after a change
+type phraseSnapshot struct {
+	Type        PhraseType `json:"type"`
+	Content     string     `json:"content"`
+	Translation string     `json:"translation"`
+}
+
+// snapshotPhrase locks the phrase for the rest of the transaction,
+// and is nil when the user has no such phrase
+func snapshotPhrase(ctx context.Context, tx statementRunner, phraseUuid string, userUuid string) (*phraseSnapshot, error) {
+	snapshot := &phraseSnapshot{}
+	err := tracedQueryRow(
+		ctx,
+		tx,
+		"SELECT phrase_type, phrase, translation FROM phrases WHERE uuid = ? AND user_uuid = ? FOR UPDATE",
+		phraseUuid,
+		userUuid,
+	).Scan(
+		&snapshot.Type,
+		&snapshot.Content,
+		&snapshot.Translation,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	return snapshot, err
+}
+
+type accountSnapshot struct {
+	Role           Role       `json:"role"`
+	DisabledAt     *time.Time `json:"disabledAt"`
+	DisabledReason string     `json:"disabledReason"`
+}
+
+// snapshotAccount is nil for users who have never been seen
+func snapshotAccount(ctx context.Context, tx statementRunner, userUuid string) (*accountSnapshot, error) {
+	snapshot := &accountSnapshot{}
+	var disabledAt sql.NullTime
+	err := tracedQueryRow(
+		ctx,
+		tx,
+		"SELECT role, disabled_at, COALESCE(disabled_reason, '') FROM users WHERE uuid = ? FOR UPDATE",
+		userUuid,
+	).Scan(
+		&snapshot.Role,
+		&disabledAt,
+		&snapshot.DisabledReason,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if disabledAt.Valid {
+		snapshot.DisabledAt = &disabledAt.Time
+	}
+
+	return snapshot, err
+}
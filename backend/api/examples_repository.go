@@ -0,0 +1,184 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// PhraseExample is an example sentence, with the phrase it shows being used
+type PhraseExample struct {
+	PhraseUuid  string
+	Content     string
+	Translation string
+	Sentence    string
+}
+
+// ExamplesRepository keeps the example sentences for each phrase, in the
+// order the user gave them. Examples are only ever read alongside their
+// phrase, so the ones left behind when a phrase is deleted or merged
+// away are never seen again
+//
+//go:generate counterfeiter . ExamplesRepository
+type ExamplesRepository interface {
+	ExamplesForPhrase(ctx context.Context, phraseUuid uuid.UUID, userUuid uuid.UUID) ([]string, error)
+	ReplaceExamplesForPhrase(ctx context.Context, phraseUuid uuid.UUID, examples []string, userUuid uuid.UUID) ([]string, error)
+
+	// ExamplesForUser is every example of every phrase the user has
+	ExamplesForUser(context.Context, uuid.UUID) ([]PhraseExample, error)
+}
+
+func NewExamplesRepository(phraseType PhraseType, db *sql.DB) ExamplesRepository {
+	return &examplesRepo{db: db, phraseType: phraseType}
+}
+
+type examplesRepo struct {
+	db         *sql.DB
+	phraseType PhraseType
+}
+
+func (repo *examplesRepo) ExamplesForPhrase(ctx context.Context, phraseUuid uuid.UUID, userUuid uuid.UUID) ([]string, error) {
+	var exists bool
+	err := tracedQueryRow(
+		ctx,
+		repo.db,
+		"SELECT TRUE FROM phrases WHERE uuid = ? AND user_uuid = ? AND phrase_type = ?",
+		phraseUuid.String(),
+		userUuid.String(),
+		string(repo.phraseType),
+	).Scan(&exists)
+	if err == sql.ErrNoRows {
+		return nil, ErrPhraseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return examplesForPhrase(ctx, repo.db, phraseUuid.String(), userUuid.String())
+}
+
+func (repo *examplesRepo) ReplaceExamplesForPhrase(ctx context.Context, phraseUuid uuid.UUID, examples []string, userUuid uuid.UUID) ([]string, error) {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	phrase, err := snapshotPhrase(ctx, tx, phraseUuid.String(), userUuid.String())
+	if err != nil {
+		return nil, err
+	}
+	if phrase == nil || phrase.Type != repo.phraseType {
+		return nil, ErrPhraseNotFound
+	}
+
+	before, err := examplesForPhrase(ctx, tx, phraseUuid.String(), userUuid.String())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tracedExec(
+		ctx,
+		tx,
+		"DELETE FROM phrase_examples WHERE phrase_uuid = ? AND user_uuid = ?",
+		phraseUuid.String(),
+		userUuid.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(examples) > 0 {
+		args := []interface{}{}
+		for i, example := range examples {
+			args = append(args, phraseUuid.String(), i+1, userUuid.String(), example)
+		}
+
+		_, err = tracedExec(
+			ctx,
+			tx,
+			"INSERT INTO phrase_examples (phrase_uuid, position, user_uuid, sentence) VALUES "+
+				strings.TrimSuffix(strings.Repeat("(?, ?, ?, ?), ", len(examples)), ", "),
+			args...,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = recordAudit(ctx, tx, auditRecord{
+		action:     AUDIT_PHRASE_EXAMPLES,
+		userUuid:   userUuid.String(),
+		phraseUuid: phraseUuid.String(),
+		before:     before,
+		after:      examples,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return examples, tx.Commit()
+}
+
+func (repo *examplesRepo) ExamplesForUser(ctx context.Context, userUuid uuid.UUID) ([]PhraseExample, error) {
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
+		`SELECT phrases.uuid, phrases.phrase, phrases.translation, phrase_examples.sentence
+		FROM phrase_examples
+		JOIN phrases ON phrases.uuid = phrase_examples.phrase_uuid AND phrases.user_uuid = phrase_examples.user_uuid
+		WHERE phrase_examples.user_uuid = ? AND phrases.phrase_type = ?
+		ORDER BY phrases.uuid, phrase_examples.position`,
+		userUuid.String(),
+		string(repo.phraseType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []PhraseExample{}
+	for rows.Next() {
+		example := PhraseExample{}
+		if err := rows.Scan(
+			&example.PhraseUuid,
+			&example.Content,
+			&example.Translation,
+			&example.Sentence,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, example)
+	}
+
+	return results, rows.Err()
+}
+
+func examplesForPhrase(ctx context.Context, db statementRunner, phraseUuid string, userUuid string) ([]string, error) {
+	rows, err := tracedQuery(
+		ctx,
+		db,
+		"SELECT sentence FROM phrase_examples WHERE phrase_uuid = ? AND user_uuid = ? ORDER BY position",
+		phraseUuid,
+		userUuid,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []string{}
+	for rows.Next() {
+		var sentence string
+		if err := rows.Scan(&sentence); err != nil {
+			return nil, err
+		}
+		results = append(results, sentence)
+	}
+
+	return results, rows.Err()
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// phraseCursor remembers where the previous page ended: the value of the
+// column being sorted on, plus the uuid to break ties between equal values.
+// It also remembers the query that produced it, since it points at a place
+// in that query's results and would skip or repeat rows in any other
+type phraseCursor struct {
+	Sort       PhraseSort `json:"s"`
+	Descending bool       `json:"d"`
+	Search     string     `json:"q"`
+	Value      string     `json:"v"`
+	Uuid       string     `json:"u"`
+}
+
+func encodePhraseCursor(cursor phraseCursor) string {
+	bytes, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(bytes)
+}
+
+func decodePhraseCursor(encoded string, query PhrasesQuery) (phraseCursor, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return phraseCursor{}, ErrInvalidCursor
+	}
+
+	cursor := phraseCursor{}
+	if err := json.Unmarshal(bytes, &cursor); err != nil {
+		return phraseCursor{}, ErrInvalidCursor
+	}
+
+	if cursor.Sort != query.Sort || cursor.Descending != query.Descending || cursor.Search != query.Search {
+		return phraseCursor{}, ErrInvalidCursor
+	}
+
+	return cursor, nil
+}
@@ -1,8 +1,14 @@
 package api
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 )
 
@@ -12,16 +18,79 @@ type Phrase struct {
 	Translation string
 }
 
+var ErrPhraseNotFound = errors.New("phrase not found")
+var ErrPhrasesNotDuplicates = errors.New("only phrases with the same content can be merged")
+
+// ErrDuplicatePhrase is what two syncs racing to save the same new phrase
+// get, whichever is second. Sent again, it is answered with the saved phrase
+var ErrDuplicatePhrase = errors.New("a phrase with the same content was saved at the same time, send it again")
+
 type PhraseType string
 
 const FRENCH_TO_ENGLISH PhraseType = "FRENCH_TO_ENGLISH"
 const ENGLISH_TO_FRENCH PhraseType = "ENGLISH_TO_FRENCH"
 
+type PhraseSort string
+
+const SORT_CREATED PhraseSort = "created"
+const SORT_UPDATED PhraseSort = "updated"
+const SORT_ALPHABETICAL PhraseSort = "alphabetical"
+
+// SORT_DUE puts the phrases that are due for review first. They come due
+// as quizzes are submitted, see QuizRepository.SubmitQuizForUserWithUUID
+const SORT_DUE PhraseSort = "due"
+
+var sortColumns = map[PhraseSort]string{
+	SORT_CREATED:      "created_at",
+	SORT_UPDATED:      "updated_at",
+	SORT_ALPHABETICAL: "phrase",
+	SORT_DUE:          "due_at",
+}
+
+type PhrasesQuery struct {
+	Sort       PhraseSort
+	Descending bool
+	Search     string
+	Cursor     string
+	Limit      int
+}
+
+// PhrasePatch describes a partial update; nil fields are left untouched
+type PhrasePatch struct {
+	Content     *string
+	Translation *string
+}
+
+// PhraseSync is one phrase sent by a client: phrases with a uuid
+// are updates, the rest are new
+type PhraseSync struct {
+	Content     string
+	Translation string
+	Uuid        *uuid.UUID
+}
+
+// SyncedPhrase is what a PhraseSync was saved as. A Duplicate wasn't
+// saved at all: it's the phrase with the same content the user already had
+type SyncedPhrase struct {
+	Phrase
+	Created   bool
+	Duplicate bool
+}
+
+type PhrasesPage struct {
+	Phrases    []Phrase
+	NextCursor string
+}
+
 //go:generate counterfeiter . PhrasesRepository
 type PhrasesRepository interface {
-	PhrasesForUserWithUUID(uuid.UUID) ([]Phrase, error)
-	AddPhraseForUserWithUUID(string, string, uuid.UUID) (Phrase, error)
-	UpdatePhraseForUserWithUUID(string, string, uuid.UUID, uuid.UUID) (Phrase, error)
+	PhrasesForUserWithUUID(context.Context, uuid.UUID, PhrasesQuery) (PhrasesPage, error)
+	PhraseForUserWithUUID(context.Context, uuid.UUID, uuid.UUID) (Phrase, error)
+	SyncPhrasesForUserWithUUID(context.Context, []PhraseSync, uuid.UUID) ([]SyncedPhrase, error)
+	PatchPhraseForUserWithUUID(context.Context, PhrasePatch, uuid.UUID, uuid.UUID) (Phrase, error)
+	MergePhrasesForUserWithUUID(context.Context, uuid.UUID, []uuid.UUID, uuid.UUID) (Phrase, error)
+	PhraseHistoryForUserWithUUID(context.Context, uuid.UUID, uuid.UUID) ([]PhraseRevision, error)
+	RevertPhraseForUserWithUUID(context.Context, uuid.UUID, uint, uuid.UUID) (Phrase, error)
 }
 
 func NewPhrasesRepository(phraseType PhraseType, db *sql.DB) PhrasesRepository {
@@ -33,43 +102,177 @@ type phrasesRepo struct {
 	phraseType PhraseType
 }
 
-func (repo *phrasesRepo) PhrasesForUserWithUUID(userUuid uuid.UUID) ([]Phrase, error) {
-	rows, err := repo.db.Query(
-		"SELECT uuid, phrase, translation FROM phrases WHERE user_uuid = ? AND phrase_type = ?",
-		userUuid.String(),
-		string(repo.phraseType),
+func (repo *phrasesRepo) PhrasesForUserWithUUID(ctx context.Context, userUuid uuid.UUID, query PhrasesQuery) (PhrasesPage, error) {
+	column, ok := sortColumns[query.Sort]
+	if !ok {
+		return PhrasesPage{}, fmt.Errorf("unknown sort order '%s'", query.Sort)
+	}
+
+	direction, comparison := "ASC", ">"
+	if query.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	clauses := []string{"user_uuid = ?", "phrase_type = ?"}
+	args := []interface{}{userUuid.String(), string(repo.phraseType)}
+
+	// the table uses an accent and case insensitive collation,
+	// so a plain LIKE matches "cafe" against "Café"
+	if query.Search != "" {
+		pattern := "%" + escapeLikePattern(query.Search) + "%"
+		clauses = append(clauses, "(phrase LIKE ? OR translation LIKE ?)")
+		args = append(args, pattern, pattern)
+	}
+
+	if query.Cursor != "" {
+		cursor, err := decodePhraseCursor(query.Cursor, query)
+		if err != nil {
+			return PhrasesPage{}, err
+		}
+
+		clauses = append(clauses, fmt.Sprintf(
+			"(%s %s ? OR (%s = ? AND uuid %s ?))",
+			column, comparison, column, comparison,
+		))
+		args = append(args, cursor.Value, cursor.Value, cursor.Uuid)
+	}
+
+	statement := fmt.Sprintf(
+		"SELECT uuid, phrase, translation, CAST(%s AS CHAR) FROM phrases WHERE %s ORDER BY %s %s, uuid %s",
+		column,
+		strings.Join(clauses, " AND "),
+		column,
+		direction,
+		direction,
 	)
+	if query.Limit > 0 {
+		// fetch one extra row to find out whether there is another page
+		statement += " LIMIT ?"
+		args = append(args, query.Limit+1)
+	}
+
+	rows, err := tracedQuery(ctx, repo.db, statement, args...)
 	if err != nil {
-		return nil, err
+		return PhrasesPage{}, err
 	}
 
 	defer rows.Close()
 
 	results := []Phrase{}
+	sortValues := []string{}
 	for rows.Next() {
 		phrase := Phrase{}
+		var sortValue string
 		if err := rows.Scan(
 			&phrase.Uuid,
 			&phrase.Content,
 			&phrase.Translation,
+			&sortValue,
 		); err != nil {
-			return nil, err
+			return PhrasesPage{}, err
 		}
 		results = append(results, phrase)
+		sortValues = append(sortValues, sortValue)
+	}
+	if err := rows.Err(); err != nil {
+		return PhrasesPage{}, err
+	}
+
+	page := PhrasesPage{Phrases: results}
+	if query.Limit > 0 && len(results) > query.Limit {
+		last := query.Limit - 1
+		page.Phrases = results[:query.Limit]
+		page.NextCursor = encodePhraseCursor(phraseCursor{
+			Sort:       query.Sort,
+			Descending: query.Descending,
+			Search:     query.Search,
+			Value:      sortValues[last],
+			Uuid:       results[last].Uuid,
+		})
+	}
+
+	return page, nil
+}
+
+func (repo *phrasesRepo) PhraseForUserWithUUID(ctx context.Context, phraseUuid uuid.UUID, userUuid uuid.UUID) (Phrase, error) {
+	phrase := Phrase{}
+	err := tracedQueryRow(
+		ctx,
+		repo.db,
+		"SELECT uuid, phrase, translation FROM phrases WHERE uuid = ? AND user_uuid = ? AND phrase_type = ?",
+		phraseUuid.String(),
+		userUuid.String(),
+		string(repo.phraseType),
+	).Scan(
+		&phrase.Uuid,
+		&phrase.Content,
+		&phrase.Translation,
+	)
+	if err == sql.ErrNoRows {
+		return Phrase{}, ErrPhraseNotFound
+	}
+
+	return phrase, err
+}
+
+// SyncPhrasesForUserWithUUID saves a client's whole sync in one transaction,
+// so a sync that fails or runs out of time part way through leaves nothing
+// half written for the client to trip over when it sends the batch again.
+// A new phrase that the user already has, or that came earlier in the batch,
+// isn't saved twice: it is answered with the phrase that was saved first,
+// marked as a Duplicate. When another sync saves the same phrase at the same
+// time, the unique key stops it and the whole sync fails with ErrDuplicatePhrase
+func (repo *phrasesRepo) SyncPhrasesForUserWithUUID(ctx context.Context, phrases []PhraseSync, userUuid uuid.UUID) ([]SyncedPhrase, error) {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	synced := make([]SyncedPhrase, 0, len(phrases))
+	for _, phrase := range phrases {
+		if phrase.Uuid != nil {
+			updated, err := repo.updatePhrase(ctx, tx, phrase.Content, phrase.Translation, *phrase.Uuid, userUuid)
+			if err != nil {
+				return nil, err
+			}
+			synced = append(synced, SyncedPhrase{Phrase: updated})
+			continue
+		}
+
+		// phrases inserted earlier in the batch are visible inside the transaction
+		duplicates, err := repo.duplicatesOfPhrase(ctx, tx, phrase.Content, userUuid)
+		if err != nil {
+			return nil, err
+		}
+		if len(duplicates) > 0 {
+			synced = append(synced, SyncedPhrase{Phrase: duplicates[0], Duplicate: true})
+			continue
+		}
+
+		added, err := repo.addPhrase(ctx, tx, phrase.Content, phrase.Translation, userUuid)
+		if err != nil {
+			return nil, err
+		}
+		synced = append(synced, SyncedPhrase{Phrase: added, Created: true})
 	}
 
-	return results, nil
+	return synced, tx.Commit()
 }
 
-func (repo *phrasesRepo) AddPhraseForUserWithUUID(content, translation string, userUuid uuid.UUID) (Phrase, error) {
+func (repo *phrasesRepo) addPhrase(ctx context.Context, tx *sql.Tx, content, translation string, userUuid uuid.UUID) (Phrase, error) {
 	newUuid, err := uuid.NewRandom()
 	if err != nil {
 		return Phrase{}, err
 	}
-	_, err = repo.db.Exec(
-		"INSERT INTO phrases (uuid, phrase, translation, user_uuid, phrase_type) VALUES (?, ?, ?, ?, ?)",
+
+	_, err = tracedExec(
+		ctx,
+		tx,
+		"INSERT INTO phrases (uuid, phrase, normalized_phrase, translation, user_uuid, phrase_type) VALUES (?, ?, ?, ?, ?, ?)",
 		newUuid.String(),
 		content,
+		NormalizePhrase(content),
 		translation,
 		userUuid,
 		string(repo.phraseType),
@@ -77,17 +280,49 @@ func (repo *phrasesRepo) AddPhraseForUserWithUUID(content, translation string, u
 	if err != nil {
 		return Phrase{}, err
 	}
+	err = claimDuplicateKey(ctx, tx, newUuid.String())
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	after := phraseSnapshot{Type: repo.phraseType, Content: content, Translation: translation}
+	err = recordAudit(ctx, tx, auditRecord{
+		action:     AUDIT_PHRASE_CREATE,
+		userUuid:   userUuid.String(),
+		phraseUuid: newUuid.String(),
+		after:      after,
+	})
+	if err != nil {
+		return Phrase{}, err
+	}
+	err = recordRevision(ctx, tx, newUuid.String(), userUuid.String(), after, 0)
+	if err != nil {
+		return Phrase{}, err
+	}
+	err = recordProgress(ctx, tx, userUuid.String(), time.Now(), 0, 1)
+	if err != nil {
+		return Phrase{}, err
+	}
 
 	return Phrase{
-		Uuid:    newUuid.String(),
-		Content: content,
+		Uuid:        newUuid.String(),
+		Content:     content,
+		Translation: translation,
 	}, nil
 }
 
-func (repo *phrasesRepo) UpdatePhraseForUserWithUUID(content string, translation string, phraseUuid uuid.UUID, userUuid uuid.UUID) (Phrase, error) {
-	_, err := repo.db.Exec(
-		"UPDATE phrases SET phrase = ?, translation = ? WHERE uuid = ? AND user_uuid = ? AND phrase_type = ?",
+func (repo *phrasesRepo) updatePhrase(ctx context.Context, tx *sql.Tx, content string, translation string, phraseUuid uuid.UUID, userUuid uuid.UUID) (Phrase, error) {
+	before, err := snapshotPhrase(ctx, tx, phraseUuid.String(), userUuid.String())
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	_, err = tracedExec(
+		ctx,
+		tx,
+		"UPDATE phrases SET phrase = ?, normalized_phrase = ?, duplicate_key = NULL, translation = ? WHERE uuid = ? AND user_uuid = ? AND phrase_type = ?",
 		content,
+		NormalizePhrase(content),
 		translation,
 		phraseUuid.String(),
 		userUuid.String(),
@@ -96,6 +331,28 @@ func (repo *phrasesRepo) UpdatePhraseForUserWithUUID(content string, translation
 	if err != nil {
 		return Phrase{}, err
 	}
+	err = claimDuplicateKeyIfFree(ctx, tx, phraseUuid.String())
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	if before != nil && before.Type == repo.phraseType {
+		after := phraseSnapshot{Type: repo.phraseType, Content: content, Translation: translation}
+		err = recordAudit(ctx, tx, auditRecord{
+			action:     AUDIT_PHRASE_UPDATE,
+			userUuid:   userUuid.String(),
+			phraseUuid: phraseUuid.String(),
+			before:     before,
+			after:      after,
+		})
+		if err != nil {
+			return Phrase{}, err
+		}
+		err = recordChangedRevision(ctx, tx, phraseUuid.String(), userUuid.String(), before, after)
+		if err != nil {
+			return Phrase{}, err
+		}
+	}
 
 	return Phrase{
 		Uuid:        phraseUuid.String(),
@@ -103,3 +360,357 @@ func (repo *phrasesRepo) UpdatePhraseForUserWithUUID(content string, translation
 		Translation: translation,
 	}, nil
 }
+
+func (repo *phrasesRepo) PatchPhraseForUserWithUUID(ctx context.Context, patch PhrasePatch, phraseUuid uuid.UUID, userUuid uuid.UUID) (Phrase, error) {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Phrase{}, err
+	}
+	defer tx.Rollback()
+
+	before, err := snapshotPhrase(ctx, tx, phraseUuid.String(), userUuid.String())
+	if err != nil {
+		return Phrase{}, err
+	}
+	if before == nil || before.Type != repo.phraseType {
+		return Phrase{}, ErrPhraseNotFound
+	}
+
+	assignments := []string{}
+	args := []interface{}{}
+	if patch.Content != nil {
+		assignments = append(assignments, "phrase = ?", "normalized_phrase = ?", "duplicate_key = NULL")
+		args = append(args, *patch.Content, NormalizePhrase(*patch.Content))
+	}
+	if patch.Translation != nil {
+		assignments = append(assignments, "translation = ?")
+		args = append(args, *patch.Translation)
+	}
+
+	if len(assignments) > 0 {
+		args = append(args, phraseUuid.String(), userUuid.String(), string(repo.phraseType))
+		_, err = tracedExec(
+			ctx,
+			tx,
+			fmt.Sprintf(
+				"UPDATE phrases SET %s WHERE uuid = ? AND user_uuid = ? AND phrase_type = ?",
+				strings.Join(assignments, ", "),
+			),
+			args...,
+		)
+		if err != nil {
+			return Phrase{}, err
+		}
+	}
+	if patch.Content != nil {
+		err = claimDuplicateKeyIfFree(ctx, tx, phraseUuid.String())
+		if err != nil {
+			return Phrase{}, err
+		}
+	}
+
+	// read the phrase back rather than trusting the patch, so the response
+	// reflects what is actually stored (and whether it exists at all)
+	phrase := Phrase{}
+	err = tracedQueryRow(
+		ctx,
+		tx,
+		"SELECT uuid, phrase, translation FROM phrases WHERE uuid = ? AND user_uuid = ? AND phrase_type = ?",
+		phraseUuid.String(),
+		userUuid.String(),
+		string(repo.phraseType),
+	).Scan(
+		&phrase.Uuid,
+		&phrase.Content,
+		&phrase.Translation,
+	)
+	if err == sql.ErrNoRows {
+		return Phrase{}, ErrPhraseNotFound
+	}
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	if len(assignments) > 0 {
+		after := phraseSnapshot{Type: repo.phraseType, Content: phrase.Content, Translation: phrase.Translation}
+		err = recordAudit(ctx, tx, auditRecord{
+			action:     AUDIT_PHRASE_UPDATE,
+			userUuid:   userUuid.String(),
+			phraseUuid: phraseUuid.String(),
+			before:     before,
+			after:      after,
+		})
+		if err != nil {
+			return Phrase{}, err
+		}
+		err = recordChangedRevision(ctx, tx, phraseUuid.String(), userUuid.String(), before, after)
+		if err != nil {
+			return Phrase{}, err
+		}
+	}
+
+	return phrase, tx.Commit()
+}
+
+func (repo *phrasesRepo) duplicatesOfPhrase(ctx context.Context, tx *sql.Tx, content string, userUuid uuid.UUID) ([]Phrase, error) {
+	rows, err := tracedQuery(
+		ctx,
+		tx,
+		"SELECT uuid, phrase, translation FROM phrases WHERE user_uuid = ? AND phrase_type = ? AND normalized_phrase = ? ORDER BY created_at, uuid",
+		userUuid.String(),
+		string(repo.phraseType),
+		NormalizePhrase(content),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []Phrase{}
+	for rows.Next() {
+		phrase := Phrase{}
+		if err := rows.Scan(
+			&phrase.Uuid,
+			&phrase.Content,
+			&phrase.Translation,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, phrase)
+	}
+
+	return results, rows.Err()
+}
+
+// MergePhrasesForUserWithUUID folds the duplicates into the surviving phrase
+// and deletes them. Their revisions and examples move to the end of the
+// survivor's. The survivor keeps the earliest creation date and the earliest
+// due date of the group, and the shortest review interval, so merging never
+// pushes a review back. It picks up a translation from a duplicate if it has
+// none of its own. Practice sessions are recorded per user, not per phrase,
+// so there are none to move
+func (repo *phrasesRepo) MergePhrasesForUserWithUUID(ctx context.Context, survivorUuid uuid.UUID, duplicateUuids []uuid.UUID, userUuid uuid.UUID) (Phrase, error) {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Phrase{}, err
+	}
+	defer tx.Rollback()
+
+	uuids := []string{survivorUuid.String()}
+	for _, duplicateUuid := range duplicateUuids {
+		uuids = append(uuids, duplicateUuid.String())
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(uuids)), ", ")
+	args := []interface{}{userUuid.String(), string(repo.phraseType)}
+	for _, u := range uuids {
+		args = append(args, u)
+	}
+
+	rows, err := tracedQuery(
+		ctx,
+		tx,
+		fmt.Sprintf(
+			"SELECT uuid, phrase, translation, normalized_phrase, CAST(created_at AS CHAR), CAST(due_at AS CHAR), review_interval_days FROM phrases WHERE user_uuid = ? AND phrase_type = ? AND uuid IN (%s) FOR UPDATE",
+			placeholders,
+		),
+		args...,
+	)
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	type mergeCandidate struct {
+		phrase         Phrase
+		normalized     string
+		createdAt      string
+		dueAt          string
+		reviewInterval uint
+	}
+
+	candidates := map[string]mergeCandidate{}
+	for rows.Next() {
+		c := mergeCandidate{}
+		if err := rows.Scan(
+			&c.phrase.Uuid,
+			&c.phrase.Content,
+			&c.phrase.Translation,
+			&c.normalized,
+			&c.createdAt,
+			&c.dueAt,
+			&c.reviewInterval,
+		); err != nil {
+			rows.Close()
+			return Phrase{}, err
+		}
+		candidates[c.phrase.Uuid] = c
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return Phrase{}, err
+	}
+
+	survivor, ok := candidates[survivorUuid.String()]
+	if !ok || len(candidates) != len(uuids) {
+		return Phrase{}, ErrPhraseNotFound
+	}
+
+	merged := survivor
+	for _, c := range candidates {
+		// phrases that are nothing but whitespace aren't duplicates of anything
+		if c.normalized == "" || c.normalized != survivor.normalized {
+			return Phrase{}, ErrPhrasesNotDuplicates
+		}
+		// the datetimes are formatted as "2006-01-02 15:04:05.000000"
+		// so comparing them as strings orders them chronologically
+		if c.createdAt < merged.createdAt {
+			merged.createdAt = c.createdAt
+		}
+		if c.dueAt < merged.dueAt {
+			merged.dueAt = c.dueAt
+		}
+		if c.reviewInterval < merged.reviewInterval {
+			merged.reviewInterval = c.reviewInterval
+		}
+	}
+	for _, duplicateUuid := range duplicateUuids {
+		if merged.phrase.Translation == "" {
+			merged.phrase.Translation = candidates[duplicateUuid.String()].phrase.Translation
+		}
+	}
+
+	_, err = tracedExec(
+		ctx,
+		tx,
+		"UPDATE phrases SET translation = ?, created_at = ?, due_at = ?, review_interval_days = ? WHERE uuid = ? AND user_uuid = ? AND phrase_type = ?",
+		merged.phrase.Translation,
+		merged.createdAt,
+		merged.dueAt,
+		merged.reviewInterval,
+		survivorUuid.String(),
+		userUuid.String(),
+		string(repo.phraseType),
+	)
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	_, err = tracedExec(
+		ctx,
+		tx,
+		fmt.Sprintf(
+			"DELETE FROM phrases WHERE user_uuid = ? AND phrase_type = ? AND uuid IN (%s)",
+			strings.TrimSuffix(strings.Repeat("?, ", len(duplicateUuids)), ", "),
+		),
+		append(args[:2:2], args[3:]...)...,
+	)
+	if err != nil {
+		return Phrase{}, err
+	}
+	for _, duplicateUuid := range duplicateUuids {
+		err = movePhraseHistory(ctx, tx, userUuid.String(), duplicateUuid.String(), survivorUuid.String())
+		if err != nil {
+			return Phrase{}, err
+		}
+	}
+	// the survivor may have been one of the duplicates without the key
+	err = claimDuplicateKeyIfFree(ctx, tx, survivorUuid.String())
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	// the survivor's entry shows what it became, and each duplicate's
+	// shows what it was and where it went
+	before := phraseSnapshot{Type: repo.phraseType, Content: survivor.phrase.Content, Translation: survivor.phrase.Translation}
+	after := phraseSnapshot{Type: repo.phraseType, Content: merged.phrase.Content, Translation: merged.phrase.Translation}
+	err = recordAudit(ctx, tx, auditRecord{
+		action:     AUDIT_PHRASE_MERGE,
+		userUuid:   userUuid.String(),
+		phraseUuid: survivorUuid.String(),
+		before:     before,
+		after:      after,
+	})
+	if err != nil {
+		return Phrase{}, err
+	}
+	err = recordChangedRevision(ctx, tx, survivorUuid.String(), userUuid.String(), &before, after)
+	if err != nil {
+		return Phrase{}, err
+	}
+	for _, duplicateUuid := range duplicateUuids {
+		duplicate := candidates[duplicateUuid.String()].phrase
+		err = recordAudit(ctx, tx, auditRecord{
+			action:     AUDIT_PHRASE_MERGE,
+			userUuid:   userUuid.String(),
+			phraseUuid: duplicateUuid.String(),
+			before:     phraseSnapshot{Type: repo.phraseType, Content: duplicate.Content, Translation: duplicate.Translation},
+			after:      map[string]string{"mergedInto": survivorUuid.String()},
+		})
+		if err != nil {
+			return Phrase{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return Phrase{}, err
+	}
+
+	return merged.phrase, nil
+}
+
+// NormalizePhrase is what we compare to decide whether two phrases are
+// duplicates. Migration 06 does the same in SQL for the phrases saved before
+func NormalizePhrase(content string) string {
+	return strings.ToLower(strings.Join(strings.Fields(content), " "))
+}
+
+// duplicateKeySQL works out the value of the unique key phrases_by_duplicate_key,
+// which only one of a user's phrases of each type can have for any normalized
+// content. Phrases that were duplicates before the key existed, or that were
+// edited into one, go without it until they are merged
+const duplicateKeySQL = "UNHEX(SHA2(CONCAT(phrase_type, ':', normalized_phrase), 256))"
+
+// mysqlDuplicateEntry is the error MySQL gives when a unique key is already taken
+const mysqlDuplicateEntry = 1062
+
+// claimDuplicateKey is for new phrases, which can't be duplicates
+func claimDuplicateKey(ctx context.Context, tx statementRunner, phraseUuid string) error {
+	_, err := tracedExec(
+		ctx,
+		tx,
+		"UPDATE phrases SET duplicate_key = "+duplicateKeySQL+" WHERE uuid = ? AND normalized_phrase <> ''",
+		phraseUuid,
+	)
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry {
+		return ErrDuplicatePhrase
+	}
+	return err
+}
+
+// claimDuplicateKeyIfFree is for edited phrases, which may have been
+// edited into a duplicate. IGNORE leaves those without the key
+func claimDuplicateKeyIfFree(ctx context.Context, tx statementRunner, phraseUuid string) error {
+	_, err := tracedExec(
+		ctx,
+		tx,
+		"UPDATE IGNORE phrases SET duplicate_key = "+duplicateKeySQL+" WHERE uuid = ? AND normalized_phrase <> ''",
+		phraseUuid,
+	)
+
+	return err
+}
+
+func escapeLikePattern(search string) string {
+	escaped := []rune{}
+	for _, r := range search {
+		if r == '\\' || r == '%' || r == '_' {
+			escaped = append(escaped, '\\')
+		}
+		escaped = append(escaped, r)
+	}
+
+	return string(escaped)
+}
@@ -0,0 +1,255 @@
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+var _ = Describe("PhrasesRepository", func() {
+	var db *sql.DB
+	var mock sqlmock.Sqlmock
+	var subject PhrasesRepository
+
+	userUuid := uuid.Must(uuid.Parse("f2f282d9-f738-463c-ab2d-27fcb5645bca"))
+	phraseUuid := uuid.Must(uuid.Parse("f56b84af-7b95-40ff-b360-888169fb7f12"))
+
+	BeforeEach(func() {
+		var err error
+		db, mock, err = sqlmock.New()
+		Expect(err).NotTo(HaveOccurred())
+		subject = NewPhrasesRepository(FRENCH_TO_ENGLISH, db)
+	})
+
+	AfterEach(func() {
+		Expect(mock.ExpectationsWereMet()).To(Succeed())
+		db.Close()
+	})
+
+	expectNoDuplicates := func(normalized string) {
+		mock.ExpectQuery("SELECT uuid, phrase, translation FROM phrases WHERE user_uuid = \\? AND phrase_type = \\? AND normalized_phrase = \\?").
+			WithArgs(userUuid.String(), string(FRENCH_TO_ENGLISH), normalized).
+			WillReturnRows(sqlmock.NewRows([]string{"uuid", "phrase", "translation"}))
+	}
+
+	expectInsert := func(content string) {
+		mock.ExpectExec("INSERT INTO phrases").
+			WithArgs(sqlmock.AnyArg(), content, NormalizePhrase(content), "a translation", userUuid, string(FRENCH_TO_ENGLISH)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE phrases SET duplicate_key = ").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO audit_log").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO phrase_revisions").WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("SELECT timezone, daily_goal_type, daily_goal_target FROM users").WillReturnError(sql.ErrNoRows)
+		mock.ExpectExec("INSERT INTO daily_progress").WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	Describe("PhrasesForUserWithUUID", func() {
+		query := PhrasesQuery{Sort: SORT_DUE, Descending: true, Search: "chat", Limit: 1}
+
+		nextCursor := func() string {
+			mock.ExpectQuery("SELECT uuid, phrase, translation, CAST\\(due_at AS CHAR\\) FROM phrases WHERE .* ORDER BY due_at DESC, uuid DESC LIMIT \\?").
+				WillReturnRows(sqlmock.NewRows([]string{"uuid", "phrase", "translation", "due_at"}).
+					AddRow(phraseUuid.String(), "le chat", "the cat", "2018-03-02 12:00:00.000000").
+					AddRow("another-uuid", "le chaton", "the kitten", "2018-03-01 12:00:00.000000"))
+
+			page, err := subject.PhrasesForUserWithUUID(context.Background(), userUuid, query)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(page.Phrases).To(HaveLen(1))
+			return page.NextCursor
+		}
+
+		It("carries on from where the cursor left off", func() {
+			next := query
+			next.Cursor = nextCursor()
+
+			mock.ExpectQuery("SELECT uuid, phrase, translation, CAST\\(due_at AS CHAR\\) FROM phrases WHERE .* AND \\(due_at < \\? OR \\(due_at = \\? AND uuid < \\?\\)\\)").
+				WithArgs(userUuid.String(), string(FRENCH_TO_ENGLISH), "%chat%", "%chat%", "2018-03-02 12:00:00.000000", "2018-03-02 12:00:00.000000", phraseUuid.String(), 2).
+				WillReturnRows(sqlmock.NewRows([]string{"uuid", "phrase", "translation", "due_at"}))
+
+			_, err := subject.PhrasesForUserWithUUID(context.Background(), userUuid, next)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("refuses a cursor from a query with another direction or search", func() {
+			cursor := nextCursor()
+
+			ascending := query
+			ascending.Cursor = cursor
+			ascending.Descending = false
+			_, err := subject.PhrasesForUserWithUUID(context.Background(), userUuid, ascending)
+			Expect(err).To(Equal(ErrInvalidCursor))
+
+			searchedAgain := query
+			searchedAgain.Cursor = cursor
+			searchedAgain.Search = "chien"
+			_, err = subject.PhrasesForUserWithUUID(context.Background(), userUuid, searchedAgain)
+			Expect(err).To(Equal(ErrInvalidCursor))
+		})
+	})
+
+	Describe("SyncPhrasesForUserWithUUID", func() {
+		It("saves the whole batch in one transaction", func() {
+			mock.ExpectBegin()
+			expectNoDuplicates("le chat")
+			expectInsert("Le chat")
+			mock.ExpectQuery("SELECT phrase_type, phrase, translation FROM phrases WHERE uuid = \\? AND user_uuid = \\? FOR UPDATE").
+				WithArgs(phraseUuid.String(), userUuid.String()).
+				WillReturnRows(sqlmock.NewRows([]string{"phrase_type", "phrase", "translation"}).
+					AddRow(string(FRENCH_TO_ENGLISH), "le chien", "the dog"))
+			mock.ExpectExec("UPDATE phrases SET phrase = \\?, normalized_phrase = \\?, duplicate_key = NULL").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("UPDATE IGNORE phrases SET duplicate_key = ").
+				WithArgs(phraseUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("INSERT INTO audit_log").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("INSERT INTO phrase_revisions").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			synced, err := subject.SyncPhrasesForUserWithUUID(context.Background(), []PhraseSync{
+				{Content: "Le chat", Translation: "a translation"},
+				{Content: "le chien", Translation: "the hound", Uuid: &phraseUuid},
+			}, userUuid)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(synced).To(HaveLen(2))
+			Expect(synced[0].Created).To(BeTrue())
+			Expect(synced[0].Content).To(Equal("Le chat"))
+			Expect(synced[0].Translation).To(Equal("a translation"))
+			Expect(synced[1]).To(Equal(SyncedPhrase{Phrase: Phrase{
+				Uuid:        phraseUuid.String(),
+				Content:     "le chien",
+				Translation: "the hound",
+			}}))
+		})
+
+		It("answers a phrase the user already has with the one they have, as a duplicate", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT uuid, phrase, translation FROM phrases WHERE user_uuid = \\? AND phrase_type = \\? AND normalized_phrase = \\?").
+				WithArgs(userUuid.String(), string(FRENCH_TO_ENGLISH), "le chat").
+				WillReturnRows(sqlmock.NewRows([]string{"uuid", "phrase", "translation"}).
+					AddRow(phraseUuid.String(), "le  chat", "the cat"))
+			mock.ExpectCommit()
+
+			synced, err := subject.SyncPhrasesForUserWithUUID(context.Background(), []PhraseSync{
+				{Content: "Le chat", Translation: "a translation"},
+			}, userUuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(synced).To(Equal([]SyncedPhrase{{
+				Phrase: Phrase{
+					Uuid:        phraseUuid.String(),
+					Content:     "le  chat",
+					Translation: "the cat",
+				},
+				Duplicate: true,
+			}}))
+		})
+
+		It("says so when another request saved the same phrase first", func() {
+			mock.ExpectBegin()
+			expectNoDuplicates("le chat")
+			mock.ExpectExec("INSERT INTO phrases").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("UPDATE phrases SET duplicate_key = ").
+				WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry for key 'phrases_by_duplicate_key'"})
+			mock.ExpectRollback()
+
+			_, err := subject.SyncPhrasesForUserWithUUID(context.Background(), []PhraseSync{
+				{Content: "Le chat", Translation: "a translation"},
+			}, userUuid)
+			Expect(err).To(Equal(ErrDuplicatePhrase))
+		})
+
+		It("looks for duplicates inside the transaction, so it sees phrases earlier in the batch", func() {
+			mock.ExpectBegin()
+			expectNoDuplicates("le chat")
+			expectInsert("Le chat")
+			mock.ExpectQuery("SELECT uuid, phrase, translation FROM phrases WHERE user_uuid = \\? AND phrase_type = \\? AND normalized_phrase = \\?").
+				WithArgs(userUuid.String(), string(FRENCH_TO_ENGLISH), "le chat").
+				WillReturnRows(sqlmock.NewRows([]string{"uuid", "phrase", "translation"}).
+					AddRow(phraseUuid.String(), "Le chat", "a translation"))
+			mock.ExpectCommit()
+
+			synced, err := subject.SyncPhrasesForUserWithUUID(context.Background(), []PhraseSync{
+				{Content: "Le chat", Translation: "a translation"},
+				{Content: "LE   CHAT", Translation: "something else"},
+			}, userUuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(synced[1].Created).To(BeFalse())
+			Expect(synced[1].Duplicate).To(BeTrue())
+			Expect(synced[1].Uuid).To(Equal(phraseUuid.String()))
+		})
+
+		It("rolls back everything it saved when a later phrase fails", func() {
+			mock.ExpectBegin()
+			expectNoDuplicates("le chat")
+			expectInsert("Le chat")
+			mock.ExpectQuery("SELECT phrase_type, phrase, translation FROM phrases WHERE uuid = \\? AND user_uuid = \\? FOR UPDATE").
+				WillReturnError(errors.New("RUH ROH"))
+			mock.ExpectRollback()
+
+			_, err := subject.SyncPhrasesForUserWithUUID(context.Background(), []PhraseSync{
+				{Content: "Le chat", Translation: "a translation"},
+				{Content: "le chien", Translation: "the hound", Uuid: &phraseUuid},
+			}, userUuid)
+			Expect(err).To(MatchError("RUH ROH"))
+		})
+	})
+
+	Describe("MergePhrasesForUserWithUUID", func() {
+		duplicateUuid := uuid.Must(uuid.Parse("67d6547d-99ac-4053-8713-e63410af9dc1"))
+
+		It("moves the duplicates' revisions and examples onto the phrase it keeps", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT uuid, phrase, translation, normalized_phrase, CAST\\(created_at AS CHAR\\), CAST\\(due_at AS CHAR\\), review_interval_days FROM phrases").
+				WillReturnRows(sqlmock.NewRows([]string{"uuid", "phrase", "translation", "normalized_phrase", "created_at", "due_at", "review_interval_days"}).
+					AddRow(phraseUuid.String(), "le chat", "the cat", "le chat", "2018-03-01 12:00:00.000000", "2018-03-02 12:00:00.000000", 4).
+					AddRow(duplicateUuid.String(), "Le chat", "", "le chat", "2018-02-01 12:00:00.000000", "2018-03-09 12:00:00.000000", 2))
+			mock.ExpectExec("UPDATE phrases SET translation = \\?, created_at = \\?, due_at = \\?, review_interval_days = \\?").
+				WithArgs("the cat", "2018-02-01 12:00:00.000000", "2018-03-02 12:00:00.000000", 2, phraseUuid.String(), userUuid.String(), string(FRENCH_TO_ENGLISH)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("DELETE FROM phrases WHERE user_uuid = \\? AND phrase_type = \\? AND uuid IN \\(\\?\\)").
+				WithArgs(userUuid.String(), string(FRENCH_TO_ENGLISH), duplicateUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectQuery("SELECT COALESCE\\(MAX\\(revision\\), 0\\) FROM phrase_revisions WHERE phrase_uuid = \\? AND user_uuid = \\?").
+				WithArgs(phraseUuid.String(), userUuid.String()).
+				WillReturnRows(sqlmock.NewRows([]string{"revision"}).AddRow(4))
+			mock.ExpectExec("UPDATE phrase_revisions SET phrase_uuid = \\?, revision = revision \\+ \\? WHERE phrase_uuid = \\? AND user_uuid = \\?").
+				WithArgs(phraseUuid.String(), 4, duplicateUuid.String(), userUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 3))
+			mock.ExpectQuery("SELECT COALESCE\\(MAX\\(position\\), 0\\) FROM phrase_examples WHERE phrase_uuid = \\? AND user_uuid = \\?").
+				WithArgs(phraseUuid.String(), userUuid.String()).
+				WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(0))
+			mock.ExpectExec("UPDATE phrase_examples SET phrase_uuid = \\?, position = position \\+ \\? WHERE phrase_uuid = \\? AND user_uuid = \\?").
+				WithArgs(phraseUuid.String(), 0, duplicateUuid.String(), userUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectExec("UPDATE IGNORE phrases SET duplicate_key = ").
+				WithArgs(phraseUuid.String()).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("INSERT INTO audit_log").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("INSERT INTO audit_log").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			merged, err := subject.MergePhrasesForUserWithUUID(context.Background(), phraseUuid, []uuid.UUID{duplicateUuid}, userUuid)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(merged.Uuid).To(Equal(phraseUuid.String()))
+		})
+
+		It("won't merge phrases that are nothing but whitespace", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT uuid, phrase, translation, normalized_phrase").
+				WillReturnRows(sqlmock.NewRows([]string{"uuid", "phrase", "translation", "normalized_phrase", "created_at", "due_at", "review_interval_days"}).
+					AddRow(phraseUuid.String(), " ", "", "", "2018-03-01 12:00:00.000000", "2018-03-02 12:00:00.000000", 0).
+					AddRow(duplicateUuid.String(), "  ", "", "", "2018-02-01 12:00:00.000000", "2018-03-09 12:00:00.000000", 0))
+			mock.ExpectRollback()
+
+			_, err := subject.MergePhrasesForUserWithUUID(context.Background(), phraseUuid, []uuid.UUID{duplicateUuid}, userUuid)
+			Expect(err).To(Equal(ErrPhrasesNotDuplicates))
+		})
+	})
+})
@@ -0,0 +1,256 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// PhraseRevision is a phrase as it was after one of its changes.
+// Revisions are numbered from 1, when the phrase was added, and
+// RevertedFrom is the revision that was restored to make this one, if any
+type PhraseRevision struct {
+	Revision     uint
+	Content      string
+	Translation  string
+	CreatedAt    time.Time
+	RevertedFrom uint
+	Device       string
+	RequestID    string
+}
+
+func (repo *phrasesRepo) PhraseHistoryForUserWithUUID(ctx context.Context, phraseUuid uuid.UUID, userUuid uuid.UUID) ([]PhraseRevision, error) {
+	if _, err := repo.PhraseForUserWithUUID(ctx, phraseUuid, userUuid); err != nil {
+		return nil, err
+	}
+
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
+		`SELECT revision, phrase, translation, created_at, COALESCE(reverted_from, 0), device, request_id
+		FROM phrase_revisions WHERE phrase_uuid = ? AND user_uuid = ? ORDER BY revision DESC`,
+		phraseUuid.String(),
+		userUuid.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []PhraseRevision{}
+	for rows.Next() {
+		revision := PhraseRevision{}
+		if err := rows.Scan(
+			&revision.Revision,
+			&revision.Content,
+			&revision.Translation,
+			&revision.CreatedAt,
+			&revision.RevertedFrom,
+			&revision.Device,
+			&revision.RequestID,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, revision)
+	}
+
+	return results, rows.Err()
+}
+
+// RevertPhraseForUserWithUUID puts back the content and translation from
+// an earlier revision. That makes a new revision rather than rewriting
+// history, so a revert can itself be reverted
+func (repo *phrasesRepo) RevertPhraseForUserWithUUID(ctx context.Context, phraseUuid uuid.UUID, revision uint, userUuid uuid.UUID) (Phrase, error) {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return Phrase{}, err
+	}
+	defer tx.Rollback()
+
+	before, err := snapshotPhrase(ctx, tx, phraseUuid.String(), userUuid.String())
+	if err != nil {
+		return Phrase{}, err
+	}
+	if before == nil || before.Type != repo.phraseType {
+		return Phrase{}, ErrPhraseNotFound
+	}
+
+	after := phraseSnapshot{Type: repo.phraseType}
+	err = tracedQueryRow(
+		ctx,
+		tx,
+		"SELECT phrase, translation FROM phrase_revisions WHERE phrase_uuid = ? AND user_uuid = ? AND revision = ?",
+		phraseUuid.String(),
+		userUuid.String(),
+		revision,
+	).Scan(
+		&after.Content,
+		&after.Translation,
+	)
+	if err == sql.ErrNoRows {
+		return Phrase{}, ErrRevisionNotFound
+	}
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	phrase := Phrase{
+		Uuid:        phraseUuid.String(),
+		Content:     after.Content,
+		Translation: after.Translation,
+	}
+	if after == *before {
+		return phrase, nil
+	}
+
+	_, err = tracedExec(
+		ctx,
+		tx,
+		"UPDATE phrases SET phrase = ?, normalized_phrase = ?, duplicate_key = NULL, translation = ? WHERE uuid = ? AND user_uuid = ? AND phrase_type = ?",
+		after.Content,
+		NormalizePhrase(after.Content),
+		after.Translation,
+		phraseUuid.String(),
+		userUuid.String(),
+		string(repo.phraseType),
+	)
+	if err != nil {
+		return Phrase{}, err
+	}
+	err = claimDuplicateKeyIfFree(ctx, tx, phraseUuid.String())
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	err = recordAudit(ctx, tx, auditRecord{
+		action:     AUDIT_PHRASE_REVERT,
+		userUuid:   userUuid.String(),
+		phraseUuid: phraseUuid.String(),
+		before:     before,
+		after:      after,
+	})
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	err = recordRevision(ctx, tx, phraseUuid.String(), userUuid.String(), after, revision)
+	if err != nil {
+		return Phrase{}, err
+	}
+
+	return phrase, tx.Commit()
+}
+
+// recordRevision adds the next revision of a phrase. It runs in the same
+// transaction as the change, after the phrase has been locked,
+// so two changes can't both take the same number
+func recordRevision(ctx context.Context, tx statementRunner, phraseUuid string, userUuid string, snapshot phraseSnapshot, revertedFrom uint) error {
+	actor := ActorFromContext(ctx)
+	var reverted interface{}
+	if revertedFrom > 0 {
+		reverted = revertedFrom
+	}
+
+	_, err := tracedExec(
+		ctx,
+		tx,
+		`INSERT INTO phrase_revisions (phrase_uuid, revision, user_uuid, phrase, translation, reverted_from, device, request_id)
+		SELECT ?, COALESCE(MAX(revision), 0) + 1, ?, ?, ?, ?, ?, ? FROM phrase_revisions WHERE phrase_uuid = ?`,
+		phraseUuid,
+		userUuid,
+		snapshot.Content,
+		snapshot.Translation,
+		reverted,
+		actor.Device,
+		actor.RequestID,
+		phraseUuid,
+	)
+
+	return err
+}
+
+// recordChangedRevision only adds a revision when the content or translation
+// actually changed, so saving a phrase untouched doesn't clutter its history
+func recordChangedRevision(ctx context.Context, tx statementRunner, phraseUuid string, userUuid string, before *phraseSnapshot, after phraseSnapshot) error {
+	if before != nil && *before == after {
+		return nil
+	}
+
+	return recordRevision(ctx, tx, phraseUuid, userUuid, after, 0)
+}
+
+// deletePhraseHistory goes in the same transaction as deleting the phrases,
+// so their revisions and examples don't outlive them. The audit log
+// still has what each phrase was when it was deleted
+func deletePhraseHistory(ctx context.Context, tx statementRunner, userUuid string, phraseUuids []string) error {
+	if len(phraseUuids) == 0 {
+		return nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(phraseUuids)), ", ")
+	args := []interface{}{userUuid}
+	for _, phraseUuid := range phraseUuids {
+		args = append(args, phraseUuid)
+	}
+
+	for _, table := range []string{"phrase_revisions", "phrase_examples"} {
+		_, err := tracedExec(
+			ctx,
+			tx,
+			fmt.Sprintf("DELETE FROM %s WHERE user_uuid = ? AND phrase_uuid IN (%s)", table, placeholders),
+			args...,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// movePhraseHistory goes in the same transaction as merging a phrase into
+// another, and renumbers the merged phrase's revisions and examples to come
+// after the ones the other phrase already has. That can leave it with more
+// examples than the use cases allow, until they are next replaced
+func movePhraseHistory(ctx context.Context, tx statementRunner, userUuid string, fromUuid string, toUuid string) error {
+	for _, numbered := range []struct{ table, column string }{
+		{"phrase_revisions", "revision"},
+		{"phrase_examples", "position"},
+	} {
+		table, column := numbered.table, numbered.column
+		var last uint
+		err := tracedQueryRow(
+			ctx,
+			tx,
+			fmt.Sprintf("SELECT COALESCE(MAX(%s), 0) FROM %s WHERE phrase_uuid = ? AND user_uuid = ?", column, table),
+			toUuid,
+			userUuid,
+		).Scan(&last)
+		if err != nil {
+			return err
+		}
+
+		_, err = tracedExec(
+			ctx,
+			tx,
+			fmt.Sprintf("UPDATE %s SET phrase_uuid = ?, %s = %s + ? WHERE phrase_uuid = ? AND user_uuid = ?", table, column, column),
+			toUuid,
+			last,
+			fromUuid,
+			userUuid,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PracticeSession is a run through some of a user's cards, as reported
+// by the client once it is over
+type PracticeSession struct {
+	StartedAt      time.Time
+	FinishedAt     time.Time
+	CardsReviewed  uint
+	CorrectAnswers uint
+}
+
+//go:generate counterfeiter . PracticeRepository
+type PracticeRepository interface {
+	RecordSessionForUserWithUUID(context.Context, PracticeSession, uuid.UUID) error
+}
+
+func NewPracticeRepository(phraseType PhraseType, db *sql.DB) PracticeRepository {
+	return &practiceRepo{db: db, phraseType: phraseType}
+}
+
+type practiceRepo struct {
+	db         *sql.DB
+	phraseType PhraseType
+}
+
+func (repo *practiceRepo) RecordSessionForUserWithUUID(ctx context.Context, session PracticeSession, userUuid uuid.UUID) error {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = recordSession(ctx, tx, repo.phraseType, session, userUuid.String())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// recordSession runs in a transaction, so the session and the day's
+// progress are saved together
+func recordSession(ctx context.Context, tx statementRunner, phraseType PhraseType, session PracticeSession, userUuid string) error {
+	_, err := tracedExec(
+		ctx,
+		tx,
+		"INSERT INTO practice_sessions (user_uuid, phrase_type, started_at, finished_at, cards_reviewed, correct_answers) VALUES (?, ?, ?, ?, ?, ?)",
+		userUuid,
+		string(phraseType),
+		session.StartedAt.UTC(),
+		session.FinishedAt.UTC(),
+		session.CardsReviewed,
+		session.CorrectAnswers,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the session counts for the day it finished on
+	return recordProgress(ctx, tx, userUuid, session.FinishedAt, session.CardsReviewed, 0)
+}
@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type GoalType string
+
+const GOAL_CARDS GoalType = "cards"
+const GOAL_PHRASES GoalType = "phrases"
+
+type DailyGoal struct {
+	Type   GoalType
+	Target uint
+}
+
+// UserSettings decide where a user's days start and end,
+// and what they have to do each day to meet their goal
+type UserSettings struct {
+	Timezone  string
+	DailyGoal DailyGoal
+}
+
+// SettingsPatch describes a partial update; nil fields are left untouched
+type SettingsPatch struct {
+	Timezone  *string
+	DailyGoal *DailyGoal
+}
+
+// DailyProgress is what a user did on one day, in their own timezone
+type DailyProgress struct {
+	Day           string
+	CardsReviewed uint
+	PhrasesAdded  uint
+}
+
+var defaultSettings = UserSettings{
+	Timezone:  "UTC",
+	DailyGoal: DailyGoal{Type: GOAL_CARDS, Target: 20},
+}
+
+//go:generate counterfeiter . ProgressRepository
+type ProgressRepository interface {
+	SettingsForUser(context.Context, uuid.UUID) (UserSettings, error)
+	UpdateSettingsForUser(context.Context, SettingsPatch, uuid.UUID) (UserSettings, error)
+
+	// ProgressForUser is every day the user did anything, oldest first
+	ProgressForUser(context.Context, uuid.UUID) ([]DailyProgress, error)
+}
+
+func NewProgressRepository(db *sql.DB) ProgressRepository {
+	return &progressRepo{db: db}
+}
+
+type progressRepo struct {
+	db *sql.DB
+}
+
+func (repo *progressRepo) SettingsForUser(ctx context.Context, userUuid uuid.UUID) (UserSettings, error) {
+	return settingsForUser(ctx, repo.db, userUuid.String())
+}
+
+func (repo *progressRepo) UpdateSettingsForUser(ctx context.Context, patch SettingsPatch, userUuid uuid.UUID) (UserSettings, error) {
+	columns := []string{"uuid"}
+	args := []interface{}{userUuid.String()}
+	assignments := []string{}
+	if patch.Timezone != nil {
+		columns = append(columns, "timezone")
+		args = append(args, *patch.Timezone)
+		assignments = append(assignments, "timezone = VALUES(timezone)")
+	}
+	if patch.DailyGoal != nil {
+		columns = append(columns, "daily_goal_type", "daily_goal_target")
+		args = append(args, string(patch.DailyGoal.Type), patch.DailyGoal.Target)
+		assignments = append(
+			assignments,
+			"daily_goal_type = VALUES(daily_goal_type)",
+			"daily_goal_target = VALUES(daily_goal_target)",
+		)
+	}
+
+	if len(assignments) > 0 {
+		_, err := tracedExec(
+			ctx,
+			repo.db,
+			fmt.Sprintf(
+				"INSERT INTO users (%s) VALUES (%s) ON DUPLICATE KEY UPDATE %s",
+				strings.Join(columns, ", "),
+				strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", "),
+				strings.Join(assignments, ", "),
+			),
+			args...,
+		)
+		if err != nil {
+			return UserSettings{}, err
+		}
+	}
+
+	return repo.SettingsForUser(ctx, userUuid)
+}
+
+func (repo *progressRepo) ProgressForUser(ctx context.Context, userUuid uuid.UUID) ([]DailyProgress, error) {
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
+		"SELECT CAST(day AS CHAR), cards_reviewed, phrases_added FROM daily_progress WHERE user_uuid = ? ORDER BY day",
+		userUuid.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []DailyProgress{}
+	for rows.Next() {
+		progress := DailyProgress{}
+		if err := rows.Scan(
+			&progress.Day,
+			&progress.CardsReviewed,
+			&progress.PhrasesAdded,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, progress)
+	}
+
+	return results, rows.Err()
+}
+
+// settingsForUser has the defaults for users we haven't seen yet
+func settingsForUser(ctx context.Context, db statementRunner, userUuid string) (UserSettings, error) {
+	settings := UserSettings{}
+	err := tracedQueryRow(
+		ctx,
+		db,
+		"SELECT timezone, daily_goal_type, daily_goal_target FROM users WHERE uuid = ?",
+		userUuid,
+	).Scan(
+		&settings.Timezone,
+		&settings.DailyGoal.Type,
+		&settings.DailyGoal.Target,
+	)
+	if err == sql.ErrNoRows {
+		return defaultSettings, nil
+	}
+
+	return settings, err
+}
+
+// recordProgress adds to what the user did on the day at falls on in their
+// timezone. The day is worked out here rather than by MySQL, which only
+// knows about timezone names when someone has loaded them into it
+func recordProgress(ctx context.Context, tx statementRunner, userUuid string, at time.Time, cardsReviewed uint, phrasesAdded uint) error {
+	settings, err := settingsForUser(ctx, tx, userUuid)
+	if err != nil {
+		return err
+	}
+
+	_, err = tracedExec(
+		ctx,
+		tx,
+		`INSERT INTO daily_progress (user_uuid, day, cards_reviewed, phrases_added) VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE cards_reviewed = cards_reviewed + VALUES(cards_reviewed), phrases_added = phrases_added + VALUES(phrases_added)`,
+		userUuid,
+		LocalDay(at, settings.Timezone),
+		cardsReviewed,
+		phrasesAdded,
+	)
+
+	return err
+}
+
+// LocalDay is the date at falls on in the timezone, or in UTC
+// if the timezone has somehow become one we don't know about
+func LocalDay(at time.Time, timezone string) string {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	return at.In(location).Format("2006-01-02")
+}
@@ -0,0 +1,248 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrQuizNotFound = errors.New("quiz not found")
+var ErrQuizAlreadySubmitted = errors.New("this quiz has already been submitted")
+
+// QuizQuestion asks about one of the user's phrases. What kind of
+// question it is, and so how it is graded, is up to the use cases
+type QuizQuestion struct {
+	Type       string   `json:"type"`
+	PhraseUuid string   `json:"phraseUuid"`
+	Prompt     string   `json:"prompt"`
+	Hint       string   `json:"hint,omitempty"`
+	Answer     string   `json:"answer"`
+	Choices    []string `json:"choices,omitempty"`
+}
+
+// Quiz is kept until it is submitted, so it can be graded
+// against the answers as they were when it was made
+type Quiz struct {
+	Uuid        string
+	CreatedAt   time.Time
+	SubmittedAt *time.Time
+	Questions   []QuizQuestion
+}
+
+// PhraseReview is how one of the phrases a quiz asked about was answered
+type PhraseReview struct {
+	PhraseUuid string
+	Correct    bool
+}
+
+// MaxReviewIntervalDays is as far apart as reviews of
+// a phrase that keeps being answered right can get
+const MaxReviewIntervalDays = 180
+
+//go:generate counterfeiter . QuizRepository
+type QuizRepository interface {
+	// PhrasesForQuiz is every phrase the user has, to make
+	// the questions and their distractors out of
+	PhrasesForQuiz(context.Context, uuid.UUID) ([]Phrase, error)
+
+	CreateQuizForUserWithUUID(context.Context, []QuizQuestion, uuid.UUID) (Quiz, error)
+	QuizForUserWithUUID(context.Context, uuid.UUID, uuid.UUID) (Quiz, error)
+
+	// SubmitQuizForUserWithUUID records how the quiz went as a practice
+	// session, and when each phrase it asked about is next due: a phrase
+	// answered right waits twice as long as it did last time, starting
+	// at a day, and one answered wrong is due again straight away.
+	// A quiz can only be submitted once
+	SubmitQuizForUserWithUUID(context.Context, uuid.UUID, PracticeSession, []PhraseReview, uuid.UUID) error
+
+	// DeleteExpiredQuizzes forgets every quiz made before the given time
+	// that was never submitted, and says how many there were.
+	// Submitted quizzes are kept, so they can't be submitted again
+	DeleteExpiredQuizzes(context.Context, time.Time) (int64, error)
+}
+
+func NewQuizRepository(phraseType PhraseType, db *sql.DB) QuizRepository {
+	return &quizRepo{db: db, phraseType: phraseType}
+}
+
+type quizRepo struct {
+	db         *sql.DB
+	phraseType PhraseType
+}
+
+func (repo *quizRepo) PhrasesForQuiz(ctx context.Context, userUuid uuid.UUID) ([]Phrase, error) {
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
+		"SELECT uuid, phrase, translation FROM phrases WHERE user_uuid = ? AND phrase_type = ? ORDER BY created_at, uuid",
+		userUuid.String(),
+		string(repo.phraseType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []Phrase{}
+	for rows.Next() {
+		phrase := Phrase{}
+		if err := rows.Scan(&phrase.Uuid, &phrase.Content, &phrase.Translation); err != nil {
+			return nil, err
+		}
+		results = append(results, phrase)
+	}
+
+	return results, rows.Err()
+}
+
+func (repo *quizRepo) CreateQuizForUserWithUUID(ctx context.Context, questions []QuizQuestion, userUuid uuid.UUID) (Quiz, error) {
+	newUuid, err := uuid.NewRandom()
+	if err != nil {
+		return Quiz{}, err
+	}
+
+	encoded, err := json.Marshal(questions)
+	if err != nil {
+		return Quiz{}, err
+	}
+
+	quiz := Quiz{
+		Uuid:      newUuid.String(),
+		CreatedAt: time.Now().UTC(),
+		Questions: questions,
+	}
+	_, err = tracedExec(
+		ctx,
+		repo.db,
+		"INSERT INTO quizzes (uuid, user_uuid, phrase_type, created_at, questions) VALUES (?, ?, ?, ?, ?)",
+		quiz.Uuid,
+		userUuid.String(),
+		string(repo.phraseType),
+		quiz.CreatedAt,
+		string(encoded),
+	)
+	if err != nil {
+		return Quiz{}, err
+	}
+
+	return quiz, nil
+}
+
+func (repo *quizRepo) QuizForUserWithUUID(ctx context.Context, quizUuid uuid.UUID, userUuid uuid.UUID) (Quiz, error) {
+	quiz := Quiz{}
+	var submittedAt sql.NullTime
+	var questions string
+	err := tracedQueryRow(
+		ctx,
+		repo.db,
+		"SELECT uuid, created_at, submitted_at, questions FROM quizzes WHERE uuid = ? AND user_uuid = ? AND phrase_type = ?",
+		quizUuid.String(),
+		userUuid.String(),
+		string(repo.phraseType),
+	).Scan(
+		&quiz.Uuid,
+		&quiz.CreatedAt,
+		&submittedAt,
+		&questions,
+	)
+	if err == sql.ErrNoRows {
+		return Quiz{}, ErrQuizNotFound
+	}
+	if err != nil {
+		return Quiz{}, err
+	}
+
+	if submittedAt.Valid {
+		quiz.SubmittedAt = &submittedAt.Time
+	}
+
+	return quiz, json.Unmarshal([]byte(questions), &quiz.Questions)
+}
+
+func (repo *quizRepo) SubmitQuizForUserWithUUID(ctx context.Context, quizUuid uuid.UUID, session PracticeSession, reviews []PhraseReview, userUuid uuid.UUID) error {
+	tx, err := repo.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// the submitted_at check makes sure two submissions
+	// racing each other can't both be counted
+	result, err := tracedExec(
+		ctx,
+		tx,
+		"UPDATE quizzes SET submitted_at = ? WHERE uuid = ? AND user_uuid = ? AND phrase_type = ? AND submitted_at IS NULL",
+		session.FinishedAt.UTC(),
+		quizUuid.String(),
+		userUuid.String(),
+		string(repo.phraseType),
+	)
+	if err != nil {
+		return err
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrQuizAlreadySubmitted
+	}
+
+	err = recordSession(ctx, tx, repo.phraseType, session, userUuid.String())
+	if err != nil {
+		return err
+	}
+
+	for _, review := range reviews {
+		err = repo.scheduleReview(ctx, tx, review, session.FinishedAt, userUuid)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// scheduleReview relies on MySQL assigning the columns left to right, so
+// due_at is worked out from the new interval. Setting updated_at to itself
+// keeps a review from looking like an edit
+func (repo *quizRepo) scheduleReview(ctx context.Context, tx *sql.Tx, review PhraseReview, reviewedAt time.Time, userUuid uuid.UUID) error {
+	interval := "review_interval_days = 0"
+	if review.Correct {
+		interval = fmt.Sprintf("review_interval_days = LEAST(GREATEST(review_interval_days * 2, 1), %d)", MaxReviewIntervalDays)
+	}
+
+	// a phrase deleted since the quiz was made just isn't there to update
+	_, err := tracedExec(
+		ctx,
+		tx,
+		"UPDATE phrases SET "+interval+", due_at = DATE_ADD(?, INTERVAL review_interval_days DAY), updated_at = updated_at WHERE uuid = ? AND user_uuid = ? AND phrase_type = ?",
+		reviewedAt.UTC(),
+		review.PhraseUuid,
+		userUuid.String(),
+		string(repo.phraseType),
+	)
+
+	return err
+}
+
+func (repo *quizRepo) DeleteExpiredQuizzes(ctx context.Context, createdBefore time.Time) (int64, error) {
+	result, err := tracedExec(
+		ctx,
+		repo.db,
+		"DELETE FROM quizzes WHERE phrase_type = ? AND submitted_at IS NULL AND created_at < ?",
+		string(repo.phraseType),
+		createdBefore.UTC(),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,79 @@
+package api_test
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+var _ = Describe("QuizRepository", func() {
+	var db *sql.DB
+	var mock sqlmock.Sqlmock
+	var subject QuizRepository
+
+	userUuid := uuid.Must(uuid.Parse("f2f282d9-f738-463c-ab2d-27fcb5645bca"))
+	quizUuid := uuid.Must(uuid.Parse("0f9a3c52-52a4-4a3e-9f55-5b1a3c5d0f7e"))
+	finishedAt := time.Date(2018, 3, 31, 18, 30, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		var err error
+		db, mock, err = sqlmock.New()
+		Expect(err).NotTo(HaveOccurred())
+		subject = NewQuizRepository(FRENCH_TO_ENGLISH, db)
+	})
+
+	AfterEach(func() {
+		Expect(mock.ExpectationsWereMet()).To(Succeed())
+		db.Close()
+	})
+
+	Describe("SubmitQuizForUserWithUUID", func() {
+		It("reschedules the phrases it asked about along with the session", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec("UPDATE quizzes SET submitted_at = \\?").
+				WithArgs(finishedAt, quizUuid.String(), userUuid.String(), string(FRENCH_TO_ENGLISH)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("INSERT INTO practice_sessions").WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery("SELECT timezone, daily_goal_type, daily_goal_target FROM users").WillReturnError(sql.ErrNoRows)
+			mock.ExpectExec("INSERT INTO daily_progress").WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("UPDATE phrases SET review_interval_days = LEAST\\(GREATEST\\(review_interval_days \\* 2, 1\\), 180\\), due_at = DATE_ADD\\(\\?, INTERVAL review_interval_days DAY\\), updated_at = updated_at").
+				WithArgs(finishedAt, "chat-uuid", userUuid.String(), string(FRENCH_TO_ENGLISH)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec("UPDATE phrases SET review_interval_days = 0, due_at = DATE_ADD\\(\\?, INTERVAL review_interval_days DAY\\), updated_at = updated_at").
+				WithArgs(finishedAt, "chien-uuid", userUuid.String(), string(FRENCH_TO_ENGLISH)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			err := subject.SubmitQuizForUserWithUUID(
+				context.Background(),
+				quizUuid,
+				PracticeSession{StartedAt: finishedAt.Add(-time.Minute), FinishedAt: finishedAt, CardsReviewed: 2, CorrectAnswers: 1},
+				[]PhraseReview{{PhraseUuid: "chat-uuid", Correct: true}, {PhraseUuid: "chien-uuid", Correct: false}},
+				userUuid,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("doesn't reschedule anything for a quiz that was already submitted", func() {
+			mock.ExpectBegin()
+			mock.ExpectExec("UPDATE quizzes SET submitted_at = \\?").WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+
+			err := subject.SubmitQuizForUserWithUUID(
+				context.Background(),
+				quizUuid,
+				PracticeSession{FinishedAt: finishedAt},
+				[]PhraseReview{{PhraseUuid: "chat-uuid", Correct: true}},
+				userUuid,
+			)
+			Expect(err).To(Equal(ErrQuizAlreadySubmitted))
+		})
+	})
+})
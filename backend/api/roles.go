@@ -0,0 +1,80 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type Role string
+
+const ROLE_USER Role = "user"
+const ROLE_MODERATOR Role = "moderator"
+const ROLE_ADMIN Role = "admin"
+
+// each role can do everything the ones below it can
+var roleRanks = map[Role]int{
+	ROLE_USER:      1,
+	ROLE_MODERATOR: 2,
+	ROLE_ADMIN:     3,
+}
+
+// Includes says whether someone with this role is allowed
+// to do something that needs the required role
+func (role Role) Includes(required Role) bool {
+	rank, ok := roleRanks[role]
+	return ok && rank >= roleRanks[required]
+}
+
+func ParseRole(value string) (Role, error) {
+	role := Role(value)
+	if _, ok := roleRanks[role]; !ok {
+		return "", fmt.Errorf("role must be one of user, moderator or admin, not '%s'", value)
+	}
+
+	return role, nil
+}
+
+// A staff key is how moderators and admins prove who they are, since
+// anyone can see a user's uuid. It is their uuid and a random secret,
+// and only its hash is ever stored
+const staffKeySecretBytes = 32
+
+var ErrMalformedStaffKey = errors.New("that is not a staff key")
+
+// NewStaffKey makes a key for the user, along with the hash to store
+func NewStaffKey(userUuid uuid.UUID) (string, []byte, error) {
+	secret := make([]byte, staffKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, err
+	}
+
+	key := userUuid.String() + "." + base64.RawURLEncoding.EncodeToString(secret)
+	return key, HashStaffKey(key), nil
+}
+
+// ParseStaffKey says whose key it claims to be, which only
+// means anything once its hash has been checked against theirs
+func ParseStaffKey(key string) (uuid.UUID, error) {
+	parts := strings.SplitN(key, ".", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return uuid.UUID{}, ErrMalformedStaffKey
+	}
+
+	userUuid, err := uuid.Parse(parts[0])
+	if err != nil {
+		return uuid.UUID{}, ErrMalformedStaffKey
+	}
+
+	return userUuid, nil
+}
+
+func HashStaffKey(key string) []byte {
+	hash := sha256.Sum256([]byte(key))
+	return hash[:]
+}
@@ -0,0 +1,49 @@
+package api_test
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+var _ = Describe("Staff keys", func() {
+	userUuid := uuid.Must(uuid.Parse("f2f282d9-f738-463c-ab2d-27fcb5645bca"))
+
+	It("says whose key it is", func() {
+		key, hash, err := NewStaffKey(userUuid)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hash).To(Equal(HashStaffKey(key)))
+		Expect(hash).To(HaveLen(32))
+
+		owner, err := ParseStaffKey(key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(owner).To(Equal(userUuid))
+	})
+
+	It("has a long enough secret that it can't be guessed", func() {
+		key, _, err := NewStaffKey(userUuid)
+		Expect(err).NotTo(HaveOccurred())
+
+		secret := strings.TrimPrefix(key, userUuid.String()+".")
+		Expect(len(secret)).To(BeNumerically(">=", 43))
+	})
+
+	It("refuses a bare uuid", func() {
+		_, err := ParseStaffKey(userUuid.String())
+		Expect(err).To(Equal(ErrMalformedStaffKey))
+	})
+
+	It("refuses a key without a secret", func() {
+		_, err := ParseStaffKey(userUuid.String() + ".")
+		Expect(err).To(Equal(ErrMalformedStaffKey))
+	})
+
+	It("refuses a key that isn't for a uuid", func() {
+		_, err := ParseStaffKey("admin.the-secret")
+		Expect(err).To(Equal(ErrMalformedStaffKey))
+	})
+})
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+type SearchablePhrase struct {
+	Uuid        string
+	Content     string
+	Translation string
+	PhraseType  PhraseType
+}
+
+//go:generate counterfeiter . SearchRepository
+type SearchRepository interface {
+	AllPhrasesForUserWithUUID(context.Context, uuid.UUID) ([]SearchablePhrase, error)
+}
+
+func NewSearchRepository(db *sql.DB) SearchRepository {
+	return &searchRepo{db: db}
+}
+
+type searchRepo struct {
+	db *sql.DB
+}
+
+func (repo *searchRepo) AllPhrasesForUserWithUUID(ctx context.Context, userUuid uuid.UUID) ([]SearchablePhrase, error) {
+	rows, err := tracedQuery(
+		ctx,
+		repo.db,
+		"SELECT uuid, phrase, translation, phrase_type FROM phrases WHERE user_uuid = ? ORDER BY created_at, uuid",
+		userUuid.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	results := []SearchablePhrase{}
+	for rows.Next() {
+		phrase := SearchablePhrase{}
+		if err := rows.Scan(
+			&phrase.Uuid,
+			&phrase.Content,
+			&phrase.Translation,
+			&phrase.PhraseType,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, phrase)
+	}
+
+	return results, rows.Err()
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// statementRunner is satisfied by both *sql.DB and *sql.Tx.
+// Every statement runs under the request's context, so it is abandoned
+// as soon as the client goes away or the request runs out of time
+type statementRunner interface {
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+}
+
+// the spans around queries end once the first rows are back,
+// so they show how long MySQL took rather than how long we spent scanning
+func tracedQuery(ctx context.Context, runner statementRunner, statement string, args ...interface{}) (*sql.Rows, error) {
+	span := startStatementSpan(ctx, statement)
+	defer span.End()
+
+	rows, err := runner.QueryContext(ctx, statement, args...)
+	span.RecordError(err)
+	return rows, err
+}
+
+func tracedQueryRow(ctx context.Context, runner statementRunner, statement string, args ...interface{}) *sql.Row {
+	span := startStatementSpan(ctx, statement)
+	defer span.End()
+
+	return runner.QueryRowContext(ctx, statement, args...)
+}
+
+func tracedExec(ctx context.Context, runner statementRunner, statement string, args ...interface{}) (sql.Result, error) {
+	span := startStatementSpan(ctx, statement)
+	defer span.End()
+
+	result, err := runner.ExecContext(ctx, statement, args...)
+	span.RecordError(err)
+	return result, err
+}
+
+func startStatementSpan(ctx context.Context, statement string) tracing.Span {
+	operation := strings.ToUpper(strings.SplitN(strings.TrimSpace(statement), " ", 2)[0])
+
+	_, span := tracing.Start(ctx, "mysql "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "mysql"),
+			attribute.String("db.statement", statement),
+		),
+	)
+	return span
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+//go:generate counterfeiter . UsersRepository
+type UsersRepository interface {
+	// RecordActivity notes that the user did something today, adding them
+	// the first time they show up, and says whether their account is disabled
+	RecordActivity(context.Context, uuid.UUID) (bool, error)
+
+	// StaffRoleForUser is the user's role and the hash of their staff key,
+	// if they have one. The role is ROLE_USER for anyone we haven't
+	// given a role to, and no role at all for disabled accounts
+	StaffRoleForUser(context.Context, uuid.UUID) (Role, []byte, error)
+}
+
+func NewUsersRepository(db *sql.DB) UsersRepository {
+	return &usersRepo{db: db}
+}
+
+type usersRepo struct {
+	db *sql.DB
+}
+
+func (repo *usersRepo) RecordActivity(ctx context.Context, userUuid uuid.UUID) (bool, error) {
+	_, err := tracedExec(
+		ctx,
+		repo.db,
+		"INSERT INTO users (uuid) VALUES (?) ON DUPLICATE KEY UPDATE last_seen_at = CURRENT_TIMESTAMP(6)",
+		userUuid.String(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tracedExec(
+		ctx,
+		repo.db,
+		"INSERT IGNORE INTO user_activity (user_uuid, day) VALUES (?, CURRENT_DATE())",
+		userUuid.String(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	var disabled bool
+	err = tracedQueryRow(
+		ctx,
+		repo.db,
+		"SELECT disabled_at IS NOT NULL FROM users WHERE uuid = ?",
+		userUuid.String(),
+	).Scan(&disabled)
+
+	return disabled, err
+}
+
+func (repo *usersRepo) StaffRoleForUser(ctx context.Context, userUuid uuid.UUID) (Role, []byte, error) {
+	var role Role
+	var disabled bool
+	var keyHash []byte
+	err := tracedQueryRow(
+		ctx,
+		repo.db,
+		"SELECT role, disabled_at IS NOT NULL, staff_key_hash FROM users WHERE uuid = ?",
+		userUuid.String(),
+	).Scan(&role, &disabled, &keyHash)
+	if err == sql.ErrNoRows {
+		return ROLE_USER, nil, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	if disabled {
+		return "", keyHash, nil
+	}
+
+	return role, keyHash, nil
+}
@@ -3,12 +3,13 @@ package db
 import (
 	"database/sql"
 	"fmt"
+	"io"
+	"os"
 
 	cfenv "github.com/cloudfoundry-community/go-cfenv"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/mattes/migrate"
 	"github.com/mattes/migrate/database/mysql"
-	_ "github.com/mattes/migrate/source/file"
 )
 
 func OpenConnectionOrPanic(app *cfenv.App) *sql.DB {
@@ -27,7 +28,7 @@ func OpenConnectionOrPanic(app *cfenv.App) *sql.DB {
 	dbName, _ := dbService.CredentialString("name")
 
 	connectionStr := fmt.Sprintf(
-		"%s:%s@tcp(%s:%s)/%s",
+		"%s:%s@tcp(%s:%s)/%s?parseTime=true",
 		username,
 		password,
 		hostname,
@@ -42,32 +43,167 @@ func OpenConnectionOrPanic(app *cfenv.App) *sql.DB {
 
 	db.SetMaxIdleConns(0)
 
-	runMigrations(db)
-
 	return db
 }
 
-func runMigrations(db *sql.DB) {
-	driver, err := mysql.WithInstance(db, &mysql.Config{})
+// Migrator runs the embedded migrations against a database,
+// for `main migrate` and for migrating at boot
+type Migrator struct {
+	migrate    *migrate.Migrate
+	migrations *Migrations
+}
+
+// MigrationStatus describes where the database is. Version is 0
+// before the first migration has been run
+type MigrationStatus struct {
+	Version uint
+	Dirty   bool
+	Latest  uint
+	Pending []Migration
+}
+
+func NewMigrator(conn *sql.DB, log io.Writer) (*Migrator, error) {
+	migrations, err := LoadMigrations()
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
-	m, err := migrate.NewWithDatabaseInstance(
-		"file://db/migrations",
-		"mysql",
-		driver,
-	)
+	driver, err := mysql.WithInstance(conn, &mysql.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := migrate.NewWithInstance("embedded", migrations, "mysql", driver)
+	if err != nil {
+		return nil, err
+	}
+	m.Log = migrateLogger{out: log}
+
+	return &Migrator{migrate: m, migrations: migrations}, nil
+}
+
+func (migrator *Migrator) Status() (MigrationStatus, error) {
+	version, applied, dirty, err := migrator.version()
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+
+	status := MigrationStatus{
+		Version: version,
+		Dirty:   dirty,
+		Latest:  migrator.migrations.Latest(),
+		Pending: []Migration{},
+	}
+	if !dirty {
+		status.Pending, err = migrator.migrations.Plan(version, applied, UP, 0)
+	}
+
+	return status, err
+}
+
+// Plan returns the migrations Up or Down would run, without running them
+func (migrator *Migrator) Plan(direction string, steps int) ([]Migration, error) {
+	version, applied, dirty, err := migrator.version()
+	if err != nil {
+		return nil, err
+	}
+	if dirty {
+		return nil, fmt.Errorf(
+			"migration %d failed and left the database dirty, fix it by hand then run 'migrate force VERSION'",
+			version,
+		)
+	}
+
+	return migrator.migrations.Plan(version, applied, direction, steps)
+}
+
+// Up runs the next steps migrations, or all of them when steps is 0
+func (migrator *Migrator) Up(steps int) ([]Migration, error) {
+	return migrator.run(UP, steps)
+}
+
+// Down rolls back the last steps migrations. Rolling back the migrations
+// that create tables loses everything in them, so unless dropTables is set
+// it refuses to, before running any of the plan
+func (migrator *Migrator) Down(steps int, dropTables bool) ([]Migration, error) {
+	if !dropTables {
+		plan, err := migrator.Plan(DOWN, steps)
+		if err != nil {
+			return nil, err
+		}
+		for _, migration := range plan {
+			drops, err := migration.DropsTables()
+			if err != nil {
+				return nil, err
+			}
+			if drops {
+				return nil, fmt.Errorf("%w: %s", ErrDropsTables, migration.FileName)
+			}
+		}
+	}
+
+	return migrator.run(DOWN, steps)
+}
+
+func (migrator *Migrator) run(direction string, steps int) ([]Migration, error) {
+	plan, err := migrator.Plan(direction, steps)
+	if err != nil || len(plan) == 0 {
+		return plan, err
+	}
+
+	if direction == DOWN {
+		err = migrator.migrate.Steps(-len(plan))
+	} else {
+		err = migrator.migrate.Steps(len(plan))
+	}
+	if err == migrate.ErrNoChange {
+		err = nil
+	}
+
+	return plan, err
+}
+
+// Force records version as the current one without running anything,
+// for after a failed migration has been cleaned up by hand.
+// -1 means no migrations have been run
+func (migrator *Migrator) Force(version int) error {
+	if version != -1 && (version < 0 || !migrator.migrations.has(uint(version))) {
+		return fmt.Errorf("there is no migration %d", version)
+	}
+
+	return migrator.migrate.Force(version)
+}
+
+func (migrator *Migrator) version() (version uint, applied bool, dirty bool, err error) {
+	version, dirty, err = migrator.migrate.Version()
+	if err == migrate.ErrNilVersion {
+		return 0, false, false, nil
+	}
+
+	return version, err == nil, dirty, err
+}
+
+// MigrateOrPanic brings the database up to date when the server boots
+func MigrateOrPanic(conn *sql.DB) {
+	migrator, err := NewMigrator(conn, os.Stdout)
 	if err != nil {
 		panic(err)
 	}
 
-	err = m.Up()
-	if err != nil && err != migrate.ErrNoChange {
-		// yes, this api really returns an "error" when there are no changes to make
-		// no this is not a great API
-		// yes I was quite frustrated when I wrote this comment
-		// why do you ask ? ¯\_(ツ)_/¯
+	_, err = migrator.Up(0)
+	if err != nil {
 		panic(fmt.Sprintf("error during migration: %s", err.Error()))
 	}
 }
+
+type migrateLogger struct {
+	out io.Writer
+}
+
+func (logger migrateLogger) Printf(format string, v ...interface{}) {
+	fmt.Fprintf(logger.out, format, v...)
+}
+
+func (logger migrateLogger) Verbose() bool {
+	return false
+}
@@ -0,0 +1,46 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// Ping checks that the database is accepting connections
+func Ping(conn *sql.DB) func(context.Context) error {
+	return func(ctx context.Context) error {
+		return conn.PingContext(ctx)
+	}
+}
+
+// CheckMigrations checks that the database has every migration we ship
+// applied, and that none of them failed halfway through
+func CheckMigrations(conn *sql.DB) func(context.Context) error {
+	return func(ctx context.Context) error {
+		migrations, err := LoadMigrations()
+		if err != nil {
+			return err
+		}
+		expected := migrations.Latest()
+
+		var version uint
+		var dirty bool
+		err = conn.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+		if err == sql.ErrNoRows {
+			return errors.New("no migrations have been run")
+		}
+		if err != nil {
+			return err
+		}
+
+		if dirty {
+			return fmt.Errorf("migration %d failed and left the database dirty", version)
+		}
+		if version != expected {
+			return fmt.Errorf("database is at migration %d, expected %d", version, expected)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,235 @@
+package db
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/mattes/migrate/source"
+)
+
+// the migrations are compiled into the binary, so they run the same
+// no matter which directory the server (or `main migrate`) starts in
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+var migrationFileName = regexp.MustCompile(`^([0-9]+)_(.*)\.(up|down)\.sql$`)
+
+var dropTableStatement = regexp.MustCompile(`(?i)\bDROP\s+TABLE\b`)
+
+// ErrDropsTables is why Migrator.Down refuses to roll back
+// a table's creation unless it's told to
+var ErrDropsTables = errors.New("rolling back would drop tables and everything in them")
+
+const (
+	UP   = "up"
+	DOWN = "down"
+)
+
+// Migration is one .sql file, as shown by `main migrate status` and dry runs
+type Migration struct {
+	Version    uint
+	Identifier string
+	Direction  string
+	FileName   string
+}
+
+func (migration Migration) SQL() (string, error) {
+	contents, err := migrationFiles.ReadFile("migrations/" + migration.FileName)
+	return string(contents), err
+}
+
+// DropsTables says whether running the migration throws away a whole table
+func (migration Migration) DropsTables() (bool, error) {
+	sql, err := migration.SQL()
+	if err != nil {
+		return false, err
+	}
+
+	return dropTableStatement.MatchString(sql), nil
+}
+
+// Migrations is a mattes/migrate source that reads the migrations
+// out of the binary instead of from "file://db/migrations"
+type Migrations struct {
+	versions   []uint
+	migrations map[uint]map[string]Migration
+}
+
+func LoadMigrations() (*Migrations, error) {
+	entries, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Migrations{migrations: map[uint]map[string]Migration{}}
+	for _, entry := range entries {
+		matches := migrationFileName.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("migration '%s' should be named VERSION_NAME.(up|down).sql", entry.Name())
+		}
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.migrations[uint(version)] == nil {
+			result.migrations[uint(version)] = map[string]Migration{}
+			result.versions = append(result.versions, uint(version))
+		}
+		result.migrations[uint(version)][matches[3]] = Migration{
+			Version:    uint(version),
+			Identifier: matches[2],
+			Direction:  matches[3],
+			FileName:   entry.Name(),
+		}
+	}
+
+	sort.Slice(result.versions, func(i, j int) bool {
+		return result.versions[i] < result.versions[j]
+	})
+
+	return result, nil
+}
+
+func (migrations *Migrations) Latest() uint {
+	if len(migrations.versions) == 0 {
+		return 0
+	}
+
+	return migrations.versions[len(migrations.versions)-1]
+}
+
+func (migrations *Migrations) has(version uint) bool {
+	_, ok := migrations.migrations[version]
+	return ok
+}
+
+func (migrations *Migrations) Open(url string) (source.Driver, error) {
+	return nil, fmt.Errorf("embedded migrations cannot be opened from '%s'", url)
+}
+
+func (migrations *Migrations) Close() error {
+	return nil
+}
+
+func (migrations *Migrations) First() (uint, error) {
+	if len(migrations.versions) == 0 {
+		return 0, &os.PathError{Op: "first", Path: "migrations", Err: os.ErrNotExist}
+	}
+
+	return migrations.versions[0], nil
+}
+
+func (migrations *Migrations) Prev(version uint) (uint, error) {
+	index := migrations.indexOf(version)
+	if index <= 0 {
+		return 0, &os.PathError{Op: fmt.Sprintf("prev for version %d", version), Path: "migrations", Err: os.ErrNotExist}
+	}
+
+	return migrations.versions[index-1], nil
+}
+
+func (migrations *Migrations) Next(version uint) (uint, error) {
+	index := migrations.indexOf(version)
+	if index < 0 || index == len(migrations.versions)-1 {
+		return 0, &os.PathError{Op: fmt.Sprintf("next for version %d", version), Path: "migrations", Err: os.ErrNotExist}
+	}
+
+	return migrations.versions[index+1], nil
+}
+
+func (migrations *Migrations) ReadUp(version uint) (io.ReadCloser, string, error) {
+	return migrations.read(version, UP)
+}
+
+func (migrations *Migrations) ReadDown(version uint) (io.ReadCloser, string, error) {
+	return migrations.read(version, DOWN)
+}
+
+func (migrations *Migrations) read(version uint, direction string) (io.ReadCloser, string, error) {
+	migration, ok := migrations.migrations[version][direction]
+	if !ok {
+		return nil, "", &os.PathError{Op: fmt.Sprintf("read %s for version %d", direction, version), Path: "migrations", Err: os.ErrNotExist}
+	}
+
+	file, err := migrationFiles.Open("migrations/" + migration.FileName)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return file, migration.Identifier, nil
+}
+
+func (migrations *Migrations) indexOf(version uint) int {
+	for i, v := range migrations.versions {
+		if v == version {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Plan works out which migrations `up` or `down` would run, in order,
+// starting from the current version. Zero steps means every pending one
+// going up, but going down always needs an explicit count since
+// the very first down migration drops the phrases table
+func (migrations *Migrations) Plan(current uint, applied bool, direction string, steps int) ([]Migration, error) {
+	plan := []Migration{}
+
+	switch direction {
+	case UP:
+		next := 0
+		if applied {
+			index := migrations.indexOf(current)
+			if index < 0 {
+				return nil, fmt.Errorf("database is at migration %d, which this binary doesn't know about", current)
+			}
+			next = index + 1
+		}
+		for i := next; i < len(migrations.versions); i++ {
+			if steps > 0 && len(plan) == steps {
+				break
+			}
+			version := migrations.versions[i]
+			migration, ok := migrations.migrations[version][UP]
+			if !ok {
+				return nil, fmt.Errorf("migration %d has no up migration", version)
+			}
+			plan = append(plan, migration)
+		}
+	case DOWN:
+		if steps <= 0 {
+			return nil, fmt.Errorf("say how many migrations to roll back")
+		}
+		if !applied {
+			return nil, fmt.Errorf("no migrations have been run")
+		}
+		index := migrations.indexOf(current)
+		if index < 0 {
+			return nil, fmt.Errorf("database is at migration %d, which this binary doesn't know about", current)
+		}
+		if steps > index+1 {
+			return nil, fmt.Errorf("only %d migrations have been run, can't roll back %d", index+1, steps)
+		}
+		for i := index; i > index-steps; i-- {
+			version := migrations.versions[i]
+			migration, ok := migrations.migrations[version][DOWN]
+			if !ok {
+				return nil, fmt.Errorf("migration %d has no down migration", version)
+			}
+			plan = append(plan, migration)
+		}
+	default:
+		return nil, fmt.Errorf("unknown direction '%s'", direction)
+	}
+
+	return plan, nil
+}
@@ -1 +1 @@
-DROP INDEX phrase_by_user;
+DROP INDEX phrases_by_user ON phrases;
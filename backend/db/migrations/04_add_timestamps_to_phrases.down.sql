@@ -0,0 +1,5 @@
+ALTER TABLE phrases
+    DROP COLUMN `review_interval_days`,
+    DROP COLUMN `due_at`,
+    DROP COLUMN `updated_at`,
+    DROP COLUMN `created_at`;
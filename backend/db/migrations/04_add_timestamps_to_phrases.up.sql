@@ -0,0 +1,6 @@
+ALTER TABLE phrases
+    CONVERT TO CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci,
+    ADD COLUMN `created_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    ADD COLUMN `updated_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6),
+    ADD COLUMN `due_at` DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    ADD COLUMN `review_interval_days` INT UNSIGNED NOT NULL DEFAULT 0;
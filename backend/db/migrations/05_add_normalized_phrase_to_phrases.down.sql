@@ -0,0 +1,5 @@
+ALTER TABLE phrases
+    DROP INDEX phrases_by_duplicate_key,
+    DROP INDEX phrases_by_normalized_phrase,
+    DROP COLUMN `duplicate_key`,
+    DROP COLUMN `normalized_phrase`;
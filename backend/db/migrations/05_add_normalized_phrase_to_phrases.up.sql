@@ -0,0 +1,5 @@
+ALTER TABLE phrases
+    ADD COLUMN `normalized_phrase` TEXT CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    ADD COLUMN `duplicate_key` BINARY(32) NULL,
+    ADD INDEX phrases_by_normalized_phrase (user_uuid, normalized_phrase(191)),
+    ADD UNIQUE KEY phrases_by_duplicate_key (user_uuid, duplicate_key);
@@ -0,0 +1 @@
+UPDATE phrases SET normalized_phrase = '';
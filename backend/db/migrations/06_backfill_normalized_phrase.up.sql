@@ -0,0 +1,24 @@
+-- the same as api.NormalizePhrase: every kind of whitespace phrases are
+-- likely to have, including the non-breaking spaces French puts before
+-- punctuation, becomes a space, runs of spaces become one (each pass halves
+-- them, so nine passes are enough for 512), then it is trimmed and lowercased.
+-- Phrases with rarer unicode spaces are normalized in Go when next edited
+UPDATE phrases SET normalized_phrase = LOWER(TRIM(
+    REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(REPLACE(phrase
+        , CHAR(9 USING utf8mb4), ' ')
+        , CHAR(10 USING utf8mb4), ' ')
+        , CHAR(11 USING utf8mb4), ' ')
+        , CHAR(12 USING utf8mb4), ' ')
+        , CHAR(13 USING utf8mb4), ' ')
+        , _utf8mb4 X'C2A0', ' ')
+        , _utf8mb4 X'E280AF', ' ')
+        , '  ', ' ')
+        , '  ', ' ')
+        , '  ', ' ')
+        , '  ', ' ')
+        , '  ', ' ')
+        , '  ', ' ')
+        , '  ', ' ')
+        , '  ', ' ')
+        , '  ', ' ')
+));
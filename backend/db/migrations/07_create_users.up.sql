@@ -0,0 +1,10 @@
+CREATE TABLE users (
+    uuid varchar(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    last_seen_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    disabled_at DATETIME(6) NULL,
+    disabled_reason TEXT NULL,
+
+    PRIMARY KEY (uuid),
+    INDEX users_by_last_seen (last_seen_at)
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
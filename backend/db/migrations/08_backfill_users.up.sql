@@ -0,0 +1,2 @@
+INSERT IGNORE INTO users (uuid, created_at, last_seen_at)
+    SELECT user_uuid, MIN(created_at), MAX(updated_at) FROM phrases GROUP BY user_uuid;
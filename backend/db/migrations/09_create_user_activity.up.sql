@@ -0,0 +1,7 @@
+CREATE TABLE user_activity (
+    user_uuid varchar(36) NOT NULL,
+    day DATE NOT NULL,
+
+    PRIMARY KEY (user_uuid, day),
+    INDEX user_activity_by_day (day)
+);
@@ -0,0 +1 @@
+DELETE FROM user_activity;
@@ -0,0 +1,4 @@
+INSERT IGNORE INTO user_activity (user_uuid, day)
+    SELECT user_uuid, DATE(created_at) FROM phrases
+    UNION
+    SELECT user_uuid, DATE(updated_at) FROM phrases;
@@ -0,0 +1,18 @@
+CREATE TABLE audit_log (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    actor_uuid varchar(36) NULL,
+    actor_role varchar(16) NOT NULL,
+    action varchar(32) NOT NULL,
+    user_uuid varchar(36) NULL,
+    phrase_uuid varchar(36) NULL,
+    before_value TEXT NULL,
+    after_value TEXT NULL,
+    request_id varchar(128) NOT NULL,
+
+    PRIMARY KEY (id),
+    INDEX audit_log_by_user (user_uuid, id),
+    INDEX audit_log_by_phrase (phrase_uuid, id),
+    INDEX audit_log_by_actor (actor_uuid, id),
+    INDEX audit_log_by_action (action, id)
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
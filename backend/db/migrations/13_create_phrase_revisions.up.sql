@@ -0,0 +1,13 @@
+CREATE TABLE phrase_revisions (
+    phrase_uuid varchar(36) NOT NULL,
+    revision INT UNSIGNED NOT NULL,
+    user_uuid varchar(36) NOT NULL,
+    created_at DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    phrase TEXT NOT NULL,
+    translation TEXT NOT NULL,
+    reverted_from INT UNSIGNED NULL,
+    device varchar(255) NOT NULL DEFAULT '',
+    request_id varchar(128) NOT NULL DEFAULT '',
+
+    PRIMARY KEY (phrase_uuid, revision)
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
@@ -0,0 +1 @@
+DELETE FROM phrase_revisions;
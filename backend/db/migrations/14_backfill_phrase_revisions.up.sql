@@ -0,0 +1,2 @@
+INSERT IGNORE INTO phrase_revisions (phrase_uuid, revision, user_uuid, created_at, phrase, translation)
+    SELECT uuid, 1, user_uuid, updated_at, phrase, translation FROM phrases;
@@ -0,0 +1 @@
+DROP TABLE practice_sessions;
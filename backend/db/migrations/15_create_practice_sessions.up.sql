@@ -0,0 +1,13 @@
+CREATE TABLE practice_sessions (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    user_uuid varchar(36) NOT NULL,
+    phrase_type varchar(32) NOT NULL,
+    started_at DATETIME(6) NOT NULL,
+    finished_at DATETIME(6) NOT NULL,
+    cards_reviewed INT UNSIGNED NOT NULL,
+    correct_answers INT UNSIGNED NOT NULL,
+
+    PRIMARY KEY (id),
+    INDEX practice_sessions_by_user (user_uuid, finished_at),
+    INDEX practice_sessions_by_finished_at (finished_at)
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
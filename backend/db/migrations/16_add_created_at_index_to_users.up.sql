@@ -0,0 +1 @@
+ALTER TABLE users ADD INDEX users_by_created_at (created_at);
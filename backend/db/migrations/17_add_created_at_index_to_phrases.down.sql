@@ -0,0 +1 @@
+ALTER TABLE phrases DROP INDEX phrases_by_created_at;
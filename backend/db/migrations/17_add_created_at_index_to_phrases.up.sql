@@ -0,0 +1 @@
+ALTER TABLE phrases ADD INDEX phrases_by_created_at (created_at);
@@ -0,0 +1,4 @@
+ALTER TABLE users
+    DROP COLUMN `timezone`,
+    DROP COLUMN `daily_goal_type`,
+    DROP COLUMN `daily_goal_target`;
@@ -0,0 +1,4 @@
+ALTER TABLE users
+    ADD COLUMN `timezone` varchar(64) NOT NULL DEFAULT 'UTC',
+    ADD COLUMN `daily_goal_type` varchar(16) NOT NULL DEFAULT 'cards',
+    ADD COLUMN `daily_goal_target` INT UNSIGNED NOT NULL DEFAULT 20;
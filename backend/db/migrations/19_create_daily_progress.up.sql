@@ -0,0 +1,8 @@
+CREATE TABLE daily_progress (
+    user_uuid varchar(36) NOT NULL,
+    day DATE NOT NULL,
+    cards_reviewed INT UNSIGNED NOT NULL DEFAULT 0,
+    phrases_added INT UNSIGNED NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (user_uuid, day)
+);
@@ -0,0 +1 @@
+DELETE FROM daily_progress;
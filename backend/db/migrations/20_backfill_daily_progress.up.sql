@@ -0,0 +1,6 @@
+INSERT IGNORE INTO daily_progress (user_uuid, day, cards_reviewed, phrases_added)
+    SELECT user_uuid, day, SUM(cards_reviewed), SUM(phrases_added) FROM (
+        SELECT user_uuid, DATE(created_at) AS day, 0 AS cards_reviewed, 1 AS phrases_added FROM phrases
+        UNION ALL
+        SELECT user_uuid, DATE(finished_at), cards_reviewed, 0 FROM practice_sessions
+    ) AS progress GROUP BY user_uuid, day;
@@ -0,0 +1,7 @@
+CREATE TABLE user_achievements (
+    user_uuid varchar(36) NOT NULL,
+    achievement_id varchar(64) NOT NULL,
+    awarded_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_uuid, achievement_id)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE quizzes (
+    uuid varchar(36) NOT NULL,
+    user_uuid varchar(36) NOT NULL,
+    phrase_type varchar(32) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    submitted_at DATETIME(6) NULL,
+    questions MEDIUMTEXT NOT NULL,
+
+    PRIMARY KEY (uuid),
+    INDEX quizzes_by_user (user_uuid, created_at)
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
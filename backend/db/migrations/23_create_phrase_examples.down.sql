@@ -0,0 +1 @@
+DROP TABLE phrase_examples;
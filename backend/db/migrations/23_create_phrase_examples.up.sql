@@ -0,0 +1,9 @@
+CREATE TABLE phrase_examples (
+    phrase_uuid varchar(36) NOT NULL,
+    position INT UNSIGNED NOT NULL,
+    user_uuid varchar(36) NOT NULL,
+    sentence TEXT NOT NULL,
+
+    PRIMARY KEY (phrase_uuid, position),
+    INDEX phrase_examples_by_user (user_uuid)
+) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci;
@@ -0,0 +1 @@
+UPDATE phrases SET duplicate_key = NULL;
@@ -0,0 +1,10 @@
+-- the same as api's duplicateKeySQL. Only one phrase of each group of
+-- duplicates can have the key, the rest go without until they are merged
+UPDATE phrases
+JOIN (
+    SELECT MIN(uuid) AS uuid
+    FROM phrases
+    WHERE normalized_phrase <> ''
+    GROUP BY user_uuid, SHA2(CONCAT(phrase_type, ':', normalized_phrase), 256)
+) AS first_of_each ON phrases.uuid = first_of_each.uuid
+SET phrases.duplicate_key = UNHEX(SHA2(CONCAT(phrases.phrase_type, ':', phrases.normalized_phrase), 256));
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN `staff_key_hash` BINARY(32) NULL;
@@ -0,0 +1 @@
+ALTER TABLE quizzes DROP INDEX quizzes_unsubmitted_by_age;
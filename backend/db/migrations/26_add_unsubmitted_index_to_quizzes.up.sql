@@ -0,0 +1 @@
+ALTER TABLE quizzes ADD INDEX quizzes_unsubmitted_by_age (submitted_at, created_at);
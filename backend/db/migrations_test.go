@@ -0,0 +1,201 @@
+package db_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/db"
+)
+
+var _ = Describe("Migrations", func() {
+	var subject *Migrations
+
+	// what's on disk, so adding a migration doesn't mean editing this test
+	var latestOnDisk uint
+	var filesOnDisk map[uint][]string
+
+	BeforeEach(func() {
+		var err error
+		subject, err = LoadMigrations()
+		Expect(err).NotTo(HaveOccurred())
+
+		entries, err := ioutil.ReadDir("migrations")
+		Expect(err).NotTo(HaveOccurred())
+
+		name := regexp.MustCompile(`^([0-9]+)_.*\.(up|down)\.sql$`)
+		latestOnDisk = 0
+		filesOnDisk = map[uint][]string{}
+		for _, entry := range entries {
+			matches := name.FindStringSubmatch(entry.Name())
+			Expect(matches).NotTo(BeNil(), entry.Name())
+			version, err := strconv.ParseUint(matches[1], 10, 64)
+			Expect(err).NotTo(HaveOccurred())
+
+			filesOnDisk[uint(version)] = append(filesOnDisk[uint(version)], matches[2])
+			if uint(version) > latestOnDisk {
+				latestOnDisk = uint(version)
+			}
+		}
+	})
+
+	It("numbers the migrations one after another, each with an up and a down", func() {
+		Expect(latestOnDisk).To(BeNumerically(">", 0))
+		for version := uint(1); version <= latestOnDisk; version++ {
+			Expect(filesOnDisk[version]).To(ConsistOf("up", "down"), fmt.Sprintf("migration %d", version))
+		}
+		Expect(filesOnDisk).To(HaveLen(int(latestOnDisk)))
+	})
+
+	It("embeds every migration in the binary", func() {
+		Expect(subject.Latest()).To(Equal(latestOnDisk))
+
+		first, err := subject.First()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first).To(Equal(uint(1)))
+
+		reader, identifier, err := subject.ReadUp(1)
+		Expect(err).NotTo(HaveOccurred())
+		defer reader.Close()
+
+		contents, err := ioutil.ReadAll(reader)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(identifier).To(Equal("french_phrases_by_user_uuid"))
+		Expect(string(contents)).To(ContainSubstring("CREATE TABLE phrases"))
+	})
+
+	It("walks through the versions in order", func() {
+		next, err := subject.Next(1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(next).To(Equal(uint(2)))
+
+		prev, err := subject.Prev(6)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(prev).To(Equal(uint(5)))
+	})
+
+	It("says so when there is nothing before or after a version", func() {
+		_, err := subject.Prev(1)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		_, err = subject.Next(latestOnDisk)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+
+		_, _, err = subject.ReadDown(latestOnDisk + 1)
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	Describe("planning", func() {
+		fileNames := func(plan []Migration) []string {
+			names := []string{}
+			for _, migration := range plan {
+				names = append(names, migration.FileName)
+			}
+			return names
+		}
+
+		It("runs everything on a fresh database", func() {
+			plan, err := subject.Plan(0, false, UP, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(HaveLen(int(latestOnDisk)))
+			Expect(plan[0].FileName).To(Equal("01_french_phrases_by_user_uuid.up.sql"))
+			for i, migration := range plan {
+				Expect(migration.Version).To(Equal(uint(i + 1)))
+				Expect(migration.Direction).To(Equal(UP))
+			}
+		})
+
+		It("runs only the pending migrations", func() {
+			plan, err := subject.Plan(4, true, UP, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(HaveLen(int(latestOnDisk) - 4))
+			Expect(plan[0].FileName).To(Equal("05_add_normalized_phrase_to_phrases.up.sql"))
+			Expect(plan[len(plan)-1].Version).To(Equal(latestOnDisk))
+		})
+
+		It("stops after the number of steps asked for", func() {
+			plan, err := subject.Plan(3, true, UP, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fileNames(plan)).To(Equal([]string{"04_add_timestamps_to_phrases.up.sql"}))
+		})
+
+		It("has nothing to do when the database is up to date", func() {
+			plan, err := subject.Plan(latestOnDisk, true, UP, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(BeEmpty())
+		})
+
+		It("rolls back from the newest migration", func() {
+			plan, err := subject.Plan(3, true, DOWN, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fileNames(plan)).To(Equal([]string{
+				"03_add_translation_to_phrase.down.sql",
+				"02_add_index_to_phrases.down.sql",
+			}))
+
+			sql, err := plan[1].SQL()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sql).To(ContainSubstring("DROP INDEX phrases_by_user ON phrases"))
+		})
+
+		It("knows which down migrations drop tables", func() {
+			plan, err := subject.Plan(2, true, DOWN, 2)
+			Expect(err).NotTo(HaveOccurred())
+
+			drops, err := plan[0].DropsTables()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drops).To(BeFalse())
+
+			drops, err = plan[1].DropsTables()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drops).To(BeTrue())
+		})
+
+		It("refuses to roll back without being told how far", func() {
+			_, err := subject.Plan(6, true, DOWN, 0)
+			Expect(err).To(MatchError("say how many migrations to roll back"))
+		})
+
+		It("refuses to roll back more than has been run", func() {
+			_, err := subject.Plan(2, true, DOWN, 3)
+			Expect(err).To(MatchError("only 2 migrations have been run, can't roll back 3"))
+		})
+
+		It("refuses to plan from a version it doesn't know about", func() {
+			_, err := subject.Plan(latestOnDisk+1, true, UP, 0)
+			Expect(err).To(MatchError(fmt.Sprintf(
+				"database is at migration %d, which this binary doesn't know about",
+				latestOnDisk+1,
+			)))
+		})
+	})
+
+	Describe("CheckMigrations", func() {
+		expectVersion := func(version uint) error {
+			conn, mock, err := sqlmock.New()
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").
+				WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(version, false))
+
+			return CheckMigrations(conn)(context.Background())
+		}
+
+		It("expects the database to be at the last migration on disk", func() {
+			Expect(expectVersion(latestOnDisk)).To(Succeed())
+			Expect(expectVersion(latestOnDisk - 1)).To(MatchError(fmt.Sprintf(
+				"database is at migration %d, expected %d",
+				latestOnDisk-1,
+				latestOnDisk,
+			)))
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+const grantRoleUsage = `usage: main grant-role USER_UUID ROLE
+
+gives the user the role (user, moderator or admin), and prints the
+staff key they need to use it. Any key they had before stops working`
+
+// runGrantRoleCommand is `main grant-role ...`, which is how the first admin
+// gets made, e.g. with "cf run-task doit-etre-rad-backend './main grant-role UUID admin'".
+// The key ends up in the task's logs, so rotate it from the admin API afterwards
+func runGrantRoleCommand(useCase usecases.ChangeRoleUseCase, args []string, out io.Writer) error {
+	if len(args) != 2 {
+		return errors.New(grantRoleUsage)
+	}
+
+	userUuid, err := uuid.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("'%s' is not a user uuid", args[0])
+	}
+
+	response, err := useCase.Execute(context.Background(), usecases.ChangeRoleRequest{
+		UserUUID: userUuid,
+		Role:     args[1],
+	})
+	if validationErr, ok := err.(usecases.ValidationError); ok {
+		return fmt.Errorf("%s\n\n%s", validationErr.Error(), grantRoleUsage)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "%s is now a %s\n", userUuid, response.Role)
+	if response.StaffKey != "" {
+		fmt.Fprintf(out, "their staff key, which won't be shown again: %s\n", response.StaffKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+// AccountCheckInterval is how long we trust what we last heard about an account.
+// It is also how long disabling an account can take to kick in
+const AccountCheckInterval = time.Minute
+
+// DeviceHeader lets clients name the device a change was made on, for the
+// phrase history. Without it we fall back to the User-Agent
+const DeviceHeader = "X-Device"
+
+const maxDeviceLength = 255
+
+var errAccountDisabled = errors.New("this account has been disabled")
+
+type AccountCheck struct {
+	users     api.UsersRepository
+	clock     func() time.Time
+	mutex     sync.Mutex
+	accounts  map[uuid.UUID]accountStatus
+	lastSweep time.Time
+}
+
+type accountStatus struct {
+	checkedAt time.Time
+	disabled  bool
+}
+
+// NewAccountCheck turns away users whose account has been disabled,
+// and keeps track of when everyone else was last active. Each user is only
+// looked up once per AccountCheckInterval, rather than on every request
+func NewAccountCheck(users api.UsersRepository, clock func() time.Time) *AccountCheck {
+	return &AccountCheck{
+		users:     users,
+		clock:     clock,
+		accounts:  map[uuid.UUID]accountStatus{},
+		lastSweep: clock(),
+	}
+}
+
+// Check wraps a handler; requests without a user token go straight through
+func (check *AccountCheck) Check(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		userUuid, err := uuid.Parse(request.Header.Get("X-User-Token"))
+		if err != nil {
+			handler.ServeHTTP(writer, request)
+			return
+		}
+
+		if check.disabled(request, userUuid) {
+			writeError(writer, errAccountDisabled, http.StatusForbidden)
+			return
+		}
+
+		// whatever the user changes is put down to them in the audit log
+		ctx := api.WithActor(request.Context(), api.Actor{
+			UserUUID:  &userUuid,
+			Role:      api.ROLE_USER,
+			Device:    deviceFromRequest(request),
+			RequestID: RequestIDFromContext(request.Context()),
+		})
+		handler.ServeHTTP(writer, request.WithContext(ctx))
+	})
+}
+
+// like the rate limiter, this lets everyone through when the database
+// is having trouble, the handler will have its own trouble soon enough
+func (check *AccountCheck) disabled(request *http.Request, userUuid uuid.UUID) bool {
+	now := check.clock()
+
+	check.mutex.Lock()
+	status, ok := check.accounts[userUuid]
+	check.mutex.Unlock()
+	if ok && now.Sub(status.checkedAt) < AccountCheckInterval {
+		return status.disabled
+	}
+
+	disabled, err := check.users.RecordActivity(request.Context(), userUuid)
+	if err != nil {
+		return false
+	}
+
+	check.mutex.Lock()
+	defer check.mutex.Unlock()
+	check.accounts[userUuid] = accountStatus{checkedAt: now, disabled: disabled}
+	if now.Sub(check.lastSweep) > 10*AccountCheckInterval {
+		for key, status := range check.accounts {
+			if now.Sub(status.checkedAt) >= AccountCheckInterval {
+				delete(check.accounts, key)
+			}
+		}
+		check.lastSweep = now
+	}
+
+	return disabled
+}
+
+func deviceFromRequest(request *http.Request) string {
+	device := request.Header.Get(DeviceHeader)
+	if device == "" {
+		device = request.UserAgent()
+	}
+	if len(device) > maxDeviceLength {
+		device = strings.ToValidUTF8(device[:maxDeviceLength], "")
+	}
+
+	return device
+}
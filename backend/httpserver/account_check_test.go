@@ -0,0 +1,117 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("AccountCheck", func() {
+	var (
+		users   *apifakes.FakeUsersRepository
+		now     time.Time
+		subject *AccountCheck
+		served  int
+		actor   api.Actor
+	)
+
+	serve := func(token string) *httptest.ResponseRecorder {
+		request, err := http.NewRequest("GET", "/api/phrases/french", nil)
+		Expect(err).NotTo(HaveOccurred())
+		if token != "" {
+			request.Header.Set("X-User-Token", token)
+		}
+
+		writer := httptest.NewRecorder()
+		subject.Check(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served++
+			actor = api.ActorFromContext(r.Context())
+		})).ServeHTTP(writer, request)
+		return writer
+	}
+
+	BeforeEach(func() {
+		users = new(apifakes.FakeUsersRepository)
+		now = time.Date(2018, 3, 1, 12, 0, 0, 0, time.UTC)
+		served = 0
+		actor = api.Actor{}
+		subject = NewAccountCheck(users, func() time.Time { return now })
+	})
+
+	It("records the user's activity and lets them through", func() {
+		writer := serve(userUUID.String())
+
+		Expect(writer.Code).To(Equal(http.StatusOK))
+		Expect(served).To(Equal(1))
+		Expect(users.RecordActivityCallCount()).To(Equal(1))
+		_, recorded := users.RecordActivityArgsForCall(0)
+		Expect(recorded).To(Equal(userUUID))
+	})
+
+	It("puts whatever the user changes down to them", func() {
+		serve(userUUID.String())
+
+		Expect(actor.UserUUID).NotTo(BeNil())
+		Expect(*actor.UserUUID).To(Equal(userUUID))
+		Expect(actor.Role).To(Equal(api.ROLE_USER))
+	})
+
+	It("only looks the user up once a minute", func() {
+		serve(userUUID.String())
+		now = now.Add(30 * time.Second)
+		serve(userUUID.String())
+		Expect(users.RecordActivityCallCount()).To(Equal(1))
+
+		now = now.Add(AccountCheckInterval)
+		serve(userUUID.String())
+		Expect(users.RecordActivityCallCount()).To(Equal(2))
+		Expect(served).To(Equal(3))
+	})
+
+	It("ignores requests without a user token", func() {
+		serve("")
+		serve("not-a-uuid")
+
+		Expect(served).To(Equal(2))
+		Expect(users.RecordActivityCallCount()).To(Equal(0))
+	})
+
+	Context("when the account has been disabled", func() {
+		BeforeEach(func() {
+			users.RecordActivityReturns(true, nil)
+		})
+
+		It("turns the user away, without asking again for a while", func() {
+			writer := serve(userUUID.String())
+			Expect(writer.Code).To(Equal(http.StatusForbidden))
+			Expect(writer.Body.String()).To(Equal(`{"error":"this account has been disabled"}`))
+
+			writer = serve(userUUID.String())
+			Expect(writer.Code).To(Equal(http.StatusForbidden))
+			Expect(users.RecordActivityCallCount()).To(Equal(1))
+			Expect(served).To(Equal(0))
+		})
+	})
+
+	Context("when the database is unavailable", func() {
+		BeforeEach(func() {
+			users.RecordActivityReturns(false, errors.New("connection refused"))
+		})
+
+		It("lets the user through, and tries again on the next request", func() {
+			serve(userUUID.String())
+			serve(userUUID.String())
+
+			Expect(served).To(Equal(2))
+			Expect(users.RecordActivityCallCount()).To(Equal(2))
+		})
+	})
+})
@@ -1,11 +1,13 @@
 package httpserver
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
 
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
 	"github.com/tjarratt/doit-etre-rad/backend/usecases"
 )
 
@@ -29,17 +31,30 @@ type addPhraseHandler struct {
 }
 
 func (handler addPhraseHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "AddPhraseParamReader.ReadParamsFromRequest")
 	params, userUuid, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
 	if err != nil {
 		writeError(writer, err, http.StatusBadRequest)
 		return
 	}
 
-	phrase, err := handler.useCase.Execute(usecases.AddPhraseRequest{
+	phrase, err := handler.useCase.Execute(request.Context(), usecases.AddPhraseRequest{
 		UserUUID: *userUuid,
 		Phrases:  mapPhrases(params),
 	})
 
+	if validationErr, ok := err.(usecases.ValidationError); ok {
+		writeValidationError(writer, validationErr)
+		return
+	}
+
+	if err == api.ErrDuplicatePhrase {
+		writeError(writer, err, http.StatusConflict)
+		return
+	}
+
 	if err != nil {
 		writeError(writer, err, http.StatusBadRequest)
 		return
@@ -51,6 +66,14 @@ func (handler addPhraseHandler) ServeHTTP(writer http.ResponseWriter, request *h
 		return
 	}
 
+	// the rest of the sync is saved, but the client has to be told
+	// that what it sent for the duplicates wasn't
+	for _, synced := range phrase {
+		if synced.Duplicate {
+			writer.WriteHeader(http.StatusConflict)
+			break
+		}
+	}
 	writer.Write([]byte(responseBody))
 }
 
@@ -67,11 +90,46 @@ func mapPhrases(params []AddPhraseParams) []usecases.AddPhraseItem {
 	return result
 }
 
+func writeValidationError(writer http.ResponseWriter, err usecases.ValidationError) {
+	responseBody, _ := json.Marshal(struct {
+		Error     string                `json:"error"`
+		Fields    []usecases.FieldError `json:"fields"`
+		RequestID string                `json:"requestId,omitempty"`
+	}{err.Error(), err.Errors, writer.Header().Get(RequestIDHeader)})
+
+	recordError(writer, err)
+	writer.WriteHeader(http.StatusUnprocessableEntity)
+	writer.Write(responseBody)
+}
+
+// writeError overrides the status for requests that ran out of time or were
+// abandoned, and for bodies we couldn't read, whatever the handler expected
+// to go wrong
 func writeError(writer http.ResponseWriter, err error, statusCode int) {
+	// the context's errors usually come back wrapped, by database/sql if nothing else
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		statusCode = http.StatusGatewayTimeout
+	case errors.Is(err, context.Canceled):
+		statusCode = statusClientClosedRequest
+	}
+	var bodyErr RequestBodyError
+	if errors.As(err, &bodyErr) {
+		statusCode = bodyErr.Status
+	}
+
+	recordError(writer, err)
 	writer.WriteHeader(statusCode)
-	writer.Write([]byte(wrap(err).Error()))
+	writer.Write(errorBody(err, writer.Header().Get(RequestIDHeader)))
 }
 
-func wrap(err error) error {
-	return errors.New(fmt.Sprintf(`{"error": "%s"}`, err.Error()))
+// errorBody is marshalled rather than formatted, because error messages
+// can have whatever the client sent in them
+func errorBody(err error, requestID string) []byte {
+	body, _ := json.Marshal(struct {
+		Error     string `json:"error"`
+		RequestID string `json:"requestId,omitempty"`
+	}{err.Error(), requestID})
+
+	return body
 }
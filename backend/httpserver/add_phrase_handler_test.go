@@ -1,12 +1,14 @@
 package httpserver_test
 
 import (
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 
 	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
 	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
 	"github.com/tjarratt/doit-etre-rad/backend/usecases"
 	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
@@ -49,23 +51,24 @@ var _ = Describe("AddPhraseHandler", func() {
 				Translation: "the-translation",
 				UUID:        &phraseUUID,
 			}}, &userUUID, nil)
-			phraseResponse := []usecases.PhraseResponse{{
+			phraseResponse := []usecases.SyncedPhraseResponse{{PhraseResponse: usecases.PhraseResponse{
 				Uuid:        "the-uuid",
 				Content:     "the-content",
 				Translation: "the-translation",
-			}}
+			}}}
 			useCase.ExecuteReturns(phraseResponse, nil)
 		})
 
 		It("returns JSON describing the resource created", func() {
 			expectedBody := `[{"uuid":"the-uuid","content":"the-content","translation":"the-translation"}]`
+			Expect(writer.Code).To(Equal(http.StatusOK))
 			Expect(writer.Body.String()).To(Equal(expectedBody))
 		})
 
 		It("provides the phrases to the use case", func() {
 			Expect(useCase.ExecuteCallCount()).To(Equal(1))
 
-			request := useCase.ExecuteArgsForCall(0)
+			_, request := useCase.ExecuteArgsForCall(0)
 			Expect(request.UserUUID).To(Equal(userUUID))
 			Expect(request.Phrases).To(Equal([]usecases.AddPhraseItem{{
 				Phrase:      "the-content",
@@ -75,25 +78,85 @@ var _ = Describe("AddPhraseHandler", func() {
 		})
 	})
 
+	Describe("when the user already had one of the phrases, saved differently", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns([]AddPhraseParams{}, &userUUID, nil)
+			useCase.ExecuteReturns([]usecases.SyncedPhraseResponse{{
+				PhraseResponse: usecases.PhraseResponse{Uuid: "the-uuid", Content: "le chat", Translation: "the cat"},
+				Duplicate:      true,
+			}, {
+				PhraseResponse: usecases.PhraseResponse{Uuid: "another-uuid", Content: "le chien", Translation: "the dog"},
+			}}, nil)
+		})
+
+		It("says there was a conflict, with the phrases the user has", func() {
+			Expect(writer.Code).To(Equal(http.StatusConflict))
+			Expect(writer.Body.String()).To(Equal(
+				`[{"uuid":"the-uuid","content":"le chat","translation":"the cat","duplicate":true},` +
+					`{"uuid":"another-uuid","content":"le chien","translation":"the dog"}]`,
+			))
+		})
+	})
+
+	Describe("when another request saved the same phrase at the same time", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns([]AddPhraseParams{}, &userUUID, nil)
+			useCase.ExecuteReturns(nil, api.ErrDuplicatePhrase)
+		})
+
+		It("says there was a conflict", func() {
+			Expect(writer.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
 	Describe("when the params cannot be read", func() {
 		BeforeEach(func() {
 			paramReader.ReadParamsFromRequestReturns([]AddPhraseParams{}, nil, errors.New("too many splines to reticulate"))
 		})
 
 		It("returns an error when the params cannot be read", func() {
-			expectedError := `{"error": "too many splines to reticulate"}`
+			expectedError := `{"error":"too many splines to reticulate"}`
 			Expect(writer.Body.String()).To(Equal(expectedError))
 		})
 	})
 
+	Describe("when the error repeats what the client sent", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns([]AddPhraseParams{}, nil, RequestBodyError{
+				Status:  http.StatusUnprocessableEntity,
+				Message: `unknown field 'a", "admin": "true'`,
+			})
+		})
+
+		It("escapes it, so it can't change the shape of the body", func() {
+			var body map[string]string
+			Expect(json.Unmarshal(writer.Body.Bytes(), &body)).To(Succeed())
+			Expect(body).To(Equal(map[string]string{"error": `unknown field 'a", "admin": "true'`}))
+		})
+	})
+
+	Describe("when the body is too large", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns([]AddPhraseParams{}, nil, RequestBodyError{
+				Status:  http.StatusRequestEntityTooLarge,
+				Message: "request body must be at most 1048576 bytes",
+			})
+		})
+
+		It("responds with the status the param reader chose", func() {
+			Expect(writer.Code).To(Equal(http.StatusRequestEntityTooLarge))
+			Expect(writer.Body.String()).To(Equal(`{"error":"request body must be at most 1048576 bytes"}`))
+		})
+	})
+
 	Describe("when the usecase returns an error", func() {
 		BeforeEach(func() {
 			paramReader.ReadParamsFromRequestReturns([]AddPhraseParams{}, &userUUID, nil)
-			useCase.ExecuteReturns([]usecases.PhraseResponse{}, errors.New("retro encabulator waneshaft requires new lunar ambifacient"))
+			useCase.ExecuteReturns([]usecases.SyncedPhraseResponse{}, errors.New("retro encabulator waneshaft requires new lunar ambifacient"))
 		})
 
 		It("returns an error when the use case returns an error", func() {
-			expectedError := `{"error": "retro encabulator waneshaft requires new lunar ambifacient"}`
+			expectedError := `{"error":"retro encabulator waneshaft requires new lunar ambifacient"}`
 			Expect(writer.Body.String()).To(Equal(expectedError))
 		})
 	})
@@ -1,9 +1,8 @@
 package httpserver
 
 import (
-	"encoding/json"
 	"errors"
-	"io/ioutil"
+	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -14,6 +13,10 @@ type AddPhraseParamReader interface {
 	ReadParamsFromRequest(*http.Request) ([]AddPhraseParams, *uuid.UUID, error)
 }
 
+// MaxPhrasesPerSync matches the largest page of phrases, so a client
+// that pages through them can always send back a page it was given
+const MaxPhrasesPerSync = 500
+
 type AddPhraseParams struct {
 	Phrase      string
 	Translation string
@@ -39,44 +42,48 @@ func (paramReader addPhraseParamReader) ReadParamsFromRequest(
 		return []AddPhraseParams{}, nil, err
 	}
 
-	bodyStr, err := ioutil.ReadAll(request.Body)
-	if err != nil {
-		return []AddPhraseParams{}, nil, err
-	}
-
-	requestObj := []map[string]string{}
-	err = json.Unmarshal(bodyStr, &requestObj)
+	requestObj := []struct {
+		Content     *string `json:"content"`
+		Translation string  `json:"translation"`
+		UUID        string  `json:"uuid"`
+	}{}
+	err = decodeJSONBody(request, &requestObj)
 	if err != nil {
 		return []AddPhraseParams{}, nil, err
 	}
 	if len(requestObj) == 0 {
 		return []AddPhraseParams{}, nil, errors.New("You must specify at least one phrase")
 	}
+	if len(requestObj) > MaxPhrasesPerSync {
+		return []AddPhraseParams{}, nil, RequestBodyError{
+			Status:  http.StatusUnprocessableEntity,
+			Message: fmt.Sprintf("a sync can contain at most %d phrases, split it into smaller batches", MaxPhrasesPerSync),
+		}
+	}
 
 	params := []AddPhraseParams{}
-	for _, obj := range requestObj {
-		content, ok := obj["content"]
-		if !ok {
+	for i, obj := range requestObj {
+		if obj.Content == nil {
 			return []AddPhraseParams{}, nil, errors.New("could not read phrase from request body")
 		}
 
+		// new phrases don't have a uuid yet
 		var phraseUUID *uuid.UUID
-		parsedUUID, err := uuid.Parse(obj["uuid"])
-		if err != nil {
-			phraseUUID = nil
-		} else {
+		if obj.UUID != "" {
+			parsedUUID, err := uuid.Parse(obj.UUID)
+			if err != nil {
+				return []AddPhraseParams{}, nil, RequestBodyError{
+					Status:  http.StatusUnprocessableEntity,
+					Message: fmt.Sprintf("phrases[%d].uuid is not a valid uuid", i),
+				}
+			}
 			phraseUUID = &parsedUUID
 		}
 
-		translation, ok := obj["translation"]
-		if !ok {
-			translation = ""
-		}
-
 		params = append(params, AddPhraseParams{
 			UUID:        phraseUUID,
-			Phrase:      content,
-			Translation: translation,
+			Phrase:      *obj.Content,
+			Translation: obj.Translation,
 		})
 	}
 
@@ -105,6 +105,110 @@ var _ = Describe("AddPhraseParamReader", func() {
 				Expect(resultErr).To(HaveOccurred())
 			})
 		})
+
+		Context("when a field has the wrong type", func() {
+			BeforeEach(func() {
+				requestBody = strings.NewReader(`[{"content": "the-phrase", "translation": 42}]`)
+			})
+
+			It("says which field is wrong", func() {
+				Expect(resultErr).To(Equal(RequestBodyError{
+					Status:  http.StatusUnprocessableEntity,
+					Message: "translation must be a string, not number",
+				}))
+			})
+		})
+
+		Context("when a phrase has a field we don't know about", func() {
+			BeforeEach(func() {
+				requestBody = strings.NewReader(`[{"content": "the-phrase", "transaltion": "oops"}]`)
+			})
+
+			It("rejects it rather than silently dropping it", func() {
+				Expect(resultErr).To(Equal(RequestBodyError{
+					Status:  http.StatusUnprocessableEntity,
+					Message: "unknown field 'transaltion'",
+				}))
+			})
+		})
+
+		Context("when a phrase further down the list has a field we don't know about", func() {
+			BeforeEach(func() {
+				requestBody = strings.NewReader(`[{"content": "the-phrase"}, {"content": "old-phrase", "uuid": "256499fb-770c-4805-bd0e-16e4f37a561c", "tags": ["oops"]}]`)
+			})
+
+			It("names the field, whatever encoding/json would have said about it", func() {
+				Expect(resultErr).To(Equal(RequestBodyError{
+					Status:  http.StatusUnprocessableEntity,
+					Message: "unknown field 'tags'",
+				}))
+			})
+		})
+
+		Context("when a field's name is in a different case", func() {
+			BeforeEach(func() {
+				requestBody = strings.NewReader(`[{"Content": "the-phrase", "TRANSLATION": "the-translation"}]`)
+			})
+
+			It("accepts it, as encoding/json does", func() {
+				Expect(resultErr).NotTo(HaveOccurred())
+				Expect(result[0].Phrase).To(Equal("the-phrase"))
+				Expect(result[0].Translation).To(Equal("the-translation"))
+			})
+		})
+
+		Context("when a phrase has an invalid uuid", func() {
+			BeforeEach(func() {
+				requestBody = strings.NewReader(`[{"content": "the-phrase"}, {"content": "old-phrase", "uuid": "not-a-uuid"}]`)
+			})
+
+			It("returns an error", func() {
+				Expect(resultErr).To(Equal(RequestBodyError{
+					Status:  http.StatusUnprocessableEntity,
+					Message: "phrases[1].uuid is not a valid uuid",
+				}))
+			})
+		})
+
+		Context("when there are too many phrases in one sync", func() {
+			BeforeEach(func() {
+				phrases := strings.Repeat(`{"content": "the-phrase"},`, MaxPhrasesPerSync+1)
+				requestBody = strings.NewReader("[" + strings.TrimSuffix(phrases, ",") + "]")
+			})
+
+			It("asks for smaller batches", func() {
+				Expect(resultErr).To(Equal(RequestBodyError{
+					Status:  http.StatusUnprocessableEntity,
+					Message: "a sync can contain at most 500 phrases, split it into smaller batches",
+				}))
+			})
+		})
+
+		Context("when the body is too large", func() {
+			BeforeEach(func() {
+				requestBody = strings.NewReader(`[{"content": "` + strings.Repeat("a", MaxRequestBodyBytes) + `"}]`)
+			})
+
+			It("gives up reading it", func() {
+				Expect(resultErr).To(Equal(RequestBodyError{
+					Status:  http.StatusRequestEntityTooLarge,
+					Message: "request body must be at most 1048576 bytes",
+				}))
+			})
+		})
+
+		Context("when there is something after the list of phrases", func() {
+			BeforeEach(func() {
+				requestBody = strings.NewReader(`[{"content": "the-phrase"}] [{"content": "another"}]`)
+			})
+
+			It("returns an error", func() {
+				Expect(resultErr).To(Equal(RequestBodyError{
+					Status:  http.StatusBadRequest,
+					Message: "request body must contain a single JSON value",
+				}))
+			})
+		})
 	})
 
 	Describe("when the user auth header is not a uuid", func() {
@@ -0,0 +1,54 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewAdminAccountHandler disables a user's account (PUT, with a reason)
+// or enables it again (DELETE). Disabled users get a 403 on every request
+// once the AccountCheck notices, which takes up to AccountCheckInterval
+func NewAdminAccountHandler(
+	useCase usecases.ChangeAccountUseCase,
+	paramReader AdminAccountParamReader,
+) http.Handler {
+	return adminAccountHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type adminAccountHandler struct {
+	useCase     usecases.ChangeAccountUseCase
+	paramReader AdminAccountParamReader
+}
+
+func (handler adminAccountHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "AdminAccountParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	err = handler.useCase.Execute(request.Context(), usecases.ChangeAccountRequest{
+		UserUUID: params.UserUUID,
+		Disabled: params.Disabled,
+		Reason:   params.Reason,
+	})
+	switch err {
+	case nil:
+	case usecases.ErrOutranked:
+		writeError(writer, err, http.StatusForbidden)
+		return
+	default:
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,144 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("AdminAccountHandler", func() {
+	var (
+		paramReader *httpserverfakes.FakeAdminAccountParamReader
+		useCase     *usecasesfakes.FakeChangeAccountUseCase
+		writer      *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		paramReader = new(httpserverfakes.FakeAdminAccountParamReader)
+		paramReader.ReadParamsFromRequestReturns(AdminAccountParams{
+			UserUUID: userUUID,
+			Disabled: true,
+			Reason:   "spam",
+		}, nil)
+		useCase = new(usecasesfakes.FakeChangeAccountUseCase)
+		writer = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("PUT", "/api/admin/users/"+userUUID.String()+"/disabled", nil)
+		Expect(err).NotTo(HaveOccurred())
+		NewAdminAccountHandler(useCase, paramReader).ServeHTTP(writer, request)
+	})
+
+	It("changes the account, saying why", func() {
+		Expect(writer.Code).To(Equal(http.StatusNoContent))
+
+		_, request := useCase.ExecuteArgsForCall(0)
+		Expect(request).To(Equal(usecases.ChangeAccountRequest{
+			UserUUID: userUUID,
+			Disabled: true,
+			Reason:   "spam",
+		}))
+	})
+
+	Context("when a moderator goes after an admin", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.ErrOutranked)
+		})
+
+		It("is forbidden", func() {
+			Expect(writer.Code).To(Equal(http.StatusForbidden))
+			Expect(writer.Body.String()).To(Equal(`{"error":"only an admin can change an admin's account"}`))
+		})
+	})
+
+	Context("when the body can't be read", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns(AdminAccountParams{}, errors.New("request body is not valid JSON"))
+		})
+
+		It("is a bad request", func() {
+			Expect(writer.Code).To(Equal(http.StatusBadRequest))
+			Expect(useCase.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the database is down", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(errors.New("too many connections"))
+		})
+
+		It("is an internal error", func() {
+			Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
+
+var _ = Describe("AdminRoleHandler", func() {
+	var (
+		paramReader *httpserverfakes.FakeAdminRoleParamReader
+		useCase     *usecasesfakes.FakeChangeRoleUseCase
+		writer      *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		paramReader = new(httpserverfakes.FakeAdminRoleParamReader)
+		paramReader.ReadParamsFromRequestReturns(AdminRoleParams{UserUUID: userUUID, Role: "moderator"}, nil)
+		useCase = new(usecasesfakes.FakeChangeRoleUseCase)
+		useCase.ExecuteReturns(usecases.StaffKeyResponse{
+			Role:     api.ROLE_MODERATOR,
+			StaffKey: userUUID.String() + ".the-secret",
+		}, nil)
+		writer = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("PUT", "/api/admin/users/"+userUUID.String()+"/role", nil)
+		Expect(err).NotTo(HaveOccurred())
+		NewAdminRoleHandler(useCase, paramReader).ServeHTTP(writer, request)
+	})
+
+	It("gives the user the role, and responds with their new staff key", func() {
+		_, request := useCase.ExecuteArgsForCall(0)
+		Expect(request).To(Equal(usecases.ChangeRoleRequest{UserUUID: userUUID, Role: "moderator"}))
+
+		Expect(writer.Code).To(Equal(http.StatusOK))
+		Expect(writer.Header().Get("Cache-Control")).To(Equal("no-store"))
+		Expect(writer.Body.String()).To(MatchJSON(`{
+			"role": "moderator",
+			"staffKey": "e2580a5b-cabb-4387-bcea-30e9401a2aa4.the-secret"
+		}`))
+	})
+
+	Context("when there is no such role", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.StaffKeyResponse{}, usecases.ValidationError{
+				Errors: []usecases.FieldError{{Field: "role", Message: "must be one of user, moderator or admin"}},
+			})
+		})
+
+		It("is unprocessable", func() {
+			Expect(writer.Code).To(Equal(http.StatusUnprocessableEntity))
+			Expect(writer.Body.String()).To(ContainSubstring("must be one of user, moderator or admin"))
+		})
+	})
+
+	Context("when the database is down", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.StaffKeyResponse{}, errors.New("too many connections"))
+		})
+
+		It("is an internal error", func() {
+			Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+//go:generate counterfeiter . AdminAccountParamReader
+type AdminAccountParamReader interface {
+	ReadParamsFromRequest(*http.Request) (AdminAccountParams, error)
+}
+
+// AdminAccountParams.Reason is only read from the body of a PUT,
+// which disables the account. A DELETE enables it again
+type AdminAccountParams struct {
+	UserUUID uuid.UUID
+	Disabled bool
+	Reason   string
+}
+
+func NewAdminAccountParamReader() AdminAccountParamReader {
+	return adminAccountParamReader{}
+}
+
+type adminAccountParamReader struct{}
+
+func (paramReader adminAccountParamReader) ReadParamsFromRequest(request *http.Request) (AdminAccountParams, error) {
+	userUuid, err := pathUUID(request, "uuid")
+	if err != nil {
+		return AdminAccountParams{}, err
+	}
+
+	params := AdminAccountParams{UserUUID: userUuid}
+	if request.Method == http.MethodPut {
+		requestObj := struct {
+			Reason string `json:"reason"`
+		}{}
+		err = decodeJSONBody(request, &requestObj)
+		if err != nil {
+			return AdminAccountParams{}, err
+		}
+		params.Disabled = true
+		params.Reason = requestObj.Reason
+	}
+
+	return params, nil
+}
@@ -0,0 +1,146 @@
+package httpserver
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewAdminAnalyticsHandler serves /api/admin/analytics/{series}, where the
+// series is new-phrases, active-users, practice-sessions or retention.
+// ?from= and ?to= are dates (the last 30 days by default), ?granularity=
+// is day, week or month, and ?format=csv downloads it as a spreadsheet
+func NewAdminAnalyticsHandler(
+	useCase usecases.ShowAnalyticsUseCase,
+	paramReader AdminAnalyticsParamReader,
+) http.Handler {
+	return adminAnalyticsHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type adminAnalyticsHandler struct {
+	useCase     usecases.ShowAnalyticsUseCase
+	paramReader AdminAnalyticsParamReader
+}
+
+func (handler adminAnalyticsHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "AdminAnalyticsParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	analytics, err := handler.useCase.Execute(request.Context(), usecases.ShowAnalyticsRequest{
+		Series:      params.Series,
+		From:        params.From,
+		To:          params.To,
+		Granularity: params.Granularity,
+	})
+	if _, ok := err.(usecases.UnknownSeriesError); ok {
+		writeError(writer, err, http.StatusNotFound)
+		return
+	}
+	switch err {
+	case nil:
+	case usecases.ErrRetentionByWeek:
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	default:
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	var records [][]string
+	var result interface{}
+	if params.Series == usecases.RETENTION_SERIES {
+		records = retentionRecords(analytics.Cohorts)
+		result = analytics.Cohorts
+	} else {
+		records = seriesRecords(analytics.Points)
+		result = analytics.Points
+	}
+
+	if !wantsCSV(request) {
+		writeJSON(writer, result)
+		return
+	}
+
+	// written out before the headers are sent, so a failure can still be a 500
+	var spreadsheet bytes.Buffer
+	if err := csv.NewWriter(&spreadsheet).WriteAll(records); err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	writer.Header().Set("Content-Disposition", fmt.Sprintf(
+		`attachment; filename="%s-%s-%s.csv"`,
+		params.Series,
+		params.From.Format("2006-01-02"),
+		params.To.Format("2006-01-02"),
+	))
+	writer.Write(spreadsheet.Bytes())
+}
+
+func wantsCSV(request *http.Request) bool {
+	switch request.URL.Query().Get("format") {
+	case "csv":
+		return true
+	case "json":
+		return false
+	}
+
+	return strings.Contains(request.Header.Get("Accept"), "text/csv")
+}
+
+func seriesRecords(points []api.SeriesPoint) [][]string {
+	records := [][]string{{"period", "count"}}
+	for _, point := range points {
+		records = append(records, []string{point.Period, strconv.FormatUint(uint64(point.Count), 10)})
+	}
+
+	return records
+}
+
+// retentionRecords has a column per week since signing up. Later cohorts
+// haven't had as many weeks yet, so their rows end early
+func retentionRecords(cohorts []api.RetentionCohort) [][]string {
+	weeks := 0
+	for _, cohort := range cohorts {
+		if len(cohort.Active) > weeks {
+			weeks = len(cohort.Active)
+		}
+	}
+
+	header := []string{"week", "size"}
+	for i := 0; i < weeks; i++ {
+		header = append(header, fmt.Sprintf("week %d", i))
+	}
+
+	records := [][]string{header}
+	for _, cohort := range cohorts {
+		record := []string{cohort.Week, strconv.FormatUint(uint64(cohort.Size), 10)}
+		for i := 0; i < weeks; i++ {
+			if i < len(cohort.Active) {
+				record = append(record, strconv.FormatUint(uint64(cohort.Active[i]), 10))
+			} else {
+				record = append(record, "")
+			}
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
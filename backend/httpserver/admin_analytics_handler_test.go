@@ -0,0 +1,172 @@
+package httpserver_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("AdminAnalyticsHandler", func() {
+	var (
+		useCase     *usecasesfakes.FakeShowAnalyticsUseCase
+		paramReader *httpserverfakes.FakeAdminAnalyticsParamReader
+		writer      *httptest.ResponseRecorder
+		url         string
+		accept      string
+	)
+
+	from := time.Date(2018, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2018, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		useCase = new(usecasesfakes.FakeShowAnalyticsUseCase)
+		useCase.ExecuteReturns(usecases.AnalyticsResponse{Points: []api.SeriesPoint{
+			{Period: "2018-03-30", Count: 12},
+			{Period: "2018-03-31", Count: 0},
+		}}, nil)
+		paramReader = new(httpserverfakes.FakeAdminAnalyticsParamReader)
+		paramReader.ReadParamsFromRequestReturns(AdminAnalyticsParams{
+			Series:      "new-phrases",
+			From:        from,
+			To:          to,
+			Granularity: api.GRANULARITY_WEEK,
+		}, nil)
+		writer = httptest.NewRecorder()
+		url = "/api/admin/analytics/new-phrases"
+		accept = ""
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("GET", url, nil)
+		Expect(err).NotTo(HaveOccurred())
+		if accept != "" {
+			request.Header.Set("Accept", accept)
+		}
+
+		NewAdminAnalyticsHandler(useCase, paramReader).ServeHTTP(writer, request)
+	})
+
+	It("sends the series as JSON", func() {
+		Expect(writer.Code).To(Equal(http.StatusOK))
+		Expect(writer.Body.String()).To(MatchJSON(`[
+			{"period": "2018-03-30", "count": 12},
+			{"period": "2018-03-31", "count": 0}
+		]`))
+
+		_, request := useCase.ExecuteArgsForCall(0)
+		Expect(request).To(Equal(usecases.ShowAnalyticsRequest{
+			Series:      "new-phrases",
+			From:        from,
+			To:          to,
+			Granularity: api.GRANULARITY_WEEK,
+		}))
+	})
+
+	Context("when asked for CSV", func() {
+		BeforeEach(func() {
+			url += "?format=csv"
+		})
+
+		It("sends a spreadsheet", func() {
+			Expect(writer.Header().Get("Content-Type")).To(Equal("text/csv; charset=utf-8"))
+			Expect(writer.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="new-phrases-2018-03-02-2018-03-31.csv"`))
+			Expect(writer.Body.String()).To(Equal("period,count\n2018-03-30,12\n2018-03-31,0\n"))
+		})
+	})
+
+	Describe("retention", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns(AdminAnalyticsParams{
+				Series: usecases.RETENTION_SERIES,
+				From:   from,
+				To:     to,
+			}, nil)
+			useCase.ExecuteReturns(usecases.AnalyticsResponse{Cohorts: []api.RetentionCohort{
+				{Week: "2018-03-19", Size: 10, Active: []uint{10, 4}},
+				{Week: "2018-03-26", Size: 5, Active: []uint{5}},
+			}}, nil)
+		})
+
+		It("follows each weekly cohort", func() {
+			Expect(writer.Body.String()).To(MatchJSON(`[
+				{"week": "2018-03-19", "size": 10, "active": [10, 4]},
+				{"week": "2018-03-26", "size": 5, "active": [5]}
+			]`))
+		})
+
+		Context("when the client accepts CSV", func() {
+			BeforeEach(func() {
+				accept = "text/csv"
+			})
+
+			It("leaves the weeks that haven't happened yet empty", func() {
+				Expect(writer.Body.String()).To(Equal("week,size,week 0,week 1\n2018-03-19,10,10,4\n2018-03-26,5,5,\n"))
+			})
+		})
+	})
+
+	Context("when the params are bad", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns(AdminAnalyticsParams{}, errors.New("from must not be after to"))
+		})
+
+		It("is a bad request", func() {
+			Expect(writer.Code).To(Equal(http.StatusBadRequest))
+			Expect(writer.Body.String()).To(MatchJSON(`{"error": "from must not be after to"}`))
+			Expect(useCase.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when asked for retention by month", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.AnalyticsResponse{}, usecases.ErrRetentionByWeek)
+		})
+
+		It("is a bad request", func() {
+			Expect(writer.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Context("for a series we don't have", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.AnalyticsResponse{}, usecases.UnknownSeriesError{Series: "revenue"})
+		})
+
+		It("is not found", func() {
+			Expect(writer.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Context("for a series with quotes in its name", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.AnalyticsResponse{}, usecases.UnknownSeriesError{Series: `a"b`})
+		})
+
+		It("still answers with valid JSON", func() {
+			var body map[string]string
+			Expect(json.Unmarshal(writer.Body.Bytes(), &body)).To(Succeed())
+			Expect(body["error"]).To(Equal(`there is no 'a"b' series`))
+		})
+	})
+
+	Context("when the database fails", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.AnalyticsResponse{}, errors.New("database is on fire"))
+		})
+
+		It("is an internal server error", func() {
+			Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+const DefaultAnalyticsDays = 30
+const MaxAnalyticsDays = 731
+
+//go:generate counterfeiter . AdminAnalyticsParamReader
+type AdminAnalyticsParamReader interface {
+	ReadParamsFromRequest(*http.Request) (AdminAnalyticsParams, error)
+}
+
+// AdminAnalyticsParams leaves the Granularity empty when none was asked for
+type AdminAnalyticsParams struct {
+	Series      string
+	From        time.Time
+	To          time.Time
+	Granularity api.Granularity
+}
+
+// NewAdminAnalyticsParamReader reads the series from the path, and the
+// dates from ?from= and ?to=, which cover the last 30 days by default
+func NewAdminAnalyticsParamReader(clock func() time.Time) AdminAnalyticsParamReader {
+	return adminAnalyticsParamReader{clock: clock}
+}
+
+type adminAnalyticsParamReader struct {
+	clock func() time.Time
+}
+
+func (paramReader adminAnalyticsParamReader) ReadParamsFromRequest(request *http.Request) (AdminAnalyticsParams, error) {
+	values := request.URL.Query()
+	today := paramReader.clock().UTC().Truncate(24 * time.Hour)
+	params := AdminAnalyticsParams{
+		Series: mux.Vars(request)["series"],
+		From:   today.AddDate(0, 0, 1-DefaultAnalyticsDays),
+		To:     today,
+	}
+
+	var err error
+	if value := values.Get("to"); value != "" {
+		params.To, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			return AdminAnalyticsParams{}, errors.New("to must be a date like 2006-01-02")
+		}
+		params.From = params.To.AddDate(0, 0, 1-DefaultAnalyticsDays)
+	}
+	if value := values.Get("from"); value != "" {
+		params.From, err = time.Parse("2006-01-02", value)
+		if err != nil {
+			return AdminAnalyticsParams{}, errors.New("from must be a date like 2006-01-02")
+		}
+	}
+	if params.To.Before(params.From) {
+		return AdminAnalyticsParams{}, errors.New("from must not be after to")
+	}
+	if params.To.Sub(params.From) >= MaxAnalyticsDays*24*time.Hour {
+		return AdminAnalyticsParams{}, fmt.Errorf("from and to must be at most %d days apart", MaxAnalyticsDays)
+	}
+
+	if value := values.Get("granularity"); value != "" {
+		params.Granularity, err = api.ParseGranularity(value)
+		if err != nil {
+			return AdminAnalyticsParams{}, err
+		}
+	}
+
+	return params, nil
+}
@@ -0,0 +1,93 @@
+package httpserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("AdminAnalyticsParamReader", func() {
+	var (
+		result    AdminAnalyticsParams
+		resultErr error
+		path      string
+	)
+
+	now := time.Date(2018, 3, 31, 18, 30, 0, 0, time.UTC)
+	day := func(value string) time.Time {
+		parsed, err := time.Parse("2006-01-02", value)
+		Expect(err).NotTo(HaveOccurred())
+		return parsed
+	}
+
+	BeforeEach(func() {
+		path = "/api/admin/analytics/new-phrases"
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("GET", path, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		router := mux.NewRouter()
+		router.HandleFunc("/api/admin/analytics/{series}", func(w http.ResponseWriter, r *http.Request) {
+			result, resultErr = NewAdminAnalyticsParamReader(func() time.Time { return now }).ReadParamsFromRequest(r)
+		})
+		router.ServeHTTP(httptest.NewRecorder(), request)
+	})
+
+	It("covers the last 30 days, leaving the granularity to the use case", func() {
+		Expect(resultErr).NotTo(HaveOccurred())
+		Expect(result).To(Equal(AdminAnalyticsParams{
+			Series: "new-phrases",
+			From:   day("2018-03-02"),
+			To:     day("2018-03-31"),
+		}))
+	})
+
+	Context("with a date range and granularity", func() {
+		BeforeEach(func() {
+			path = "/api/admin/analytics/active-users?from=2018-01-01&to=2018-03-31&granularity=week"
+		})
+
+		It("reads them", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result).To(Equal(AdminAnalyticsParams{
+				Series:      "active-users",
+				From:        day("2018-01-01"),
+				To:          day("2018-03-31"),
+				Granularity: api.GRANULARITY_WEEK,
+			}))
+		})
+	})
+
+	Context("with only an end date", func() {
+		BeforeEach(func() {
+			path += "?to=2018-02-28"
+		})
+
+		It("covers the 30 days before it", func() {
+			Expect(result.From).To(Equal(day("2018-01-30")))
+			Expect(result.To).To(Equal(day("2018-02-28")))
+		})
+	})
+
+	for _, bad := range []string{"from=yesterday", "to=2018-02-30", "from=2018-04-01&to=2018-03-01", "from=2015-01-01", "granularity=hour"} {
+		bad := bad
+		Context("when given "+bad, func() {
+			BeforeEach(func() {
+				path += "?" + bad
+			})
+
+			It("returns an error", func() {
+				Expect(resultErr).To(HaveOccurred())
+			})
+		})
+	}
+})
@@ -0,0 +1,101 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+// NewAdminAuditHandler searches the audit log, newest first. It can be
+// filtered by ?user=, ?phrase=, ?actor=, ?action=, ?since= and ?until=
+// (RFC3339 times), and paged through by passing the last id seen as ?before=
+func NewAdminAuditHandler(repository api.AuditRepository) http.Handler {
+	return adminAuditHandler{repository: repository}
+}
+
+type adminAuditHandler struct {
+	repository api.AuditRepository
+}
+
+func (handler adminAuditHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	query, err := readAuditQuery(request)
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	entries, err := handler.repository.Entries(request.Context(), query)
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, entries)
+}
+
+func readAuditQuery(request *http.Request) (api.AuditQuery, error) {
+	values := request.URL.Query()
+	query := api.AuditQuery{
+		Action: api.AuditAction(values.Get("action")),
+		Limit:  DefaultAdminPageSize,
+	}
+
+	for _, filter := range []struct {
+		name  string
+		value *string
+	}{
+		{"user", &query.UserUUID},
+		{"phrase", &query.PhraseUUID},
+		{"actor", &query.ActorUUID},
+	} {
+		value := values.Get(filter.name)
+		if value == "" {
+			continue
+		}
+		parsed, err := uuid.Parse(value)
+		if err != nil {
+			return query, fmt.Errorf("%s must be a uuid", filter.name)
+		}
+		*filter.value = parsed.String()
+	}
+
+	for _, filter := range []struct {
+		name  string
+		value **time.Time
+	}{
+		{"since", &query.Since},
+		{"until", &query.Until},
+	} {
+		value := values.Get(filter.name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return query, fmt.Errorf("%s must be a time like 2006-01-02T15:04:05Z", filter.name)
+		}
+		parsed = parsed.UTC()
+		*filter.value = &parsed
+	}
+
+	var err error
+	if before := values.Get("before"); before != "" {
+		query.Before, err = strconv.ParseUint(before, 10, 64)
+		if err != nil || query.Before == 0 {
+			return query, errors.New("before must be the id of an audit log entry")
+		}
+	}
+	if limit := values.Get("limit"); limit != "" {
+		query.Limit, err = strconv.Atoi(limit)
+		if err != nil || query.Limit < 1 || query.Limit > MaxAdminPageSize {
+			return query, fmt.Errorf("limit must be between 1 and %d", MaxAdminPageSize)
+		}
+	}
+
+	return query, nil
+}
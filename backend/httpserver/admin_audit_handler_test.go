@@ -0,0 +1,113 @@
+package httpserver_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("AdminAuditHandler", func() {
+	var (
+		repository *apifakes.FakeAuditRepository
+		writer     *httptest.ResponseRecorder
+		path       string
+	)
+
+	BeforeEach(func() {
+		repository = new(apifakes.FakeAuditRepository)
+		repository.EntriesReturns([]api.AuditEntry{{
+			ID:         42,
+			CreatedAt:  time.Date(2018, 3, 31, 18, 30, 0, 0, time.UTC),
+			ActorUUID:  userUUID.String(),
+			ActorRole:  api.ROLE_USER,
+			Action:     api.AUDIT_PHRASE_UPDATE,
+			UserUUID:   userUUID.String(),
+			PhraseUUID: "a7f3a3b8-5f6a-4ab1-8c4b-7cd1c5c1e4a1",
+			Before:     json.RawMessage(`{"type":"FRENCH_TO_ENGLISH","content":"bonjour","translation":""}`),
+			After:      json.RawMessage(`{"type":"FRENCH_TO_ENGLISH","content":"bonjour","translation":"hello"}`),
+			RequestID:  "abc123",
+		}}, nil)
+		writer = httptest.NewRecorder()
+		path = "/api/admin/audit"
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("GET", path, nil)
+		Expect(err).NotTo(HaveOccurred())
+		NewAdminAuditHandler(repository).ServeHTTP(writer, request)
+	})
+
+	It("lists the most recent entries", func() {
+		Expect(writer.Code).To(Equal(http.StatusOK))
+		Expect(writer.Body.String()).To(MatchJSON(`[{
+			"id": 42,
+			"createdAt": "2018-03-31T18:30:00Z",
+			"actorUuid": "e2580a5b-cabb-4387-bcea-30e9401a2aa4",
+			"actorRole": "user",
+			"action": "phrase.update",
+			"userUuid": "e2580a5b-cabb-4387-bcea-30e9401a2aa4",
+			"phraseUuid": "a7f3a3b8-5f6a-4ab1-8c4b-7cd1c5c1e4a1",
+			"before": {"type": "FRENCH_TO_ENGLISH", "content": "bonjour", "translation": ""},
+			"after": {"type": "FRENCH_TO_ENGLISH", "content": "bonjour", "translation": "hello"},
+			"requestId": "abc123"
+		}]`))
+
+		_, query := repository.EntriesArgsForCall(0)
+		Expect(query).To(Equal(api.AuditQuery{Limit: DefaultAdminPageSize}))
+	})
+
+	Context("with filters", func() {
+		BeforeEach(func() {
+			path = "/api/admin/audit?user=" + userUUID.String() +
+				"&action=user.disable&since=2018-03-01T00:00:00Z&until=2018-04-01T02:00:00%2B02:00&before=42&limit=10"
+		})
+
+		It("passes them on", func() {
+			since := time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)
+			until := time.Date(2018, 4, 1, 0, 0, 0, 0, time.UTC)
+
+			_, query := repository.EntriesArgsForCall(0)
+			Expect(query).To(Equal(api.AuditQuery{
+				UserUUID: userUUID.String(),
+				Action:   api.AUDIT_USER_DISABLE,
+				Since:    &since,
+				Until:    &until,
+				Before:   42,
+				Limit:    10,
+			}))
+		})
+	})
+
+	for _, bad := range []string{"user=nope", "phrase=nope", "actor=nope", "since=yesterday", "before=-1", "limit=501"} {
+		bad := bad
+		Context("when given "+bad, func() {
+			BeforeEach(func() {
+				path = "/api/admin/audit?" + bad
+			})
+
+			It("is a bad request", func() {
+				Expect(writer.Code).To(Equal(http.StatusBadRequest))
+				Expect(repository.EntriesCallCount()).To(Equal(0))
+			})
+		})
+	}
+
+	Context("when the audit log can't be read", func() {
+		BeforeEach(func() {
+			repository.EntriesReturns(nil, errors.New("database is on fire"))
+		})
+
+		It("is an internal server error", func() {
+			Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -25,7 +25,7 @@ var _ = Describe("AdminHandler", func() {
 	BeforeEach(func() {
 		writer = httptest.NewRecorder()
 		adminRepository = new(apifakes.FakeAdminRepository)
-		subject = NewAdminHandler(adminRepository, "really-thoughtful-password")
+		subject = NewAdminHandler(adminRepository)
 	})
 
 	BeforeEach(func() {
@@ -42,8 +42,6 @@ var _ = Describe("AdminHandler", func() {
 		BeforeEach(func() {
 			phrases := []api.PhraseCount{{"the-uuid", 666}}
 			adminRepository.PhraseCountByUserUUIDReturns(phrases, nil)
-
-			request.Header.Add("X-Password", "really-thoughtful-password")
 		})
 
 		It("returns JSON describing the resource created", func() {
@@ -52,28 +50,13 @@ var _ = Describe("AdminHandler", func() {
 		})
 	})
 
-	Describe("when the user fails to provide the correct password", func() {
-		BeforeEach(func() {
-			phrases := []api.PhraseCount{{"the-uuid", 666}}
-			adminRepository.PhraseCountByUserUUIDReturns(phrases, nil)
-
-			request.Header.Add("X-Password", "1337H4X0RZ")
-		})
-
-		It("returns JSON describing the resource created", func() {
-			expectedBody := `{"error": "ah ah ah, you didn't say the magic word"}`
-			Expect(writer.Body.String()).To(Equal(expectedBody))
-		})
-	})
-
 	Describe("when the repository returns an error", func() {
 		BeforeEach(func() {
 			adminRepository.PhraseCountByUserUUIDReturns([]api.PhraseCount{}, errors.New("something done goofed"))
-			request.Header.Add("X-Password", "really-thoughtful-password")
 		})
 
 		It("returns JSON describing the resource created", func() {
-			expectedBody := `{"error": "something done goofed"}`
+			expectedBody := `{"error":"something done goofed"}`
 			Expect(writer.Body.String()).To(Equal(expectedBody))
 		})
 	})
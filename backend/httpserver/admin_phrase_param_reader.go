@@ -0,0 +1,57 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+//go:generate counterfeiter . AdminPhraseParamReader
+type AdminPhraseParamReader interface {
+	ReadParamsFromRequest(*http.Request) (AdminPhraseParams, error)
+}
+
+// AdminPhraseParams.Content and Translation are only read from the
+// body of a PATCH, and are nil when they are left out of it
+type AdminPhraseParams struct {
+	UserUUID    uuid.UUID
+	PhraseUUID  uuid.UUID
+	Content     *string
+	Translation *string
+}
+
+func NewAdminPhraseParamReader() AdminPhraseParamReader {
+	return adminPhraseParamReader{}
+}
+
+type adminPhraseParamReader struct{}
+
+func (paramReader adminPhraseParamReader) ReadParamsFromRequest(request *http.Request) (AdminPhraseParams, error) {
+	userUuid, err := pathUUID(request, "uuid")
+	if err != nil {
+		return AdminPhraseParams{}, err
+	}
+	phraseUuid, err := pathUUID(request, "phraseUuid")
+	if err != nil {
+		return AdminPhraseParams{}, err
+	}
+
+	params := AdminPhraseParams{
+		UserUUID:   userUuid,
+		PhraseUUID: phraseUuid,
+	}
+	if request.Method == http.MethodPatch {
+		requestObj := struct {
+			Content     *string `json:"content"`
+			Translation *string `json:"translation"`
+		}{}
+		err = decodeJSONBody(request, &requestObj)
+		if err != nil {
+			return AdminPhraseParams{}, err
+		}
+		params.Content = requestObj.Content
+		params.Translation = requestObj.Translation
+	}
+
+	return params, nil
+}
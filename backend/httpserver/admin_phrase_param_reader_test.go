@@ -0,0 +1,121 @@
+package httpserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("AdminPhraseParamReader", func() {
+	var (
+		result    AdminPhraseParams
+		resultErr error
+		method    string
+		phraseVar string
+		body      string
+	)
+
+	phraseUUID := uuid.Must(uuid.Parse("2dff2424-c888-4785-a91d-6fcb006dabe5"))
+
+	BeforeEach(func() {
+		method = "PATCH"
+		phraseVar = phraseUUID.String()
+		body = `{"translation": "hello there"}`
+	})
+
+	JustBeforeEach(func() {
+		request := httptest.NewRequest(method, "/api/admin/users/"+userUUID.String()+"/phrases/"+phraseVar, strings.NewReader(body))
+
+		// mux only fills in the path variables for routes it matched
+		router := mux.NewRouter()
+		router.HandleFunc("/api/admin/users/{uuid}/phrases/{phraseUuid}", func(w http.ResponseWriter, r *http.Request) {
+			result, resultErr = NewAdminPhraseParamReader().ReadParamsFromRequest(r)
+		})
+		router.ServeHTTP(httptest.NewRecorder(), request)
+	})
+
+	It("reads the user and phrase from the path, and only what was sent from the body", func() {
+		Expect(resultErr).NotTo(HaveOccurred())
+		Expect(result.UserUUID).To(Equal(userUUID))
+		Expect(result.PhraseUUID).To(Equal(phraseUUID))
+		Expect(result.Content).To(BeNil())
+		Expect(*result.Translation).To(Equal("hello there"))
+	})
+
+	Context("when the body has a field it shouldn't", func() {
+		BeforeEach(func() {
+			body = `{"type": "ENGLISH_TO_FRENCH"}`
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when deleting", func() {
+		BeforeEach(func() {
+			method = "DELETE"
+			body = ""
+		})
+
+		It("doesn't need a body", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result).To(Equal(AdminPhraseParams{UserUUID: userUUID, PhraseUUID: phraseUUID}))
+		})
+	})
+
+	Context("when the phrase uuid is malformed", func() {
+		BeforeEach(func() {
+			phraseVar = "nope"
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(MatchError("phraseUuid is not a valid uuid"))
+		})
+	})
+})
+
+var _ = Describe("AdminAccountParamReader", func() {
+	var (
+		result    AdminAccountParams
+		resultErr error
+		method    string
+	)
+
+	BeforeEach(func() {
+		method = "PUT"
+	})
+
+	JustBeforeEach(func() {
+		request := httptest.NewRequest(method, "/api/admin/users/"+userUUID.String()+"/disabled", strings.NewReader(`{"reason": "spam"}`))
+
+		router := mux.NewRouter()
+		router.HandleFunc("/api/admin/users/{uuid}/disabled", func(w http.ResponseWriter, r *http.Request) {
+			result, resultErr = NewAdminAccountParamReader().ReadParamsFromRequest(r)
+		})
+		router.ServeHTTP(httptest.NewRecorder(), request)
+	})
+
+	It("disables the account, saying why", func() {
+		Expect(resultErr).NotTo(HaveOccurred())
+		Expect(result).To(Equal(AdminAccountParams{UserUUID: userUUID, Disabled: true, Reason: "spam"}))
+	})
+
+	Context("when enabling the account again", func() {
+		BeforeEach(func() {
+			method = "DELETE"
+		})
+
+		It("ignores the body", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result).To(Equal(AdminAccountParams{UserUUID: userUUID}))
+		})
+	})
+})
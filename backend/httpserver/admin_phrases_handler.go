@@ -0,0 +1,117 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewAdminPhraseHandler lets moderators fix (PATCH) or remove (DELETE)
+// one of a user's phrases, whichever direction it is in
+func NewAdminPhraseHandler(
+	editUseCase usecases.EditUserPhraseUseCase,
+	deleteUseCase usecases.DeleteUserPhraseUseCase,
+	paramReader AdminPhraseParamReader,
+) http.Handler {
+	return adminPhraseHandler{
+		editUseCase:   editUseCase,
+		deleteUseCase: deleteUseCase,
+		paramReader:   paramReader,
+	}
+}
+
+type adminPhraseHandler struct {
+	editUseCase   usecases.EditUserPhraseUseCase
+	deleteUseCase usecases.DeleteUserPhraseUseCase
+	paramReader   AdminPhraseParamReader
+}
+
+func (handler adminPhraseHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "AdminPhraseParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	if request.Method == http.MethodDelete {
+		err = handler.deleteUseCase.Execute(request.Context(), usecases.DeleteUserPhraseRequest{
+			PhraseUUID: params.PhraseUUID,
+			UserUUID:   params.UserUUID,
+		})
+		if err != nil {
+			writeAdminPhraseError(writer, err)
+			return
+		}
+
+		writer.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	phrase, err := handler.editUseCase.Execute(request.Context(), usecases.EditUserPhraseRequest{
+		Content:     params.Content,
+		Translation: params.Translation,
+		PhraseUUID:  params.PhraseUUID,
+		UserUUID:    params.UserUUID,
+	})
+	if err != nil {
+		writeAdminPhraseError(writer, err)
+		return
+	}
+
+	writeJSON(writer, phrase)
+}
+
+func writeAdminPhraseError(writer http.ResponseWriter, err error) {
+	if validationErr, ok := err.(usecases.ValidationError); ok {
+		writeValidationError(writer, validationErr)
+		return
+	}
+	if err == api.ErrPhraseNotFound {
+		writeError(writer, err, http.StatusNotFound)
+		return
+	}
+
+	writeError(writer, err, http.StatusInternalServerError)
+}
+
+// NewAdminUserPhrasesHandler deletes everything a user has saved
+func NewAdminUserPhrasesHandler(
+	useCase usecases.DeleteUserPhrasesUseCase,
+	paramReader AdminUserParamReader,
+) http.Handler {
+	return adminUserPhrasesHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type adminUserPhrasesHandler struct {
+	useCase     usecases.DeleteUserPhrasesUseCase
+	paramReader AdminUserParamReader
+}
+
+func (handler adminUserPhrasesHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "AdminUserParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	deleted, err := handler.useCase.Execute(request.Context(), usecases.DeleteUserPhrasesRequest{
+		UserUUID: params.UserUUID,
+	})
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, deleted)
+}
@@ -0,0 +1,181 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("AdminPhraseHandler", func() {
+	var (
+		paramReader   *httpserverfakes.FakeAdminPhraseParamReader
+		editUseCase   *usecasesfakes.FakeEditUserPhraseUseCase
+		deleteUseCase *usecasesfakes.FakeDeleteUserPhraseUseCase
+		writer        *httptest.ResponseRecorder
+		method        string
+	)
+
+	phraseUUID := uuid.Must(uuid.Parse("2dff2424-c888-4785-a91d-6fcb006dabe5"))
+	translation := "hello there"
+
+	BeforeEach(func() {
+		paramReader = new(httpserverfakes.FakeAdminPhraseParamReader)
+		paramReader.ReadParamsFromRequestReturns(AdminPhraseParams{
+			UserUUID:    userUUID,
+			PhraseUUID:  phraseUUID,
+			Translation: &translation,
+		}, nil)
+		editUseCase = new(usecasesfakes.FakeEditUserPhraseUseCase)
+		deleteUseCase = new(usecasesfakes.FakeDeleteUserPhraseUseCase)
+		writer = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest(method, "/api/admin/users/"+userUUID.String()+"/phrases/"+phraseUUID.String(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		NewAdminPhraseHandler(editUseCase, deleteUseCase, paramReader).ServeHTTP(writer, request)
+	})
+
+	Describe("editing a phrase", func() {
+		BeforeEach(func() {
+			method = "PATCH"
+			editUseCase.ExecuteReturns(api.AdminPhrase{
+				Uuid:        phraseUUID.String(),
+				Type:        api.FRENCH_TO_ENGLISH,
+				Content:     "salut",
+				Translation: "hello there",
+			}, nil)
+		})
+
+		It("responds with the edited phrase", func() {
+			_, request := editUseCase.ExecuteArgsForCall(0)
+			Expect(request).To(Equal(usecases.EditUserPhraseRequest{
+				Translation: &translation,
+				PhraseUUID:  phraseUUID,
+				UserUUID:    userUUID,
+			}))
+
+			Expect(writer.Code).To(Equal(http.StatusOK))
+			Expect(writer.Body.String()).To(MatchJSON(`{
+				"uuid": "2dff2424-c888-4785-a91d-6fcb006dabe5",
+				"type": "FRENCH_TO_ENGLISH",
+				"content": "salut",
+				"translation": "hello there",
+				"createdAt": "0001-01-01T00:00:00Z",
+				"updatedAt": "0001-01-01T00:00:00Z"
+			}`))
+			Expect(deleteUseCase.ExecuteCallCount()).To(Equal(0))
+		})
+
+		Context("when the content would be emptied out", func() {
+			BeforeEach(func() {
+				editUseCase.ExecuteReturns(api.AdminPhrase{}, usecases.ValidationError{
+					Errors: []usecases.FieldError{{Field: "content", Message: "must not be empty"}},
+				})
+			})
+
+			It("says which field is wrong", func() {
+				Expect(writer.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(writer.Body.String()).To(ContainSubstring("must not be empty"))
+			})
+		})
+
+		Context("when the phrase doesn't belong to the user", func() {
+			BeforeEach(func() {
+				editUseCase.ExecuteReturns(api.AdminPhrase{}, api.ErrPhraseNotFound)
+			})
+
+			It("is not found", func() {
+				Expect(writer.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the body can't be read", func() {
+			BeforeEach(func() {
+				paramReader.ReadParamsFromRequestReturns(AdminPhraseParams{}, errors.New("phraseUuid is not a valid uuid"))
+			})
+
+			It("is a bad request", func() {
+				Expect(writer.Code).To(Equal(http.StatusBadRequest))
+				Expect(editUseCase.ExecuteCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("deleting a phrase", func() {
+		BeforeEach(func() {
+			method = "DELETE"
+		})
+
+		It("deletes it", func() {
+			Expect(writer.Code).To(Equal(http.StatusNoContent))
+
+			_, request := deleteUseCase.ExecuteArgsForCall(0)
+			Expect(request).To(Equal(usecases.DeleteUserPhraseRequest{
+				PhraseUUID: phraseUUID,
+				UserUUID:   userUUID,
+			}))
+			Expect(editUseCase.ExecuteCallCount()).To(Equal(0))
+		})
+
+		Context("when it is already gone", func() {
+			BeforeEach(func() {
+				deleteUseCase.ExecuteReturns(api.ErrPhraseNotFound)
+			})
+
+			It("is not found", func() {
+				Expect(writer.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+})
+
+var _ = Describe("AdminUserPhrasesHandler", func() {
+	var (
+		paramReader *httpserverfakes.FakeAdminUserParamReader
+		useCase     *usecasesfakes.FakeDeleteUserPhrasesUseCase
+		writer      *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		paramReader = new(httpserverfakes.FakeAdminUserParamReader)
+		paramReader.ReadParamsFromRequestReturns(AdminUserParams{UserUUID: userUUID}, nil)
+		useCase = new(usecasesfakes.FakeDeleteUserPhrasesUseCase)
+		useCase.ExecuteReturns(usecases.DeletedPhrasesResponse{Deleted: 12}, nil)
+		writer = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("DELETE", "/api/admin/users/"+userUUID.String()+"/phrases", nil)
+		Expect(err).NotTo(HaveOccurred())
+		NewAdminUserPhrasesHandler(useCase, paramReader).ServeHTTP(writer, request)
+	})
+
+	It("says how many phrases were deleted", func() {
+		_, request := useCase.ExecuteArgsForCall(0)
+		Expect(request).To(Equal(usecases.DeleteUserPhrasesRequest{UserUUID: userUUID}))
+
+		Expect(writer.Code).To(Equal(http.StatusOK))
+		Expect(writer.Body.String()).To(MatchJSON(`{"deleted": 12}`))
+	})
+
+	Context("when the database is down", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.DeletedPhrasesResponse{}, errors.New("too many connections"))
+		})
+
+		It("is an internal error", func() {
+			Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
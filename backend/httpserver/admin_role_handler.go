@@ -0,0 +1,54 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewAdminRoleHandler makes a user a moderator or an admin, or takes it
+// away again. Staff get a new staff key in the response, which has to be
+// passed on to them, as it can't be looked up again later
+func NewAdminRoleHandler(
+	useCase usecases.ChangeRoleUseCase,
+	paramReader AdminRoleParamReader,
+) http.Handler {
+	return adminRoleHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type adminRoleHandler struct {
+	useCase     usecases.ChangeRoleUseCase
+	paramReader AdminRoleParamReader
+}
+
+func (handler adminRoleHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "AdminRoleParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	response, err := handler.useCase.Execute(request.Context(), usecases.ChangeRoleRequest{
+		UserUUID: params.UserUUID,
+		Role:     params.Role,
+	})
+	if validationErr, ok := err.(usecases.ValidationError); ok {
+		writeValidationError(writer, validationErr)
+		return
+	}
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	// keep the key out of any caches along the way
+	writer.Header().Set("Cache-Control", "no-store")
+	writeJSON(writer, response)
+}
@@ -0,0 +1,40 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+//go:generate counterfeiter . AdminRoleParamReader
+type AdminRoleParamReader interface {
+	ReadParamsFromRequest(*http.Request) (AdminRoleParams, error)
+}
+
+// AdminRoleParams.Role is left for the use case to make sense of
+type AdminRoleParams struct {
+	UserUUID uuid.UUID
+	Role     string
+}
+
+func NewAdminRoleParamReader() AdminRoleParamReader {
+	return adminRoleParamReader{}
+}
+
+type adminRoleParamReader struct{}
+
+func (paramReader adminRoleParamReader) ReadParamsFromRequest(request *http.Request) (AdminRoleParams, error) {
+	userUuid, err := pathUUID(request, "uuid")
+	if err != nil {
+		return AdminRoleParams{}, err
+	}
+
+	requestObj := struct {
+		Role string `json:"role"`
+	}{}
+	if err = decodeJSONBody(request, &requestObj); err != nil {
+		return AdminRoleParams{}, err
+	}
+
+	return AdminRoleParams{UserUUID: userUuid, Role: requestObj.Role}, nil
+}
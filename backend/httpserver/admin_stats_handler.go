@@ -0,0 +1,46 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewAdminStatsHandler summarises users and phrases, with the number
+// of active users for each of the last ?days= days (30 by default)
+func NewAdminStatsHandler(
+	useCase usecases.ShowAdminStatsUseCase,
+	paramReader AdminStatsParamReader,
+) http.Handler {
+	return adminStatsHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type adminStatsHandler struct {
+	useCase     usecases.ShowAdminStatsUseCase
+	paramReader AdminStatsParamReader
+}
+
+func (handler adminStatsHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "AdminStatsParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	stats, err := handler.useCase.Execute(request.Context(), usecases.ShowAdminStatsRequest{
+		Days: params.Days,
+	})
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, stats)
+}
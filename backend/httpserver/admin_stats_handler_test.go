@@ -0,0 +1,80 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("AdminStatsHandler", func() {
+	var (
+		paramReader *httpserverfakes.FakeAdminStatsParamReader
+		useCase     *usecasesfakes.FakeShowAdminStatsUseCase
+		writer      *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		paramReader = new(httpserverfakes.FakeAdminStatsParamReader)
+		paramReader.ReadParamsFromRequestReturns(AdminStatsParams{Days: 7}, nil)
+		useCase = new(usecasesfakes.FakeShowAdminStatsUseCase)
+		useCase.ExecuteReturns(api.Stats{
+			TotalUsers:    3,
+			DisabledUsers: 1,
+			PhrasesByType: map[api.PhraseType]uint{
+				api.FRENCH_TO_ENGLISH: 10,
+				api.ENGLISH_TO_FRENCH: 4,
+			},
+			ActiveUsersPerDay: []api.DailyCount{{Day: "2018-03-31", Count: 2}},
+		}, nil)
+		writer = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("GET", "/api/admin/stats?days=7", nil)
+		Expect(err).NotTo(HaveOccurred())
+		NewAdminStatsHandler(useCase, paramReader).ServeHTTP(writer, request)
+	})
+
+	It("summarises the days asked about", func() {
+		_, request := useCase.ExecuteArgsForCall(0)
+		Expect(request).To(Equal(usecases.ShowAdminStatsRequest{Days: 7}))
+
+		Expect(writer.Code).To(Equal(http.StatusOK))
+		Expect(writer.Body.String()).To(MatchJSON(`{
+			"totalUsers": 3,
+			"disabledUsers": 1,
+			"phrasesByType": {"FRENCH_TO_ENGLISH": 10, "ENGLISH_TO_FRENCH": 4},
+			"activeUsersPerDay": [{"day": "2018-03-31", "count": 2}]
+		}`))
+	})
+
+	Context("when the number of days makes no sense", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns(AdminStatsParams{}, errors.New("days must be between 1 and 366"))
+		})
+
+		It("is a bad request", func() {
+			Expect(writer.Code).To(Equal(http.StatusBadRequest))
+			Expect(useCase.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the database is down", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(api.Stats{}, errors.New("too many connections"))
+		})
+
+		It("is an internal error", func() {
+			Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -0,0 +1,40 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const DefaultStatsDays = 30
+const MaxStatsDays = 366
+
+//go:generate counterfeiter . AdminStatsParamReader
+type AdminStatsParamReader interface {
+	ReadParamsFromRequest(*http.Request) (AdminStatsParams, error)
+}
+
+type AdminStatsParams struct {
+	Days int
+}
+
+// NewAdminStatsParamReader reads how many days of activity
+// to show from ?days=, which is 30 by default
+func NewAdminStatsParamReader() AdminStatsParamReader {
+	return adminStatsParamReader{}
+}
+
+type adminStatsParamReader struct{}
+
+func (paramReader adminStatsParamReader) ReadParamsFromRequest(request *http.Request) (AdminStatsParams, error) {
+	params := AdminStatsParams{Days: DefaultStatsDays}
+	if value := request.URL.Query().Get("days"); value != "" {
+		var err error
+		params.Days, err = strconv.Atoi(value)
+		if err != nil || params.Days < 1 || params.Days > MaxStatsDays {
+			return AdminStatsParams{}, fmt.Errorf("days must be between 1 and %d", MaxStatsDays)
+		}
+	}
+
+	return params, nil
+}
@@ -0,0 +1,53 @@
+package httpserver_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("AdminStatsParamReader", func() {
+	var (
+		result    AdminStatsParams
+		resultErr error
+		url       string
+	)
+
+	BeforeEach(func() {
+		url = "http://example.com/api/admin/stats"
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("GET", url, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		result, resultErr = NewAdminStatsParamReader().ReadParamsFromRequest(request)
+	})
+
+	It("covers the last 30 days by default", func() {
+		Expect(resultErr).NotTo(HaveOccurred())
+		Expect(result).To(Equal(AdminStatsParams{Days: DefaultStatsDays}))
+	})
+
+	Context("when asked about a week", func() {
+		BeforeEach(func() {
+			url += "?days=7"
+		})
+
+		It("reads it", func() {
+			Expect(result).To(Equal(AdminStatsParams{Days: 7}))
+		})
+	})
+
+	Context("when the number of days makes no sense", func() {
+		BeforeEach(func() {
+			url += "?days=0"
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(MatchError("days must be between 1 and 366"))
+		})
+	})
+})
@@ -0,0 +1,43 @@
+package httpserver
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+//go:generate counterfeiter . AdminUserParamReader
+type AdminUserParamReader interface {
+	ReadParamsFromRequest(*http.Request) (AdminUserParams, error)
+}
+
+type AdminUserParams struct {
+	UserUUID uuid.UUID
+}
+
+// NewAdminUserParamReader reads the user from the {uuid} in the path
+func NewAdminUserParamReader() AdminUserParamReader {
+	return adminUserParamReader{}
+}
+
+type adminUserParamReader struct{}
+
+func (paramReader adminUserParamReader) ReadParamsFromRequest(request *http.Request) (AdminUserParams, error) {
+	userUuid, err := pathUUID(request, "uuid")
+	if err != nil {
+		return AdminUserParams{}, err
+	}
+
+	return AdminUserParams{UserUUID: userUuid}, nil
+}
+
+func pathUUID(request *http.Request, name string) (uuid.UUID, error) {
+	value, err := uuid.Parse(mux.Vars(request)[name])
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("%s is not a valid uuid", name)
+	}
+
+	return value, nil
+}
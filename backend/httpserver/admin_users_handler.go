@@ -0,0 +1,101 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewAdminUsersHandler lists users, most recently active first,
+// a page at a time with ?limit= and ?offset=
+func NewAdminUsersHandler(
+	useCase usecases.ShowUsersUseCase,
+	paramReader AdminUsersParamReader,
+) http.Handler {
+	return adminUsersHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type adminUsersHandler struct {
+	useCase     usecases.ShowUsersUseCase
+	paramReader AdminUsersParamReader
+}
+
+func (handler adminUsersHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "AdminUsersParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	users, err := handler.useCase.Execute(request.Context(), usecases.ShowUsersRequest{
+		Limit:  params.Limit,
+		Offset: params.Offset,
+	})
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, users)
+}
+
+// NewAdminUserHandler shows a user along with every one of their phrases
+func NewAdminUserHandler(
+	useCase usecases.ShowUserUseCase,
+	paramReader AdminUserParamReader,
+) http.Handler {
+	return adminUserHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type adminUserHandler struct {
+	useCase     usecases.ShowUserUseCase
+	paramReader AdminUserParamReader
+}
+
+func (handler adminUserHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "AdminUserParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	user, err := handler.useCase.Execute(request.Context(), usecases.ShowUserRequest{
+		UserUUID: params.UserUUID,
+	})
+	switch err {
+	case nil:
+	case api.ErrUserNotFound:
+		writeError(writer, err, http.StatusNotFound)
+		return
+	default:
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, user)
+}
+
+func writeJSON(writer http.ResponseWriter, value interface{}) {
+	responseBody, err := json.Marshal(value)
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writer.Write(responseBody)
+}
@@ -0,0 +1,175 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var lastSeen = time.Date(2018, 3, 1, 12, 0, 0, 0, time.UTC)
+
+var _ = Describe("AdminUsersHandler", func() {
+	var (
+		paramReader *httpserverfakes.FakeAdminUsersParamReader
+		useCase     *usecasesfakes.FakeShowUsersUseCase
+		writer      *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		paramReader = new(httpserverfakes.FakeAdminUsersParamReader)
+		paramReader.ReadParamsFromRequestReturns(AdminUsersParams{Limit: 20, Offset: 40}, nil)
+		useCase = new(usecasesfakes.FakeShowUsersUseCase)
+		useCase.ExecuteReturns([]api.User{{
+			Uuid:           userUUID.String(),
+			Role:           api.ROLE_MODERATOR,
+			PhraseCount:    12,
+			CreatedAt:      lastSeen.Add(-time.Hour),
+			LastSeenAt:     lastSeen,
+			DisabledAt:     &lastSeen,
+			DisabledReason: "spam",
+		}}, nil)
+		writer = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("GET", "/api/admin/users?limit=20&offset=40", nil)
+		Expect(err).NotTo(HaveOccurred())
+		NewAdminUsersHandler(useCase, paramReader).ServeHTTP(writer, request)
+	})
+
+	It("shows when each one was last active", func() {
+		_, request := useCase.ExecuteArgsForCall(0)
+		Expect(request).To(Equal(usecases.ShowUsersRequest{Limit: 20, Offset: 40}))
+
+		Expect(writer.Code).To(Equal(http.StatusOK))
+		Expect(writer.Body.String()).To(MatchJSON(`[{
+			"uuid": "e2580a5b-cabb-4387-bcea-30e9401a2aa4",
+			"role": "moderator",
+			"phraseCount": 12,
+			"createdAt": "2018-03-01T11:00:00Z",
+			"lastSeenAt": "2018-03-01T12:00:00Z",
+			"disabledAt": "2018-03-01T12:00:00Z",
+			"disabledReason": "spam"
+		}]`))
+	})
+
+	Context("when the page makes no sense", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns(AdminUsersParams{}, errors.New("limit must be between 1 and 500"))
+		})
+
+		It("is a bad request", func() {
+			Expect(writer.Code).To(Equal(http.StatusBadRequest))
+			Expect(writer.Body.String()).To(Equal(`{"error":"limit must be between 1 and 500"}`))
+			Expect(useCase.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the database is down", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(nil, errors.New("too many connections"))
+		})
+
+		It("is an internal error", func() {
+			Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
+
+var _ = Describe("AdminUserHandler", func() {
+	var (
+		paramReader *httpserverfakes.FakeAdminUserParamReader
+		useCase     *usecasesfakes.FakeShowUserUseCase
+		writer      *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		paramReader = new(httpserverfakes.FakeAdminUserParamReader)
+		paramReader.ReadParamsFromRequestReturns(AdminUserParams{UserUUID: userUUID}, nil)
+		useCase = new(usecasesfakes.FakeShowUserUseCase)
+		useCase.ExecuteReturns(usecases.UserResponse{
+			User: api.User{Uuid: userUUID.String(), Role: api.ROLE_USER, LastSeenAt: lastSeen, CreatedAt: lastSeen},
+			Phrases: []api.AdminPhrase{{
+				Uuid:        "the-uuid",
+				Type:        api.FRENCH_TO_ENGLISH,
+				Content:     "bonjour",
+				Translation: "hello",
+				CreatedAt:   lastSeen,
+				UpdatedAt:   lastSeen,
+			}},
+		}, nil)
+		writer = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("GET", "/api/admin/users/"+userUUID.String(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		NewAdminUserHandler(useCase, paramReader).ServeHTTP(writer, request)
+	})
+
+	It("includes their phrases in both directions", func() {
+		_, request := useCase.ExecuteArgsForCall(0)
+		Expect(request).To(Equal(usecases.ShowUserRequest{UserUUID: userUUID}))
+
+		Expect(writer.Code).To(Equal(http.StatusOK))
+		Expect(writer.Body.String()).To(MatchJSON(`{
+			"user": {
+				"uuid": "e2580a5b-cabb-4387-bcea-30e9401a2aa4",
+				"role": "user",
+				"phraseCount": 0,
+				"createdAt": "2018-03-01T12:00:00Z",
+				"lastSeenAt": "2018-03-01T12:00:00Z"
+			},
+			"phrases": [{
+				"uuid": "the-uuid",
+				"type": "FRENCH_TO_ENGLISH",
+				"content": "bonjour",
+				"translation": "hello",
+				"createdAt": "2018-03-01T12:00:00Z",
+				"updatedAt": "2018-03-01T12:00:00Z"
+			}]
+		}`))
+	})
+
+	Context("when there is no such user", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.UserResponse{}, api.ErrUserNotFound)
+		})
+
+		It("is not found", func() {
+			Expect(writer.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Context("when the uuid is malformed", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns(AdminUserParams{}, errors.New("uuid is not a valid uuid"))
+		})
+
+		It("is a bad request", func() {
+			Expect(writer.Code).To(Equal(http.StatusBadRequest))
+			Expect(writer.Body.String()).To(Equal(`{"error":"uuid is not a valid uuid"}`))
+			Expect(useCase.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the database is down", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.UserResponse{}, errors.New("too many connections"))
+		})
+
+		It("is an internal error", func() {
+			Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const DefaultAdminPageSize = 100
+const MaxAdminPageSize = 500
+
+//go:generate counterfeiter . AdminUsersParamReader
+type AdminUsersParamReader interface {
+	ReadParamsFromRequest(*http.Request) (AdminUsersParams, error)
+}
+
+type AdminUsersParams struct {
+	Limit  int
+	Offset int
+}
+
+// NewAdminUsersParamReader reads a page of users from ?limit= and ?offset=
+func NewAdminUsersParamReader() AdminUsersParamReader {
+	return adminUsersParamReader{}
+}
+
+type adminUsersParamReader struct{}
+
+func (paramReader adminUsersParamReader) ReadParamsFromRequest(request *http.Request) (AdminUsersParams, error) {
+	params := AdminUsersParams{Limit: DefaultAdminPageSize}
+
+	var err error
+	if limit := request.URL.Query().Get("limit"); limit != "" {
+		params.Limit, err = strconv.Atoi(limit)
+		if err != nil || params.Limit < 1 || params.Limit > MaxAdminPageSize {
+			return AdminUsersParams{}, fmt.Errorf("limit must be between 1 and %d", MaxAdminPageSize)
+		}
+	}
+	if offset := request.URL.Query().Get("offset"); offset != "" {
+		params.Offset, err = strconv.Atoi(offset)
+		if err != nil || params.Offset < 0 {
+			return AdminUsersParams{}, errors.New("offset must be a positive number")
+		}
+	}
+
+	return params, nil
+}
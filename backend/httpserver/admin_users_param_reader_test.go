@@ -0,0 +1,64 @@
+package httpserver_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("AdminUsersParamReader", func() {
+	var (
+		result    AdminUsersParams
+		resultErr error
+		url       string
+	)
+
+	BeforeEach(func() {
+		url = "http://example.com/api/admin/users"
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("GET", url, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		result, resultErr = NewAdminUsersParamReader().ReadParamsFromRequest(request)
+	})
+
+	It("returns the first page by default", func() {
+		Expect(resultErr).NotTo(HaveOccurred())
+		Expect(result).To(Equal(AdminUsersParams{Limit: DefaultAdminPageSize, Offset: 0}))
+	})
+
+	Context("when a page is asked for", func() {
+		BeforeEach(func() {
+			url += "?limit=20&offset=40"
+		})
+
+		It("reads it", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result).To(Equal(AdminUsersParams{Limit: 20, Offset: 40}))
+		})
+	})
+
+	Context("when the page is too large", func() {
+		BeforeEach(func() {
+			url += "?limit=5000"
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(MatchError("limit must be between 1 and 500"))
+		})
+	})
+
+	Context("when the offset is negative", func() {
+		BeforeEach(func() {
+			url += "?offset=-1"
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(MatchError("offset must be a positive number"))
+		})
+	})
+})
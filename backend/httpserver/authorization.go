@@ -0,0 +1,72 @@
+package httpserver
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+// StaffKeyHeader is how moderators and admins prove who they are.
+// Their X-User-Token is no good for that, as it's their uuid, which
+// other staff can see
+const StaffKeyHeader = "X-Staff-Key"
+
+var errNotAuthenticated = errors.New("ah ah ah, you didn't say the magic word")
+
+type Authorizer struct {
+	users api.UsersRepository
+}
+
+// NewAuthorizer checks callers against the roles routes require, using
+// the staff keys handed out by `main grant-role` and the admin role handler
+func NewAuthorizer(users api.UsersRepository) *Authorizer {
+	return &Authorizer{users: users}
+}
+
+// Require only lets through callers whose role includes the given one,
+// and tells the handler (and the audit log) who they are with api.WithActor
+func (authorizer *Authorizer) Require(role api.Role, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		actor, err := authorizer.authenticate(request)
+		if err == errNotAuthenticated {
+			writeError(writer, err, http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			writeError(writer, err, http.StatusInternalServerError)
+			return
+		}
+
+		if !actor.Role.Includes(role) {
+			writeError(writer, fmt.Errorf("this needs the %s role", role), http.StatusForbidden)
+			return
+		}
+
+		actor.Device = deviceFromRequest(request)
+		actor.RequestID = RequestIDFromContext(request.Context())
+		handler.ServeHTTP(writer, request.WithContext(api.WithActor(request.Context(), actor)))
+	})
+}
+
+// only a key whose hash matches the one stored for its user will do,
+// and the comparison takes as long whether the guess is close or not
+func (authorizer *Authorizer) authenticate(request *http.Request) (api.Actor, error) {
+	key := request.Header.Get(StaffKeyHeader)
+	userUuid, err := api.ParseStaffKey(key)
+	if err != nil {
+		return api.Actor{}, errNotAuthenticated
+	}
+
+	role, keyHash, err := authorizer.users.StaffRoleForUser(request.Context(), userUuid)
+	if err != nil {
+		return api.Actor{}, err
+	}
+	if len(keyHash) == 0 || subtle.ConstantTimeCompare(api.HashStaffKey(key), keyHash) != 1 {
+		return api.Actor{}, errNotAuthenticated
+	}
+
+	return api.Actor{UserUUID: &userUuid, Role: role}, nil
+}
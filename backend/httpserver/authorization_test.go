@@ -0,0 +1,163 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("Authorizer", func() {
+	var (
+		users    *apifakes.FakeUsersRepository
+		staffKey string
+		keyHash  []byte
+		required api.Role
+		actor    *api.Actor
+		writer   *httptest.ResponseRecorder
+		request  *http.Request
+	)
+
+	BeforeEach(func() {
+		var err error
+		staffKey, keyHash, err = api.NewStaffKey(userUUID)
+		Expect(err).NotTo(HaveOccurred())
+
+		users = new(apifakes.FakeUsersRepository)
+		users.StaffRoleForUserReturns(api.ROLE_MODERATOR, keyHash, nil)
+		required = api.ROLE_MODERATOR
+		actor = nil
+		writer = httptest.NewRecorder()
+
+		request, err = http.NewRequest("GET", "/api/admin/users", nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		NewAuthorizer(users).Require(required, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			found := api.ActorFromContext(r.Context())
+			actor = &found
+		})).ServeHTTP(writer, request)
+	})
+
+	Context("with the staff key of a moderator", func() {
+		BeforeEach(func() {
+			request.Header.Set("X-Staff-Key", staffKey)
+		})
+
+		It("lets them do what moderators do", func() {
+			Expect(actor).NotTo(BeNil())
+			Expect(*actor.UserUUID).To(Equal(userUUID))
+			Expect(actor.Role).To(Equal(api.ROLE_MODERATOR))
+
+			_, looked := users.StaffRoleForUserArgsForCall(0)
+			Expect(looked).To(Equal(userUUID))
+		})
+
+		Context("on a route only admins can use", func() {
+			BeforeEach(func() {
+				required = api.ROLE_ADMIN
+			})
+
+			It("is forbidden", func() {
+				Expect(actor).To(BeNil())
+				Expect(writer.Code).To(Equal(http.StatusForbidden))
+				Expect(writer.Body.String()).To(Equal(`{"error":"this needs the admin role"}`))
+			})
+		})
+
+		Context("when their account has been disabled", func() {
+			BeforeEach(func() {
+				users.StaffRoleForUserReturns("", keyHash, nil)
+			})
+
+			It("is forbidden", func() {
+				Expect(actor).To(BeNil())
+				Expect(writer.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when the role can't be looked up", func() {
+			BeforeEach(func() {
+				users.StaffRoleForUserReturns("", nil, errors.New("too many connections"))
+			})
+
+			It("doesn't let the caller in", func() {
+				Expect(actor).To(BeNil())
+				Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Context("with a key that has since been replaced", func() {
+		BeforeEach(func() {
+			request.Header.Set("X-Staff-Key", staffKey)
+
+			_, newHash, err := api.NewStaffKey(userUUID)
+			Expect(err).NotTo(HaveOccurred())
+			users.StaffRoleForUserReturns(api.ROLE_ADMIN, newHash, nil)
+		})
+
+		It("turns the caller away", func() {
+			Expect(actor).To(BeNil())
+			Expect(writer.Code).To(Equal(http.StatusUnauthorized))
+			Expect(writer.Body.String()).To(Equal(`{"error":"ah ah ah, you didn't say the magic word"}`))
+		})
+	})
+
+	Context("with someone else's uuid in front of the secret", func() {
+		BeforeEach(func() {
+			otherUUID := uuid.Must(uuid.Parse("2dff2424-c888-4785-a91d-6fcb006dabe5"))
+			request.Header.Set("X-Staff-Key", otherUUID.String()+staffKey[len(userUUID.String()):])
+		})
+
+		It("turns the caller away", func() {
+			Expect(actor).To(BeNil())
+			Expect(writer.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	Context("with the token of an admin and no staff key", func() {
+		BeforeEach(func() {
+			request.Header.Set("X-User-Token", userUUID.String())
+			users.StaffRoleForUserReturns(api.ROLE_ADMIN, keyHash, nil)
+		})
+
+		It("turns the caller away without looking them up", func() {
+			Expect(actor).To(BeNil())
+			Expect(writer.Code).To(Equal(http.StatusUnauthorized))
+			Expect(users.StaffRoleForUserCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("with just a uuid as the staff key", func() {
+		BeforeEach(func() {
+			request.Header.Set("X-Staff-Key", userUUID.String())
+		})
+
+		It("turns the caller away", func() {
+			Expect(actor).To(BeNil())
+			Expect(writer.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	Context("with a staff key for someone who has never had one", func() {
+		BeforeEach(func() {
+			request.Header.Set("X-Staff-Key", userUUID.String()+".guess")
+			users.StaffRoleForUserReturns(api.ROLE_USER, nil, nil)
+			required = api.ROLE_USER
+		})
+
+		It("turns the caller away, whatever the route", func() {
+			Expect(actor).To(BeNil())
+			Expect(writer.Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CORSConfig says which other origins may call the API from a browser.
+// Origins are matched exactly, or "*" allows any origin and
+// "https://*.example.com" any subdomain of example.com
+type CORSConfig struct {
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	ExposedHeaders []string
+	MaxAge         time.Duration
+}
+
+var DefaultCORSConfig = CORSConfig{
+	AllowedMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+	AllowedHeaders: []string{"Content-Type", "X-User-Token", StaffKeyHeader, DeviceHeader, RequestIDHeader, "traceparent"},
+	ExposedHeaders: []string{
+		"X-Next-Cursor",
+		RequestIDHeader,
+		"Retry-After",
+		"RateLimit-Limit",
+		"RateLimit-Remaining",
+		"RateLimit-Reset",
+	},
+	MaxAge: 10 * time.Minute,
+}
+
+var errCORSNotAllowed = errors.New("this cross origin request is not allowed")
+
+// NewCORS answers preflight requests itself and adds the CORS headers
+// to everything else from an allowed origin. With no allowed origins,
+// it leaves requests alone and only same origin clients will work
+func NewCORS(handler http.Handler, config CORSConfig) http.Handler {
+	if len(config.AllowedOrigins) == 0 {
+		return handler
+	}
+
+	return &cors{handler: handler, config: config}
+}
+
+type cors struct {
+	handler http.Handler
+	config  CORSConfig
+}
+
+func (cors *cors) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	origin := request.Header.Get("Origin")
+	if origin == "" {
+		cors.handler.ServeHTTP(writer, request)
+		return
+	}
+
+	// responses differ by origin, so caches have to keep them apart
+	writer.Header().Add("Vary", "Origin")
+
+	preflight := request.Method == "OPTIONS" && request.Header.Get("Access-Control-Request-Method") != ""
+	if preflight {
+		writer.Header().Add("Vary", "Access-Control-Request-Method")
+		writer.Header().Add("Vary", "Access-Control-Request-Headers")
+	}
+
+	if !cors.allowsOrigin(origin) {
+		if preflight {
+			writeError(writer, errCORSNotAllowed, http.StatusForbidden)
+			return
+		}
+		cors.handler.ServeHTTP(writer, request)
+		return
+	}
+
+	writer.Header().Set("Access-Control-Allow-Origin", origin)
+
+	if !preflight {
+		if len(cors.config.ExposedHeaders) > 0 {
+			writer.Header().Set("Access-Control-Expose-Headers", strings.Join(cors.config.ExposedHeaders, ", "))
+		}
+		cors.handler.ServeHTTP(writer, request)
+		return
+	}
+
+	if !containsFold(cors.config.AllowedMethods, request.Header.Get("Access-Control-Request-Method")) {
+		writeError(writer, errCORSNotAllowed, http.StatusForbidden)
+		return
+	}
+	for _, header := range strings.Split(request.Header.Get("Access-Control-Request-Headers"), ",") {
+		header = strings.TrimSpace(header)
+		if header != "" && !containsFold(cors.config.AllowedHeaders, header) {
+			writeError(writer, errCORSNotAllowed, http.StatusForbidden)
+			return
+		}
+	}
+
+	writer.Header().Set("Access-Control-Allow-Methods", strings.Join(cors.config.AllowedMethods, ", "))
+	writer.Header().Set("Access-Control-Allow-Headers", strings.Join(cors.config.AllowedHeaders, ", "))
+	if cors.config.MaxAge > 0 {
+		writer.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(cors.config.MaxAge.Seconds())))
+	}
+	writer.WriteHeader(http.StatusNoContent)
+}
+
+func (cors *cors) allowsOrigin(origin string) bool {
+	for _, allowed := range cors.config.AllowedOrigins {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+
+		wildcard := strings.Index(allowed, "://*.")
+		if wildcard >= 0 {
+			scheme := allowed[:wildcard+len("://")]
+			domain := allowed[wildcard+len("://*"):]
+			if strings.HasPrefix(origin, scheme) && strings.HasSuffix(origin, domain) && len(origin) > len(scheme)+len(domain) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
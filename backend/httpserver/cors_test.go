@@ -0,0 +1,144 @@
+package httpserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("CORS", func() {
+	var (
+		config  CORSConfig
+		served  bool
+		writer  *httptest.ResponseRecorder
+		request *http.Request
+	)
+
+	BeforeEach(func() {
+		config = DefaultCORSConfig
+		config.AllowedOrigins = []string{"https://dashboard.example.com", "https://*.doit-etre-rad.fr"}
+		config.MaxAge = 5 * time.Minute
+
+		served = false
+		writer = httptest.NewRecorder()
+
+		var err error
+		request, err = http.NewRequest("GET", "/api/phrases/french", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("Origin", "https://dashboard.example.com")
+	})
+
+	JustBeforeEach(func() {
+		subject := NewCORS(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served = true
+		}), config)
+		subject.ServeHTTP(writer, request)
+	})
+
+	It("lets an allowed origin read the response and our headers", func() {
+		Expect(served).To(BeTrue())
+		Expect(writer.Header().Get("Access-Control-Allow-Origin")).To(Equal("https://dashboard.example.com"))
+		Expect(writer.Header().Get("Access-Control-Expose-Headers")).To(ContainSubstring("X-Next-Cursor"))
+		Expect(writer.Header()["Vary"]).To(ContainElement("Origin"))
+	})
+
+	Context("when the origin matches a wildcard", func() {
+		BeforeEach(func() {
+			request.Header.Set("Origin", "https://extension.doit-etre-rad.fr")
+		})
+
+		It("allows it", func() {
+			Expect(writer.Header().Get("Access-Control-Allow-Origin")).To(Equal("https://extension.doit-etre-rad.fr"))
+		})
+	})
+
+	Context("when the origin is not allowed", func() {
+		BeforeEach(func() {
+			request.Header.Set("Origin", "https://doit-etre-rad.fr.evil.com")
+		})
+
+		It("serves the request without CORS headers, so the browser hides the response", func() {
+			Expect(served).To(BeTrue())
+			Expect(writer.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty())
+		})
+	})
+
+	Context("when the request is not cross origin", func() {
+		BeforeEach(func() {
+			request.Header.Del("Origin")
+		})
+
+		It("leaves it alone", func() {
+			Expect(served).To(BeTrue())
+			Expect(writer.Header()).To(BeEmpty())
+		})
+	})
+
+	Describe("preflight requests", func() {
+		BeforeEach(func() {
+			request.Method = "OPTIONS"
+			request.Header.Set("Access-Control-Request-Method", "POST")
+			request.Header.Set("Access-Control-Request-Headers", "content-type, x-user-token")
+		})
+
+		It("answers them without bothering the handler", func() {
+			Expect(served).To(BeFalse())
+			Expect(writer.Code).To(Equal(http.StatusNoContent))
+			Expect(writer.Header().Get("Access-Control-Allow-Origin")).To(Equal("https://dashboard.example.com"))
+			Expect(writer.Header().Get("Access-Control-Allow-Methods")).To(Equal("GET, POST, PUT, PATCH, DELETE"))
+			Expect(writer.Header().Get("Access-Control-Allow-Headers")).To(ContainSubstring("X-User-Token"))
+			Expect(writer.Header().Get("Access-Control-Allow-Headers")).To(ContainSubstring("X-Staff-Key"))
+			Expect(writer.Header().Get("Access-Control-Max-Age")).To(Equal("300"))
+		})
+
+		Context("when the method is not allowed", func() {
+			BeforeEach(func() {
+				request.Header.Set("Access-Control-Request-Method", "TRACE")
+			})
+
+			It("refuses", func() {
+				Expect(served).To(BeFalse())
+				Expect(writer.Code).To(Equal(http.StatusForbidden))
+				Expect(writer.Header().Get("Access-Control-Allow-Methods")).To(BeEmpty())
+			})
+		})
+
+		Context("when a header is not allowed", func() {
+			BeforeEach(func() {
+				request.Header.Set("Access-Control-Request-Headers", "x-user-token, x-sneaky")
+			})
+
+			It("refuses", func() {
+				Expect(writer.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when the origin is not allowed", func() {
+			BeforeEach(func() {
+				request.Header.Set("Origin", "https://evil.com")
+			})
+
+			It("refuses", func() {
+				Expect(writer.Code).To(Equal(http.StatusForbidden))
+				Expect(writer.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("when no origins are allowed", func() {
+		BeforeEach(func() {
+			config.AllowedOrigins = nil
+			request.Method = "OPTIONS"
+			request.Header.Set("Access-Control-Request-Method", "POST")
+		})
+
+		It("passes everything through", func() {
+			Expect(served).To(BeTrue())
+			Expect(writer.Header()).To(BeEmpty())
+		})
+	})
+})
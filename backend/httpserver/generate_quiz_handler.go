@@ -0,0 +1,50 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewGenerateQuizHandler makes a quiz of ?questions= questions (10 by
+// default) from the user's phrases, or their example sentences for cloze
+// items. The answers are kept back until the quiz is submitted to NewGradeQuizHandler
+func NewGenerateQuizHandler(
+	useCase usecases.GenerateQuizUseCase,
+	paramReader GenerateQuizParamReader,
+) http.Handler {
+	return generateQuizHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type generateQuizHandler struct {
+	useCase     usecases.GenerateQuizUseCase
+	paramReader GenerateQuizParamReader
+}
+
+func (handler generateQuizHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "GenerateQuizParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	quiz, err := handler.useCase.Execute(request.Context(), usecases.GenerateQuizRequest(params))
+	switch err {
+	case nil:
+	case usecases.ErrNoPhrasesForQuiz, usecases.ErrNoExamplesForCloze:
+		writeError(writer, err, http.StatusUnprocessableEntity)
+		return
+	default:
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, quiz)
+}
@@ -0,0 +1,55 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+//go:generate counterfeiter . GenerateQuizParamReader
+type GenerateQuizParamReader interface {
+	ReadParamsFromRequest(*http.Request) (GenerateQuizParams, error)
+}
+
+// GenerateQuizParams.Questions is 0 when the client leaves it to us
+type GenerateQuizParams struct {
+	UserUUID  uuid.UUID
+	Questions int
+}
+
+func NewGenerateQuizParamReader() GenerateQuizParamReader {
+	return generateQuizParamReader{}
+}
+
+type generateQuizParamReader struct{}
+
+func (paramReader generateQuizParamReader) ReadParamsFromRequest(
+	request *http.Request,
+) (GenerateQuizParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return GenerateQuizParams{}, errors.New("you done goofed; I'm pretty sure you didn't authenticate")
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return GenerateQuizParams{}, err
+	}
+
+	questions := 0
+	if value := request.URL.Query().Get("questions"); value != "" {
+		questions, err = strconv.Atoi(value)
+		if err != nil || questions < 1 || questions > usecases.MaxQuizQuestions {
+			return GenerateQuizParams{}, fmt.Errorf("questions must be between 1 and %d", usecases.MaxQuizQuestions)
+		}
+	}
+
+	return GenerateQuizParams{
+		UserUUID:  userUuid,
+		Questions: questions,
+	}, nil
+}
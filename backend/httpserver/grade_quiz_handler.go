@@ -0,0 +1,60 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewGradeQuizHandler takes {"answers": [...]}, one per question in order,
+// and responds with the score and the right answer to every question
+func NewGradeQuizHandler(
+	useCase usecases.GradeQuizUseCase,
+	paramReader GradeQuizParamReader,
+) http.Handler {
+	return gradeQuizHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type gradeQuizHandler struct {
+	useCase     usecases.GradeQuizUseCase
+	paramReader GradeQuizParamReader
+}
+
+func (handler gradeQuizHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "GradeQuizParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	result, err := handler.useCase.Execute(request.Context(), usecases.GradeQuizRequest(params))
+	if validationErr, ok := err.(usecases.ValidationError); ok {
+		writeValidationError(writer, validationErr)
+		return
+	}
+	switch err {
+	case nil:
+	case api.ErrQuizNotFound:
+		writeError(writer, err, http.StatusNotFound)
+		return
+	case api.ErrQuizAlreadySubmitted:
+		writeError(writer, err, http.StatusConflict)
+		return
+	case usecases.ErrQuizExpired:
+		writeError(writer, err, http.StatusGone)
+		return
+	default:
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, result)
+}
@@ -0,0 +1,118 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("GradeQuizHandler", func() {
+	var paramReader *httpserverfakes.FakeGradeQuizParamReader
+	var useCase *usecasesfakes.FakeGradeQuizUseCase
+	var writer *httptest.ResponseRecorder
+
+	quizUUID := uuid.Must(uuid.Parse("0f9a3c52-52a4-4a3e-9f55-5b1a3c5d0f7e"))
+
+	BeforeEach(func() {
+		paramReader = new(httpserverfakes.FakeGradeQuizParamReader)
+		paramReader.ReadParamsFromRequestReturns(GradeQuizParams{
+			UserUUID: userUUID,
+			QuizUUID: quizUUID,
+			Answers:  []string{"the cat"},
+		}, nil)
+		useCase = new(usecasesfakes.FakeGradeQuizUseCase)
+		useCase.ExecuteReturns(usecases.QuizResultResponse{
+			Score: 1,
+			Total: 1,
+			Questions: []usecases.GradedQuestionResponse{
+				{Prompt: "le chat", Answer: "the cat", Given: "the cat", Correct: true},
+			},
+		}, nil)
+		writer = httptest.NewRecorder()
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("POST", "/api/quizzes/french/"+quizUUID.String(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		NewGradeQuizHandler(useCase, paramReader).ServeHTTP(writer, request)
+	})
+
+	It("responds with the grades", func() {
+		_, request := useCase.ExecuteArgsForCall(0)
+		Expect(request).To(Equal(usecases.GradeQuizRequest{
+			UserUUID: userUUID,
+			QuizUUID: quizUUID,
+			Answers:  []string{"the cat"},
+		}))
+
+		Expect(writer.Code).To(Equal(http.StatusOK))
+		Expect(writer.Body.String()).To(MatchJSON(`{
+			"score": 1,
+			"total": 1,
+			"questions": [{"prompt": "le chat", "answer": "the cat", "given": "the cat", "correct": true}]
+		}`))
+	})
+
+	Context("when there is no such quiz", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.QuizResultResponse{}, api.ErrQuizNotFound)
+		})
+
+		It("responds 404", func() {
+			Expect(writer.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Context("when the quiz has already been submitted", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.QuizResultResponse{}, api.ErrQuizAlreadySubmitted)
+		})
+
+		It("responds 409 Conflict", func() {
+			Expect(writer.Code).To(Equal(http.StatusConflict))
+		})
+	})
+
+	Context("when the quiz has expired", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.QuizResultResponse{}, usecases.ErrQuizExpired)
+		})
+
+		It("responds 410 Gone", func() {
+			Expect(writer.Code).To(Equal(http.StatusGone))
+		})
+	})
+
+	Context("when the database is down", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.QuizResultResponse{}, errors.New("RUH ROH"))
+		})
+
+		It("responds 500", func() {
+			Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Context("when an answer is missing", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(usecases.QuizResultResponse{}, usecases.ValidationError{
+				Errors: []usecases.FieldError{{Field: "answers", Message: "must have an answer for each of the 3 questions"}},
+			})
+		})
+
+		It("says which field is wrong", func() {
+			Expect(writer.Code).To(Equal(http.StatusUnprocessableEntity))
+			Expect(writer.Body.String()).To(ContainSubstring("must have an answer for each of the 3 questions"))
+		})
+	})
+})
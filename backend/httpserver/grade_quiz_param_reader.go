@@ -0,0 +1,59 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+//go:generate counterfeiter . GradeQuizParamReader
+type GradeQuizParamReader interface {
+	ReadParamsFromRequest(*http.Request) (GradeQuizParams, error)
+}
+
+type GradeQuizParams struct {
+	UserUUID uuid.UUID
+	QuizUUID uuid.UUID
+	Answers  []string
+}
+
+func NewGradeQuizParamReader() GradeQuizParamReader {
+	return gradeQuizParamReader{}
+}
+
+type gradeQuizParamReader struct{}
+
+func (paramReader gradeQuizParamReader) ReadParamsFromRequest(
+	request *http.Request,
+) (GradeQuizParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return GradeQuizParams{}, errors.New("you done goofed; I'm pretty sure you didn't authenticate")
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return GradeQuizParams{}, err
+	}
+
+	quizUuid, err := uuid.Parse(mux.Vars(request)["uuid"])
+	if err != nil {
+		return GradeQuizParams{}, errors.New("invalid quiz uuid")
+	}
+
+	requestObj := struct {
+		Answers []string `json:"answers"`
+	}{}
+	err = decodeJSONBody(request, &requestObj)
+	if err != nil {
+		return GradeQuizParams{}, err
+	}
+
+	return GradeQuizParams{
+		UserUUID: userUuid,
+		QuizUUID: quizUuid,
+		Answers:  requestObj.Answers,
+	}, nil
+}
@@ -0,0 +1,85 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+const readinessTimeout = 5 * time.Second
+
+type HealthCheck struct {
+	Name  string
+	Check func(context.Context) error
+}
+
+type healthCheckResult struct {
+	Name      string  `json:"name"`
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+	Error     string  `json:"error,omitempty"`
+}
+
+type healthResponse struct {
+	Status string              `json:"status"`
+	Checks []healthCheckResult `json:"checks"`
+}
+
+// NewLivenessHandler only tells the platform that the process is up and
+// serving requests; it deliberately doesn't depend on anything else
+func NewLivenessHandler() http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writeHealth(writer, healthResponse{Status: "ok", Checks: []healthCheckResult{}})
+	})
+}
+
+// NewReadinessHandler runs every check and only reports ready
+// when all of them pass, so traffic isn't routed to a broken instance
+func NewReadinessHandler(checks []HealthCheck) http.Handler {
+	return readinessHandler{checks: checks}
+}
+
+type readinessHandler struct {
+	checks []HealthCheck
+}
+
+func (handler readinessHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	ctx, cancel := context.WithTimeout(request.Context(), readinessTimeout)
+	defer cancel()
+
+	response := healthResponse{Status: "ok", Checks: []healthCheckResult{}}
+	for _, check := range handler.checks {
+		started := time.Now()
+		err := check.Check(ctx)
+
+		result := healthCheckResult{
+			Name:      check.Name,
+			Status:    "ok",
+			LatencyMs: float64(time.Since(started)) / float64(time.Millisecond),
+		}
+		if err != nil {
+			result.Status = "failing"
+			result.Error = err.Error()
+			response.Status = "unavailable"
+		}
+		response.Checks = append(response.Checks, result)
+	}
+
+	writeHealth(writer, response)
+}
+
+func writeHealth(writer http.ResponseWriter, response healthResponse) {
+	responseBody, err := json.Marshal(response)
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Cache-Control", "no-store")
+	if response.Status != "ok" {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}
+	writer.Write(responseBody)
+}
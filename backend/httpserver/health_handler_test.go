@@ -0,0 +1,102 @@
+package httpserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("health handlers", func() {
+	var writer *httptest.ResponseRecorder
+	var request *http.Request
+
+	BeforeEach(func() {
+		writer = httptest.NewRecorder()
+
+		var err error
+		request, err = http.NewRequest("GET", "http://example.com/readyz", nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	type checkResult struct {
+		Name      string  `json:"name"`
+		Status    string  `json:"status"`
+		LatencyMs float64 `json:"latencyMs"`
+		Error     string  `json:"error"`
+	}
+
+	type healthResponse struct {
+		Status string        `json:"status"`
+		Checks []checkResult `json:"checks"`
+	}
+
+	readResponse := func() healthResponse {
+		response := healthResponse{}
+		Expect(json.Unmarshal(writer.Body.Bytes(), &response)).To(Succeed())
+		return response
+	}
+
+	passing := func(context.Context) error { return nil }
+	failing := func(context.Context) error { return errors.New("connection refused") }
+
+	Describe("LivenessHandler", func() {
+		It("reports that the process is up", func() {
+			NewLivenessHandler().ServeHTTP(writer, request)
+
+			Expect(writer.Code).To(Equal(http.StatusOK))
+			Expect(readResponse().Status).To(Equal("ok"))
+		})
+	})
+
+	Describe("ReadinessHandler", func() {
+		Context("when every check passes", func() {
+			BeforeEach(func() {
+				NewReadinessHandler([]HealthCheck{
+					{Name: "database", Check: passing},
+					{Name: "migrations", Check: passing},
+				}).ServeHTTP(writer, request)
+			})
+
+			It("reports that the instance is ready", func() {
+				Expect(writer.Code).To(Equal(http.StatusOK))
+
+				response := readResponse()
+				Expect(response.Status).To(Equal("ok"))
+				Expect(response.Checks).To(HaveLen(2))
+				Expect(response.Checks[0].Name).To(Equal("database"))
+				Expect(response.Checks[0].Status).To(Equal("ok"))
+				Expect(response.Checks[1].Name).To(Equal("migrations"))
+				Expect(response.Checks[1].Status).To(Equal("ok"))
+			})
+		})
+
+		Context("when a check fails", func() {
+			BeforeEach(func() {
+				NewReadinessHandler([]HealthCheck{
+					{Name: "database", Check: failing},
+					{Name: "migrations", Check: passing},
+				}).ServeHTTP(writer, request)
+			})
+
+			It("reports that the instance is unavailable, and why", func() {
+				Expect(writer.Code).To(Equal(http.StatusServiceUnavailable))
+
+				response := readResponse()
+				Expect(response.Status).To(Equal("unavailable"))
+				Expect(response.Checks[0]).To(Equal(checkResult{
+					Name:      "database",
+					Status:    "failing",
+					LatencyMs: response.Checks[0].LatencyMs,
+					Error:     "connection refused",
+				}))
+				Expect(response.Checks[1].Status).To(Equal("ok"))
+			})
+		})
+	})
+})
@@ -0,0 +1,105 @@
+package httpserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// MaxRequestBodyBytes is far more than a full sync of MaxPhrasesPerSync
+// phrases needs, but small enough that nobody can make us buffer gigabytes
+const MaxRequestBodyBytes = 1 << 20
+
+// RequestBodyError is what the param readers return when the body is
+// too big or doesn't have the shape we expect. writeError answers it
+// with its own status, rather than whatever the handler asked for
+type RequestBodyError struct {
+	Status  int
+	Message string
+}
+
+func (err RequestBodyError) Error() string {
+	return err.Message
+}
+
+// decodeJSONBody decodes exactly one JSON value from the body into a typed
+// struct, refusing fields the struct doesn't have and values of the wrong type
+func decodeJSONBody(request *http.Request, into interface{}) error {
+	body, err := ioutil.ReadAll(io.LimitReader(request.Body, MaxRequestBodyBytes+1))
+	if err != nil {
+		return RequestBodyError{Status: http.StatusBadRequest, Message: "request body could not be read"}
+	}
+	// a body cut off at the limit looks like broken JSON,
+	// so check for that before anything else
+	if len(body) > MaxRequestBodyBytes {
+		return RequestBodyError{
+			Status:  http.StatusRequestEntityTooLarge,
+			Message: fmt.Sprintf("request body must be at most %d bytes", MaxRequestBodyBytes),
+		}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(into)
+	if err == nil {
+		if _, trailing := decoder.Token(); trailing != io.EOF {
+			err = RequestBodyError{Status: http.StatusBadRequest, Message: "request body must contain a single JSON value"}
+		}
+	}
+
+	return jsonBodyError(err)
+}
+
+func jsonBodyError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := err.(RequestBodyError); ok {
+		return err
+	}
+
+	if typeErr, ok := err.(*json.UnmarshalTypeError); ok {
+		if typeErr.Field == "" {
+			return RequestBodyError{
+				Status:  http.StatusUnprocessableEntity,
+				Message: fmt.Sprintf("request body must be %s, not %s", describeJSONType(typeErr.Type.Kind()), typeErr.Value),
+			}
+		}
+		// newer versions of Go give the whole path, like "0.translation"
+		field := typeErr.Field[strings.LastIndex(typeErr.Field, ".")+1:]
+		return RequestBodyError{
+			Status:  http.StatusUnprocessableEntity,
+			Message: fmt.Sprintf("%s must be %s, not %s", field, describeJSONType(typeErr.Type.Kind()), typeErr.Value),
+		}
+	}
+
+	// encoding/json has no type for this one, and stops at the first it finds
+	if strings.HasPrefix(err.Error(), "json: unknown field ") {
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		return RequestBodyError{Status: http.StatusUnprocessableEntity, Message: fmt.Sprintf("unknown field '%s'", field)}
+	}
+
+	return RequestBodyError{Status: http.StatusBadRequest, Message: "request body is not valid JSON"}
+}
+
+func describeJSONType(kind reflect.Kind) string {
+	switch kind {
+	case reflect.Slice, reflect.Array:
+		return "a list"
+	case reflect.Struct, reflect.Map:
+		return "an object"
+	case reflect.String:
+		return "a string"
+	case reflect.Bool:
+		return "a boolean"
+	default:
+		return "a number"
+	}
+}
@@ -0,0 +1,76 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+func NewMergePatchPhraseParamReader() UpdatePhraseParamReader {
+	return mergePatchPhraseParamReader{}
+}
+
+// mergePatchPhraseParamReader reads a JSON Merge Patch (RFC 7396):
+// members that are absent are left alone, and members set to null
+// are cleared
+type mergePatchPhraseParamReader struct{}
+
+func (reader mergePatchPhraseParamReader) ReadParamsFromRequest(request *http.Request) (updatePhraseParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return updatePhraseParams{}, errors.New(`{"err": "You done goofed; I'm pretty sure you didn't authenticate"}`)
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return updatePhraseParams{}, err
+	}
+
+	if contentType := request.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || (mediaType != "application/merge-patch+json" && mediaType != "application/json") {
+			return updatePhraseParams{}, errors.New(`{"err": "expected a body of type application/merge-patch+json"}`)
+		}
+	}
+
+	// raw messages, to tell a member set to null from one left out
+	requestObj := struct {
+		Content     json.RawMessage `json:"content"`
+		Translation json.RawMessage `json:"translation"`
+	}{}
+	err = decodeJSONBody(request, &requestObj)
+	if err != nil {
+		return updatePhraseParams{}, err
+	}
+
+	params := updatePhraseParams{UserUUID: userUuid}
+	if params.Content, err = readMergePatchString(requestObj.Content, "content"); err != nil {
+		return updatePhraseParams{}, err
+	}
+	if params.Translation, err = readMergePatchString(requestObj.Translation, "translation"); err != nil {
+		return updatePhraseParams{}, err
+	}
+
+	return params, nil
+}
+
+func readMergePatchString(raw json.RawMessage, key string) (*string, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	value := ""
+	if string(raw) == "null" {
+		return &value, nil
+	}
+
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf(`{"err": "%s must be a string or null"}`, key)
+	}
+
+	return &value, nil
+}
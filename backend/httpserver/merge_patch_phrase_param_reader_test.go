@@ -0,0 +1,100 @@
+package httpserver_test
+
+import (
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("MergePatchPhraseParamReader", func() {
+	var (
+		subject     UpdatePhraseParamReader
+		body        string
+		resultErr   error
+		content     *string
+		translation *string
+	)
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("PATCH", "http://example.com/api/phrases/french/the-uuid", strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("X-User-Token", userUUID.String())
+		request.Header.Set("Content-Type", "application/merge-patch+json")
+
+		subject = NewMergePatchPhraseParamReader()
+		result, err := subject.ReadParamsFromRequest(request)
+		resultErr = err
+		content, translation = result.Content, result.Translation
+	})
+
+	Context("when a member is absent", func() {
+		BeforeEach(func() {
+			body = `{"translation": "the cat"}`
+		})
+
+		It("leaves it out of the update", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(content).To(BeNil())
+			Expect(*translation).To(Equal("the cat"))
+		})
+	})
+
+	Context("when a member is null", func() {
+		BeforeEach(func() {
+			body = `{"content": "le chat", "translation": null}`
+		})
+
+		It("clears it", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(*content).To(Equal("le chat"))
+			Expect(*translation).To(BeEmpty())
+		})
+	})
+
+	Context("when a member is not a string", func() {
+		BeforeEach(func() {
+			body = `{"translation": 42}`
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when a member is an object", func() {
+		BeforeEach(func() {
+			body = `{"translation": {"text": "the cat"}}`
+		})
+
+		It("says it must be a string rather than complaining about what is inside it", func() {
+			Expect(resultErr).NotTo(MatchError("unknown field 'text'"))
+			Expect(resultErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when there is a member we don't know about", func() {
+		BeforeEach(func() {
+			body = `{"content": "le chat", "notes": "a pet"}`
+		})
+
+		It("rejects it", func() {
+			Expect(resultErr).To(Equal(RequestBodyError{
+				Status:  http.StatusUnprocessableEntity,
+				Message: "unknown field 'notes'",
+			}))
+		})
+	})
+
+	Context("when the body is not a JSON object", func() {
+		BeforeEach(func() {
+			body = `["le chat"]`
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+type MergePhrasesHandler interface {
+	http.Handler
+}
+
+func NewMergePhrasesHandler(
+	useCase usecases.MergePhrasesUseCase,
+	paramReader MergePhrasesParamReader,
+) http.Handler {
+	return mergePhrasesHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type mergePhrasesHandler struct {
+	useCase     usecases.MergePhrasesUseCase
+	paramReader MergePhrasesParamReader
+}
+
+func (handler mergePhrasesHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "MergePhrasesParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	phrase, err := handler.useCase.Execute(request.Context(), usecases.MergePhrasesRequest{
+		UserUUID:   params.UserUUID,
+		Into:       params.Into,
+		Duplicates: params.Duplicates,
+	})
+	switch err {
+	case nil:
+	case api.ErrPhraseNotFound:
+		writeError(writer, err, http.StatusNotFound)
+		return
+	case api.ErrPhrasesNotDuplicates:
+		writeError(writer, err, http.StatusUnprocessableEntity)
+		return
+	default:
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	responseBody, err := json.Marshal(phrase)
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writer.Write([]byte(responseBody))
+}
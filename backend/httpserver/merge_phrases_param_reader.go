@@ -0,0 +1,68 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+//go:generate counterfeiter . MergePhrasesParamReader
+type MergePhrasesParamReader interface {
+	ReadParamsFromRequest(*http.Request) (MergePhrasesParams, error)
+}
+
+type MergePhrasesParams struct {
+	UserUUID   uuid.UUID
+	Into       uuid.UUID
+	Duplicates []uuid.UUID
+}
+
+func NewMergePhrasesParamReader() MergePhrasesParamReader {
+	return mergePhrasesParamReader{}
+}
+
+type mergePhrasesParamReader struct{}
+
+func (paramReader mergePhrasesParamReader) ReadParamsFromRequest(
+	request *http.Request,
+) (MergePhrasesParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return MergePhrasesParams{}, errors.New("you done goofed; I'm pretty sure you didn't authenticate")
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return MergePhrasesParams{}, err
+	}
+
+	requestObj := struct {
+		Into       string   `json:"into"`
+		Duplicates []string `json:"duplicates"`
+	}{}
+	err = decodeJSONBody(request, &requestObj)
+	if err != nil {
+		return MergePhrasesParams{}, err
+	}
+
+	into, err := uuid.Parse(requestObj.Into)
+	if err != nil {
+		return MergePhrasesParams{}, errors.New("could not read the phrase to merge into from request body")
+	}
+
+	duplicates := []uuid.UUID{}
+	for _, d := range requestObj.Duplicates {
+		duplicate, err := uuid.Parse(d)
+		if err != nil {
+			return MergePhrasesParams{}, errors.New("could not read the duplicate phrases from request body")
+		}
+		duplicates = append(duplicates, duplicate)
+	}
+
+	return MergePhrasesParams{
+		UserUUID:   userUuid,
+		Into:       into,
+		Duplicates: duplicates,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+//go:generate counterfeiter . PhraseExamplesParamReader
+type PhraseExamplesParamReader interface {
+	ReadParamsFromRequest(*http.Request) (PhraseExamplesParams, error)
+}
+
+// PhraseExamplesParams.Examples is only read from the body of a PUT
+type PhraseExamplesParams struct {
+	UserUUID   uuid.UUID
+	PhraseUUID uuid.UUID
+	Examples   []string
+}
+
+func NewPhraseExamplesParamReader() PhraseExamplesParamReader {
+	return phraseExamplesParamReader{}
+}
+
+type phraseExamplesParamReader struct{}
+
+func (paramReader phraseExamplesParamReader) ReadParamsFromRequest(
+	request *http.Request,
+) (PhraseExamplesParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return PhraseExamplesParams{}, errors.New("you done goofed; I'm pretty sure you didn't authenticate")
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return PhraseExamplesParams{}, err
+	}
+
+	phraseUuid, err := uuid.Parse(mux.Vars(request)["uuid"])
+	if err != nil {
+		return PhraseExamplesParams{}, errors.New("invalid phrase uuid")
+	}
+
+	params := PhraseExamplesParams{
+		UserUUID:   userUuid,
+		PhraseUUID: phraseUuid,
+	}
+	if request.Method == http.MethodPut {
+		requestObj := struct {
+			Examples []string `json:"examples"`
+		}{}
+		err = decodeJSONBody(request, &requestObj)
+		if err != nil {
+			return PhraseExamplesParams{}, err
+		}
+		if requestObj.Examples == nil {
+			return PhraseExamplesParams{}, RequestBodyError{
+				Status:  http.StatusUnprocessableEntity,
+				Message: "examples must be a list, which can be empty",
+			}
+		}
+		params.Examples = requestObj.Examples
+	}
+
+	return params, nil
+}
@@ -0,0 +1,51 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewPhraseHistoryHandler lists every revision of a phrase, newest first
+func NewPhraseHistoryHandler(
+	useCase usecases.PhraseHistoryUseCase,
+	paramReader PhraseRevisionParamReader,
+) http.Handler {
+	return phraseHistoryHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type phraseHistoryHandler struct {
+	useCase     usecases.PhraseHistoryUseCase
+	paramReader PhraseRevisionParamReader
+}
+
+func (handler phraseHistoryHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "PhraseRevisionParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	revisions, err := handler.useCase.Execute(request.Context(), usecases.PhraseHistoryRequest{
+		UUID:     params.PhraseUUID,
+		UserUUID: params.UserUUID,
+	})
+	if err == api.ErrPhraseNotFound {
+		writeError(writer, err, http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, revisions)
+}
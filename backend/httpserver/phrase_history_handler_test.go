@@ -0,0 +1,138 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("Phrase history", func() {
+	var paramReader *httpserverfakes.FakePhraseRevisionParamReader
+	var writer *httptest.ResponseRecorder
+
+	phraseUUID := uuid.Must(uuid.Parse("a7f3a3b8-5f6a-4ab1-8c4b-7cd1c5c1e4a1"))
+
+	BeforeEach(func() {
+		paramReader = new(httpserverfakes.FakePhraseRevisionParamReader)
+		paramReader.ReadParamsFromRequestReturns(PhraseRevisionParams{
+			UserUUID:   userUUID,
+			PhraseUUID: phraseUUID,
+			Revision:   1,
+		}, nil)
+		writer = httptest.NewRecorder()
+	})
+
+	Describe("PhraseHistoryHandler", func() {
+		var useCase *usecasesfakes.FakePhraseHistoryUseCase
+
+		BeforeEach(func() {
+			useCase = new(usecasesfakes.FakePhraseHistoryUseCase)
+			useCase.ExecuteReturns([]usecases.PhraseRevisionResponse{
+				{
+					Revision:     2,
+					Content:      "le chat",
+					Translation:  "the cat",
+					CreatedAt:    time.Date(2018, 3, 2, 9, 0, 0, 0, time.UTC),
+					RevertedFrom: 1,
+					Device:       "Firefox",
+					RequestID:    "def456",
+				},
+				{
+					Revision:    1,
+					Content:     "le chat",
+					Translation: "the dog",
+					CreatedAt:   time.Date(2018, 3, 1, 9, 0, 0, 0, time.UTC),
+					Device:      "my phone",
+					RequestID:   "abc123",
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", "/api/phrases/french/"+phraseUUID.String()+"/history", nil)
+			Expect(err).NotTo(HaveOccurred())
+			NewPhraseHistoryHandler(useCase, paramReader).ServeHTTP(writer, request)
+		})
+
+		It("lists the revisions of the user's phrase", func() {
+			_, request := useCase.ExecuteArgsForCall(0)
+			Expect(request).To(Equal(usecases.PhraseHistoryRequest{UUID: phraseUUID, UserUUID: userUUID}))
+
+			Expect(writer.Code).To(Equal(http.StatusOK))
+			Expect(writer.Body.String()).To(MatchJSON(`[
+				{"revision": 2, "content": "le chat", "translation": "the cat", "createdAt": "2018-03-02T09:00:00Z", "revertedFrom": 1, "device": "Firefox", "requestId": "def456"},
+				{"revision": 1, "content": "le chat", "translation": "the dog", "createdAt": "2018-03-01T09:00:00Z", "device": "my phone", "requestId": "abc123"}
+			]`))
+		})
+
+		Context("when the user has no such phrase", func() {
+			BeforeEach(func() {
+				useCase.ExecuteReturns(nil, api.ErrPhraseNotFound)
+			})
+
+			It("is not found", func() {
+				Expect(writer.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the params cannot be read", func() {
+			BeforeEach(func() {
+				paramReader.ReadParamsFromRequestReturns(PhraseRevisionParams{}, errors.New("invalid phrase uuid"))
+			})
+
+			It("is a bad request", func() {
+				Expect(writer.Code).To(Equal(http.StatusBadRequest))
+				Expect(useCase.ExecuteCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("RevertPhraseHandler", func() {
+		var useCase *usecasesfakes.FakeRevertPhraseUseCase
+
+		BeforeEach(func() {
+			useCase = new(usecasesfakes.FakeRevertPhraseUseCase)
+			useCase.ExecuteReturns(usecases.PhraseResponse{
+				Uuid:        phraseUUID.String(),
+				Content:     "le chat",
+				Translation: "the dog",
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", "/api/phrases/french/"+phraseUUID.String()+"/revert/1", nil)
+			Expect(err).NotTo(HaveOccurred())
+			NewRevertPhraseHandler(useCase, paramReader).ServeHTTP(writer, request)
+		})
+
+		It("reverts the user's phrase and returns it", func() {
+			_, request := useCase.ExecuteArgsForCall(0)
+			Expect(request).To(Equal(usecases.RevertPhraseRequest{UUID: phraseUUID, Revision: 1, UserUUID: userUUID}))
+
+			Expect(writer.Code).To(Equal(http.StatusOK))
+			Expect(writer.Body.String()).To(MatchJSON(`{"uuid": "a7f3a3b8-5f6a-4ab1-8c4b-7cd1c5c1e4a1", "content": "le chat", "translation": "the dog"}`))
+		})
+
+		Context("when there is no such revision", func() {
+			BeforeEach(func() {
+				useCase.ExecuteReturns(usecases.PhraseResponse{}, api.ErrRevisionNotFound)
+			})
+
+			It("is not found", func() {
+				Expect(writer.Code).To(Equal(http.StatusNotFound))
+				Expect(writer.Body.String()).To(Equal(`{"error":"revision not found"}`))
+			})
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+//go:generate counterfeiter . PhraseRevisionParamReader
+type PhraseRevisionParamReader interface {
+	ReadParamsFromRequest(*http.Request) (PhraseRevisionParams, error)
+}
+
+// PhraseRevisionParams is read from a phrase's history routes.
+// Revision is 0 for the history itself, which has no {revision}
+type PhraseRevisionParams struct {
+	UserUUID   uuid.UUID
+	PhraseUUID uuid.UUID
+	Revision   uint
+}
+
+func NewPhraseRevisionParamReader() PhraseRevisionParamReader {
+	return phraseRevisionParamReader{}
+}
+
+type phraseRevisionParamReader struct{}
+
+func (paramReader phraseRevisionParamReader) ReadParamsFromRequest(
+	request *http.Request,
+) (PhraseRevisionParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return PhraseRevisionParams{}, errors.New("you done goofed; I'm pretty sure you didn't authenticate")
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return PhraseRevisionParams{}, err
+	}
+
+	requestVars := mux.Vars(request)
+	phraseUuid, err := uuid.Parse(requestVars["uuid"])
+	if err != nil {
+		return PhraseRevisionParams{}, errors.New("invalid phrase uuid")
+	}
+
+	params := PhraseRevisionParams{
+		UserUUID:   userUuid,
+		PhraseUUID: phraseUuid,
+	}
+	if revision, ok := requestVars["revision"]; ok {
+		number, err := strconv.ParseUint(revision, 10, 32)
+		if err != nil || number == 0 {
+			return PhraseRevisionParams{}, errors.New("revision must be a positive number")
+		}
+		params.Revision = uint(number)
+	}
+
+	return params, nil
+}
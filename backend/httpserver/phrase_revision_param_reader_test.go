@@ -0,0 +1,84 @@
+package httpserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gorilla/mux"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("PhraseRevisionParamReader", func() {
+	var (
+		result    PhraseRevisionParams
+		resultErr error
+		path      string
+	)
+
+	phraseUUID := "a7f3a3b8-5f6a-4ab1-8c4b-7cd1c5c1e4a1"
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("POST", path, nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("X-User-Token", userUUID.String())
+
+		// mux only fills in the path variables for routes it matched
+		router := mux.NewRouter()
+		read := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			result, resultErr = NewPhraseRevisionParamReader().ReadParamsFromRequest(r)
+		})
+		router.Handle("/api/phrases/french/{uuid}/history", read)
+		router.Handle("/api/phrases/french/{uuid}/revert/{revision}", read)
+		router.ServeHTTP(httptest.NewRecorder(), request)
+	})
+
+	Describe("reading the history", func() {
+		BeforeEach(func() {
+			path = "/api/phrases/french/" + phraseUUID + "/history"
+		})
+
+		It("has no revision", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result.UserUUID).To(Equal(userUUID))
+			Expect(result.PhraseUUID.String()).To(Equal(phraseUUID))
+			Expect(result.Revision).To(Equal(uint(0)))
+		})
+	})
+
+	Describe("reverting", func() {
+		BeforeEach(func() {
+			path = "/api/phrases/french/" + phraseUUID + "/revert/3"
+		})
+
+		It("reads the revision", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result.Revision).To(Equal(uint(3)))
+		})
+	})
+
+	for _, bad := range []string{"0", "-1", "three"} {
+		bad := bad
+		Describe("reverting to revision "+bad, func() {
+			BeforeEach(func() {
+				path = "/api/phrases/french/" + phraseUUID + "/revert/" + bad
+			})
+
+			It("returns an error", func() {
+				Expect(resultErr).To(MatchError("revision must be a positive number"))
+			})
+		})
+	}
+
+	Describe("when the phrase uuid is invalid", func() {
+		BeforeEach(func() {
+			path = "/api/phrases/french/not-a-uuid/history"
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(MatchError("invalid phrase uuid"))
+		})
+	})
+})
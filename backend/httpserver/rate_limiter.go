@@ -0,0 +1,109 @@
+package httpserver
+
+import (
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/ratelimit"
+)
+
+var errTooManyRequests = errors.New("too many requests, please slow down")
+
+type RateLimiter struct {
+	store            ratelimit.Store
+	rules            ratelimit.Rules
+	trustedProxyHops int
+}
+
+// NewRateLimiter throttles each user (by X-User-Token) and each client IP.
+// trustedProxyHops is how many proxies in front of us append to
+// X-Forwarded-For; with none, the client is whoever connected to us
+func NewRateLimiter(store ratelimit.Store, rules ratelimit.Rules, trustedProxyHops int) *RateLimiter {
+	return &RateLimiter{
+		store:            store,
+		rules:            rules,
+		trustedProxyHops: trustedProxyHops,
+	}
+}
+
+// Limit applies the rule for a route template to a handler.
+// Every route has its own buckets, so a client retrying a sync
+// doesn't also lock itself out of reading its phrases
+func (limiter *RateLimiter) Limit(template string, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		route := request.Method + " " + template
+		rule := limiter.rules.For(request.Method, template)
+
+		results := []ratelimit.Result{}
+		if userUuid, err := uuid.Parse(request.Header.Get("X-User-Token")); err == nil {
+			results = append(results, limiter.take("user:"+userUuid.String()+":"+route, rule.PerUser))
+		}
+		results = append(results, limiter.take("ip:"+limiter.clientIP(request)+":"+route, rule.PerIP))
+
+		// report whichever limit is closest to running out
+		var tightest *ratelimit.Result
+		for i := range results {
+			if results[i].Limit == 0 {
+				continue
+			}
+			if tightest == nil || !results[i].Allowed || (tightest.Allowed && results[i].Remaining < tightest.Remaining) {
+				tightest = &results[i]
+			}
+		}
+
+		if tightest != nil {
+			writer.Header().Set("RateLimit-Limit", strconv.Itoa(tightest.Limit))
+			writer.Header().Set("RateLimit-Remaining", strconv.Itoa(tightest.Remaining))
+			writer.Header().Set("RateLimit-Reset", seconds(tightest.Reset))
+
+			if !tightest.Allowed {
+				writer.Header().Set("Retry-After", seconds(tightest.RetryAfter))
+				writeError(writer, errTooManyRequests, http.StatusTooManyRequests)
+				return
+			}
+		}
+
+		handler.ServeHTTP(writer, request)
+	})
+}
+
+// take lets requests through when the store is unavailable,
+// we'd rather be hammered than turn everyone away
+func (limiter *RateLimiter) take(key string, limit ratelimit.Limit) ratelimit.Result {
+	result, err := limiter.store.Take(key, limit)
+	if err != nil {
+		return ratelimit.Result{Allowed: true}
+	}
+
+	return result
+}
+
+func (limiter *RateLimiter) clientIP(request *http.Request) string {
+	if limiter.trustedProxyHops > 0 {
+		forwarded := strings.Split(request.Header.Get("X-Forwarded-For"), ",")
+		// each proxy appends whoever connected to it, so the entries
+		// before the ones our proxies added could have been made up
+		if index := len(forwarded) - limiter.trustedProxyHops; index >= 0 {
+			if ip := strings.TrimSpace(forwarded[index]); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		return request.RemoteAddr
+	}
+
+	return host
+}
+
+func seconds(duration time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(duration.Seconds())))
+}
@@ -0,0 +1,143 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/ratelimit"
+	"github.com/tjarratt/doit-etre-rad/backend/ratelimit/ratelimitfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("RateLimiter", func() {
+	var (
+		store            *ratelimitfakes.FakeStore
+		trustedProxyHops int
+		served           bool
+		writer           *httptest.ResponseRecorder
+		request          *http.Request
+	)
+
+	rules := ratelimit.Rules{
+		Default: ratelimit.Rule{
+			PerUser: ratelimit.Limit{Requests: 100, Per: time.Minute},
+			PerIP:   ratelimit.Limit{Requests: 1000, Per: time.Minute},
+		},
+		Routes: map[string]ratelimit.Rule{
+			"POST /api/phrases/french": {
+				PerUser: ratelimit.Limit{Requests: 10, Per: time.Minute},
+				PerIP:   ratelimit.Limit{Requests: 50, Per: time.Minute},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		store = new(ratelimitfakes.FakeStore)
+		store.TakeStub = func(key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+			return ratelimit.Result{Allowed: true, Limit: limit.Requests, Remaining: limit.Requests - 1, Reset: time.Second}, nil
+		}
+		trustedProxyHops = 0
+		served = false
+		writer = httptest.NewRecorder()
+
+		var err error
+		request, err = http.NewRequest("POST", "/api/phrases/french", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.RemoteAddr = "10.0.0.1:54321"
+		request.Header.Set("X-User-Token", userUUID.String())
+	})
+
+	JustBeforeEach(func() {
+		limiter := NewRateLimiter(store, rules, trustedProxyHops)
+		subject := limiter.Limit("/api/phrases/french", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served = true
+		}))
+		subject.ServeHTTP(writer, request)
+	})
+
+	It("takes from a bucket for the user and one for their IP, using the route's rule", func() {
+		Expect(store.TakeCallCount()).To(Equal(2))
+
+		key, limit := store.TakeArgsForCall(0)
+		Expect(key).To(Equal("user:" + userUUID.String() + ":POST /api/phrases/french"))
+		Expect(limit).To(Equal(ratelimit.Limit{Requests: 10, Per: time.Minute}))
+
+		key, limit = store.TakeArgsForCall(1)
+		Expect(key).To(Equal("ip:10.0.0.1:POST /api/phrases/french"))
+		Expect(limit).To(Equal(ratelimit.Limit{Requests: 50, Per: time.Minute}))
+	})
+
+	It("serves the request and reports the tightest limit", func() {
+		Expect(served).To(BeTrue())
+		Expect(writer.Header().Get("RateLimit-Limit")).To(Equal("10"))
+		Expect(writer.Header().Get("RateLimit-Remaining")).To(Equal("9"))
+		Expect(writer.Header().Get("RateLimit-Reset")).To(Equal("1"))
+		Expect(writer.Header().Get("Retry-After")).To(BeEmpty())
+	})
+
+	Context("when a bucket is empty", func() {
+		BeforeEach(func() {
+			store.TakeReturnsOnCall(1, ratelimit.Result{
+				Allowed:    false,
+				Limit:      50,
+				Remaining:  0,
+				RetryAfter: 1200 * time.Millisecond,
+				Reset:      time.Minute,
+			}, nil)
+		})
+
+		It("refuses the request with a 429", func() {
+			Expect(served).To(BeFalse())
+			Expect(writer.Code).To(Equal(http.StatusTooManyRequests))
+			Expect(writer.Body.String()).To(Equal(`{"error":"too many requests, please slow down"}`))
+		})
+
+		It("says when to try again", func() {
+			Expect(writer.Header().Get("Retry-After")).To(Equal("2"))
+			Expect(writer.Header().Get("RateLimit-Limit")).To(Equal("50"))
+			Expect(writer.Header().Get("RateLimit-Remaining")).To(Equal("0"))
+			Expect(writer.Header().Get("RateLimit-Reset")).To(Equal("60"))
+		})
+	})
+
+	Context("when the request has no user token", func() {
+		BeforeEach(func() {
+			request.Header.Del("X-User-Token")
+		})
+
+		It("only limits by IP", func() {
+			Expect(store.TakeCallCount()).To(Equal(1))
+			key, _ := store.TakeArgsForCall(0)
+			Expect(key).To(Equal("ip:10.0.0.1:POST /api/phrases/french"))
+		})
+	})
+
+	Context("when we are behind a trusted proxy", func() {
+		BeforeEach(func() {
+			trustedProxyHops = 1
+			request.Header.Set("X-Forwarded-For", "1.2.3.4, 192.168.1.20")
+		})
+
+		It("limits the client the proxy saw, not what the client claimed", func() {
+			key, _ := store.TakeArgsForCall(1)
+			Expect(key).To(Equal("ip:192.168.1.20:POST /api/phrases/french"))
+		})
+	})
+
+	Context("when the store is unavailable", func() {
+		BeforeEach(func() {
+			store.TakeStub = nil
+			store.TakeReturns(ratelimit.Result{}, errors.New("connection refused"))
+		})
+
+		It("lets the request through", func() {
+			Expect(served).To(BeTrue())
+			Expect(writer.Header().Get("RateLimit-Limit")).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewRecordPracticeSessionHandler is where clients report a practice
+// session once it is over, and answers 204 No Content
+func NewRecordPracticeSessionHandler(
+	useCase usecases.RecordPracticeSessionUseCase,
+	paramReader RecordPracticeSessionParamReader,
+) http.Handler {
+	return recordPracticeSessionHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type recordPracticeSessionHandler struct {
+	useCase     usecases.RecordPracticeSessionUseCase
+	paramReader RecordPracticeSessionParamReader
+}
+
+func (handler recordPracticeSessionHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "RecordPracticeSessionParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	err = handler.useCase.Execute(request.Context(), usecases.RecordPracticeSessionRequest(params))
+	if validationErr, ok := err.(usecases.ValidationError); ok {
+		writeValidationError(writer, validationErr)
+		return
+	}
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writer.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,84 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+//go:generate counterfeiter . RecordPracticeSessionParamReader
+type RecordPracticeSessionParamReader interface {
+	ReadParamsFromRequest(*http.Request) (RecordPracticeSessionParams, error)
+}
+
+type RecordPracticeSessionParams struct {
+	UserUUID       uuid.UUID
+	StartedAt      time.Time
+	FinishedAt     time.Time
+	CardsReviewed  uint
+	CorrectAnswers uint
+}
+
+func NewRecordPracticeSessionParamReader() RecordPracticeSessionParamReader {
+	return recordPracticeSessionParamReader{}
+}
+
+type recordPracticeSessionParamReader struct{}
+
+func (paramReader recordPracticeSessionParamReader) ReadParamsFromRequest(
+	request *http.Request,
+) (RecordPracticeSessionParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return RecordPracticeSessionParams{}, errors.New("you done goofed; I'm pretty sure you didn't authenticate")
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return RecordPracticeSessionParams{}, err
+	}
+
+	requestObj := struct {
+		StartedAt      string `json:"startedAt"`
+		FinishedAt     string `json:"finishedAt"`
+		CardsReviewed  uint   `json:"cardsReviewed"`
+		CorrectAnswers uint   `json:"correctAnswers"`
+	}{}
+	err = decodeJSONBody(request, &requestObj)
+	if err != nil {
+		return RecordPracticeSessionParams{}, err
+	}
+
+	startedAt, err := parseSessionTime("startedAt", requestObj.StartedAt)
+	if err != nil {
+		return RecordPracticeSessionParams{}, err
+	}
+	finishedAt, err := parseSessionTime("finishedAt", requestObj.FinishedAt)
+	if err != nil {
+		return RecordPracticeSessionParams{}, err
+	}
+
+	return RecordPracticeSessionParams{
+		UserUUID:       userUuid,
+		StartedAt:      startedAt,
+		FinishedAt:     finishedAt,
+		CardsReviewed:  requestObj.CardsReviewed,
+		CorrectAnswers: requestObj.CorrectAnswers,
+	}, nil
+}
+
+// times are RFC3339, the way JavaScript's toISOString writes them
+func parseSessionTime(field string, value string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, RequestBodyError{
+			Status:  http.StatusUnprocessableEntity,
+			Message: fmt.Sprintf("%s must be a time like 2006-01-02T15:04:05Z", field),
+		}
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,53 @@
+package httpserver_test
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("RecordPracticeSessionParamReader", func() {
+	var (
+		body      string
+		result    RecordPracticeSessionParams
+		resultErr error
+	)
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("POST", "/api/phrases/french/practice", strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("X-User-Token", userUUID.String())
+
+		result, resultErr = NewRecordPracticeSessionParamReader().ReadParamsFromRequest(request)
+	})
+
+	Describe("a finished session", func() {
+		BeforeEach(func() {
+			body = `{"startedAt": "2018-03-31T18:30:00.000Z", "finishedAt": "2018-03-31T20:35:00+02:00", "cardsReviewed": 20, "correctAnswers": 17}`
+		})
+
+		It("reads it", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result.UserUUID).To(Equal(userUUID))
+			Expect(result.StartedAt.Equal(time.Date(2018, 3, 31, 18, 30, 0, 0, time.UTC))).To(BeTrue())
+			Expect(result.FinishedAt.Equal(time.Date(2018, 3, 31, 18, 35, 0, 0, time.UTC))).To(BeTrue())
+			Expect(result.CardsReviewed).To(Equal(uint(20)))
+			Expect(result.CorrectAnswers).To(Equal(uint(17)))
+		})
+	})
+
+	Describe("without a finishedAt", func() {
+		BeforeEach(func() {
+			body = `{"startedAt": "2018-03-31T18:30:00Z", "cardsReviewed": 20, "correctAnswers": 17}`
+		})
+
+		It("says what is wrong", func() {
+			Expect(resultErr).To(MatchError("finishedAt must be a time like 2006-01-02T15:04:05Z"))
+			Expect(resultErr.(RequestBodyError).Status).To(Equal(http.StatusUnprocessableEntity))
+		})
+	})
+})
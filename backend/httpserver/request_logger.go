@@ -0,0 +1,167 @@
+package httpserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+type contextKey string
+
+const requestInfoKey contextKey = "request-info"
+
+// requests ids are echoed back into headers and error bodies,
+// so only accept ones that can't break either of those
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,128}$`)
+
+type requestInfo struct {
+	requestID string
+	route     string
+	traceID   string
+}
+
+type logEntry struct {
+	Time      string  `json:"time"`
+	Level     string  `json:"level"`
+	Message   string  `json:"msg"`
+	Method    string  `json:"method"`
+	Route     string  `json:"route"`
+	Path      string  `json:"path,omitempty"`
+	Status    int     `json:"status"`
+	LatencyMs float64 `json:"latencyMs"`
+	UserID    string  `json:"userId,omitempty"`
+	RequestID string  `json:"requestId"`
+	TraceID   string  `json:"traceId,omitempty"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// NewRequestLogger assigns every request an id (or keeps the one the client
+// sent in X-Request-ID), makes it available through the request context,
+// and writes one JSON line per request once it has been served
+func NewRequestLogger(handler http.Handler, out io.Writer) http.Handler {
+	return &requestLogger{handler: handler, out: out}
+}
+
+type requestLogger struct {
+	handler http.Handler
+	out     io.Writer
+	mutex   sync.Mutex
+}
+
+func (logger *requestLogger) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	started := time.Now()
+
+	requestID := request.Header.Get(RequestIDHeader)
+	if !validRequestID.MatchString(requestID) {
+		requestID = uuid.New().String()
+	}
+
+	info := &requestInfo{requestID: requestID, route: "unknown"}
+	request = request.WithContext(context.WithValue(request.Context(), requestInfoKey, info))
+
+	writer.Header().Set(RequestIDHeader, requestID)
+	recorder := &statusRecordingWriter{ResponseWriter: writer, status: http.StatusOK}
+	logger.handler.ServeHTTP(recorder, request)
+
+	entry := logEntry{
+		Time:      started.UTC().Format(time.RFC3339Nano),
+		Level:     "info",
+		Message:   "request",
+		Method:    request.Method,
+		Route:     info.route,
+		Status:    recorder.status,
+		LatencyMs: float64(time.Since(started)) / float64(time.Millisecond),
+		RequestID: requestID,
+		TraceID:   info.traceID,
+		Error:     recorder.err,
+	}
+	// the path is only logged when no route matched, because the admin
+	// routes have users' uuids in them, and they are what users log in with
+	if info.route == "unknown" {
+		entry.Path = request.URL.Path
+	}
+	if userUuid, err := uuid.Parse(request.Header.Get("X-User-Token")); err == nil {
+		entry.UserID = loggedUserID(userUuid)
+	}
+	if recorder.status >= http.StatusInternalServerError {
+		entry.Level = "error"
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	logger.mutex.Lock()
+	defer logger.mutex.Unlock()
+	logger.out.Write(append(line, '\n'))
+}
+
+// loggedUserID is what the logs know a user by. Their uuid is the token
+// they authenticate with, so it can't go in the logs, but a hash of it
+// still lets us follow one user's requests and look them up when we need to
+func loggedUserID(userUuid uuid.UUID) string {
+	sum := sha256.Sum256([]byte(userUuid.String()))
+	return hex.EncodeToString(sum[:8])
+}
+
+// WithRouteTemplate tells the request logger which route served the request,
+// so that /api/phrases/french/{uuid} is logged as one route, not one per phrase
+func WithRouteTemplate(template string, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if info, ok := request.Context().Value(requestInfoKey).(*requestInfo); ok {
+			info.route = template
+		}
+		handler.ServeHTTP(writer, request)
+	})
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		return info.requestID
+	}
+
+	return ""
+}
+
+type statusRecordingWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	err         string
+}
+
+func (writer *statusRecordingWriter) WriteHeader(status int) {
+	if !writer.wroteHeader {
+		writer.status = status
+		writer.wroteHeader = true
+	}
+	writer.ResponseWriter.WriteHeader(status)
+}
+
+func (writer *statusRecordingWriter) Write(bytes []byte) (int, error) {
+	writer.wroteHeader = true
+	return writer.ResponseWriter.Write(bytes)
+}
+
+// recordError is how writeError gets the error message into the request log
+func recordError(writer http.ResponseWriter, err error) {
+	for {
+		recorder, ok := writer.(*statusRecordingWriter)
+		if !ok {
+			return
+		}
+		recorder.err = err.Error()
+		writer = recorder.ResponseWriter
+	}
+}
@@ -0,0 +1,136 @@
+package httpserver_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("RequestLogger", func() {
+	var (
+		subject  http.Handler
+		inner    http.Handler
+		out      *bytes.Buffer
+		writer   *httptest.ResponseRecorder
+		request  *http.Request
+		loggedID string
+	)
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		writer = httptest.NewRecorder()
+		loggedID = ""
+
+		inner = WithRouteTemplate("/api/phrases/french/{uuid}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			loggedID = RequestIDFromContext(r.Context())
+			w.WriteHeader(http.StatusTeapot)
+		}))
+
+		var err error
+		request, err = http.NewRequest("PUT", "http://example.com/api/phrases/french/the-uuid", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("X-User-Token", userUUID.String())
+	})
+
+	JustBeforeEach(func() {
+		subject = NewRequestLogger(inner, out)
+		subject.ServeHTTP(writer, request)
+	})
+
+	readLog := func() map[string]interface{} {
+		entry := map[string]interface{}{}
+		Expect(json.Unmarshal(out.Bytes(), &entry)).To(Succeed())
+		return entry
+	}
+
+	It("logs the request as a single line of JSON", func() {
+		Expect(bytes.Count(out.Bytes(), []byte("\n"))).To(Equal(1))
+
+		entry := readLog()
+		Expect(entry["method"]).To(Equal("PUT"))
+		Expect(entry["route"]).To(Equal("/api/phrases/french/{uuid}"))
+		Expect(entry["status"]).To(BeNumerically("==", http.StatusTeapot))
+		Expect(entry["userId"]).To(HaveLen(16))
+		Expect(entry).To(HaveKey("latencyMs"))
+	})
+
+	It("never logs the token the user authenticated with", func() {
+		Expect(out.String()).NotTo(ContainSubstring(userUUID.String()))
+		Expect(readLog()).NotTo(HaveKey("path"))
+	})
+
+	It("knows the same user by the same id from one request to the next", func() {
+		first := readLog()["userId"]
+
+		out.Reset()
+		subject.ServeHTTP(httptest.NewRecorder(), request)
+		Expect(readLog()["userId"]).To(Equal(first))
+	})
+
+	Context("when no route matches the request", func() {
+		BeforeEach(func() {
+			inner = http.NotFoundHandler()
+		})
+
+		It("logs the path instead", func() {
+			entry := readLog()
+			Expect(entry["route"]).To(Equal("unknown"))
+			Expect(entry["path"]).To(Equal("/api/phrases/french/the-uuid"))
+		})
+	})
+
+	It("generates a request id and shares it with the handler and the client", func() {
+		requestID := writer.Header().Get("X-Request-ID")
+		Expect(requestID).NotTo(BeEmpty())
+		Expect(loggedID).To(Equal(requestID))
+		Expect(readLog()["requestId"]).To(Equal(requestID))
+	})
+
+	Context("when the client provides a request id", func() {
+		BeforeEach(func() {
+			request.Header.Set("X-Request-ID", "from-the-client-123")
+		})
+
+		It("keeps it", func() {
+			Expect(writer.Header().Get("X-Request-ID")).To(Equal("from-the-client-123"))
+			Expect(loggedID).To(Equal("from-the-client-123"))
+		})
+	})
+
+	Context("when the client provides a request id we can't safely echo", func() {
+		BeforeEach(func() {
+			request.Header.Set("X-Request-ID", `"}, {"oops`)
+		})
+
+		It("replaces it with one of our own", func() {
+			Expect(writer.Header().Get("X-Request-ID")).NotTo(ContainSubstring("oops"))
+		})
+	})
+
+	Context("when the handler writes an error", func() {
+		BeforeEach(func() {
+			paramReader := new(httpserverfakes.FakeSearchPhrasesParamReader)
+			paramReader.ReadParamsFromRequestReturns(SearchPhrasesParams{}, errors.New("no query"))
+			inner = NewSearchPhrasesHandler(new(usecasesfakes.FakeSearchPhrasesUseCase), paramReader)
+
+			request.Header.Set("X-Request-ID", "the-request-id")
+		})
+
+		It("includes the request id in the error body", func() {
+			Expect(writer.Body.String()).To(Equal(`{"error":"no query","requestId":"the-request-id"}`))
+		})
+
+		It("logs the error", func() {
+			Expect(readLog()["error"]).To(Equal("no query"))
+		})
+	})
+})
@@ -0,0 +1,32 @@
+package httpserver
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RequestMetrics is told about every request served, for /metrics
+type RequestMetrics interface {
+	RequestServed(route, method, status string, duration time.Duration)
+}
+
+// NewRequestMetrics counts requests and times them per route template.
+// It has to sit inside the request logger to know which route was served
+func NewRequestMetrics(handler http.Handler, metrics RequestMetrics) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		started := time.Now()
+
+		route := "unknown"
+		info, ok := request.Context().Value(requestInfoKey).(*requestInfo)
+
+		recorder := &statusRecordingWriter{ResponseWriter: writer, status: http.StatusOK}
+		handler.ServeHTTP(recorder, request)
+
+		if ok {
+			route = info.route
+		}
+
+		metrics.RequestServed(route, request.Method, strconv.Itoa(recorder.status), time.Since(started))
+	})
+}
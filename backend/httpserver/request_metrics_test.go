@@ -0,0 +1,36 @@
+package httpserver_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("RequestMetrics", func() {
+	It("counts and times requests by route template, method and status", func() {
+		inner := WithRouteTemplate("/api/phrases/french/{uuid}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(time.Millisecond)
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		registry := metrics.New()
+		subject := NewRequestLogger(NewRequestMetrics(inner, registry), &bytes.Buffer{})
+
+		request, err := http.NewRequest("DELETE", "http://example.com/api/phrases/french/the-uuid", nil)
+		Expect(err).NotTo(HaveOccurred())
+		subject.ServeHTTP(httptest.NewRecorder(), request)
+
+		scrape := httptest.NewRecorder()
+		registry.Handler().ServeHTTP(scrape, httptest.NewRequest("GET", "/metrics", nil))
+
+		labels := `method="DELETE",route="/api/phrases/french/{uuid}",status="404"`
+		Expect(scrape.Body.String()).To(ContainSubstring(`http_requests_total{` + labels + `} 1`))
+		Expect(scrape.Body.String()).To(ContainSubstring(`http_request_duration_seconds_count{` + labels + `} 1`))
+	})
+})
@@ -0,0 +1,29 @@
+package httpserver
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+const DefaultRequestTimeout = 30 * time.Second
+
+// statusClientClosedRequest is nginx's status for a client that hung up
+// before we answered. Nobody will see it, but it keeps the logs honest
+const statusClientClosedRequest = 499
+
+// NewRequestTimeout gives every request a deadline, after which its
+// database statements are cancelled. A timeout of zero means no deadline.
+// The request context is also cancelled when the client disconnects
+func NewRequestTimeout(handler http.Handler, timeout time.Duration) http.Handler {
+	if timeout <= 0 {
+		return handler
+	}
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx, cancel := context.WithTimeout(request.Context(), timeout)
+		defer cancel()
+
+		handler.ServeHTTP(writer, request.WithContext(ctx))
+	})
+}
@@ -0,0 +1,107 @@
+package httpserver_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("RequestTimeout", func() {
+	var (
+		useCase     *usecasesfakes.FakeAddPhraseUseCase
+		paramReader *httpserverfakes.FakeAddPhraseParamReader
+		timeout     time.Duration
+		writer      *httptest.ResponseRecorder
+		request     *http.Request
+	)
+
+	BeforeEach(func() {
+		useCase = new(usecasesfakes.FakeAddPhraseUseCase)
+		useCase.ExecuteStub = func(ctx context.Context, _ usecases.AddPhraseRequest) ([]usecases.SyncedPhraseResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+
+		paramReader = new(httpserverfakes.FakeAddPhraseParamReader)
+		paramReader.ReadParamsFromRequestReturns([]AddPhraseParams{{Phrase: "bonjour"}}, &userUUID, nil)
+
+		writer = httptest.NewRecorder()
+
+		var err error
+		request, err = http.NewRequest("POST", "/api/phrases/french", nil)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		subject := NewRequestTimeout(NewAddPhraseHandler(useCase, paramReader), timeout)
+		subject.ServeHTTP(writer, request)
+	})
+
+	Context("when the request takes longer than its deadline", func() {
+		BeforeEach(func() {
+			timeout = 10 * time.Millisecond
+		})
+
+		It("cancels the use case and responds with a gateway timeout", func() {
+			Expect(useCase.ExecuteCallCount()).To(Equal(1))
+			Expect(writer.Code).To(Equal(http.StatusGatewayTimeout))
+			Expect(writer.Body.String()).To(Equal(`{"error":"context deadline exceeded"}`))
+		})
+	})
+
+	Context("when the deadline comes back wrapped, as it does from a transaction", func() {
+		BeforeEach(func() {
+			timeout = 10 * time.Millisecond
+			useCase.ExecuteStub = func(ctx context.Context, _ usecases.AddPhraseRequest) ([]usecases.SyncedPhraseResponse, error) {
+				<-ctx.Done()
+				return nil, fmt.Errorf("committing the sync: %w", ctx.Err())
+			}
+		})
+
+		It("still responds with a gateway timeout", func() {
+			Expect(writer.Code).To(Equal(http.StatusGatewayTimeout))
+		})
+	})
+
+	Context("when the client disconnects", func() {
+		BeforeEach(func() {
+			timeout = time.Minute
+
+			ctx, cancel := context.WithCancel(context.Background())
+			request = request.WithContext(ctx)
+			time.AfterFunc(10*time.Millisecond, cancel)
+		})
+
+		It("cancels the use case", func() {
+			Expect(useCase.ExecuteCallCount()).To(Equal(1))
+			Expect(writer.Code).To(Equal(499))
+		})
+	})
+
+	Context("when the timeout is zero", func() {
+		BeforeEach(func() {
+			timeout = 0
+			useCase.ExecuteStub = func(ctx context.Context, _ usecases.AddPhraseRequest) ([]usecases.SyncedPhraseResponse, error) {
+				if _, ok := ctx.Deadline(); ok {
+					return nil, errors.New("the request should not have a deadline")
+				}
+				return []usecases.SyncedPhraseResponse{}, nil
+			}
+		})
+
+		It("leaves the request without a deadline", func() {
+			Expect(writer.Code).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,55 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewRequestTracing starts the server span every other span in a request
+// hangs off, continuing the caller's trace when it sends a traceparent.
+// Like the request metrics, it has to sit inside the request logger
+func NewRequestTracing(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(request.Context(), propagation.HeaderCarrier(request.Header))
+		ctx, span := tracing.Start(ctx, request.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(attribute.String("http.method", request.Method)),
+		)
+		defer span.End()
+
+		if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok && span.IsRecording() {
+			// so the request log can be matched up with its trace
+			info.traceID = span.SpanContext().TraceID().String()
+			span.SetAttributes(attribute.String("http.request_id", info.requestID))
+		}
+
+		recorder := &statusRecordingWriter{ResponseWriter: writer, status: http.StatusOK}
+		handler.ServeHTTP(recorder, request.WithContext(ctx))
+
+		// the route rather than the path, which has the user's phrase uuids in it
+		if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+			span.SetName(request.Method + " " + info.route)
+			span.SetAttributes(
+				attribute.String("http.route", info.route),
+				attribute.String("http.target", info.route),
+			)
+		}
+		span.SetAttributes(attribute.Int("http.status_code", recorder.status))
+		if recorder.err != "" {
+			span.SetAttributes(attribute.String("error.message", recorder.err))
+		}
+		if recorder.status >= http.StatusInternalServerError {
+			message := recorder.err
+			if message == "" {
+				message = http.StatusText(recorder.status)
+			}
+			span.RecordError(errors.New(message))
+		}
+	})
+}
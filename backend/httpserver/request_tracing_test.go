@@ -0,0 +1,109 @@
+package httpserver_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("RequestTracing", func() {
+	var (
+		recorder *tracetest.SpanRecorder
+		logs     *bytes.Buffer
+		request  *http.Request
+	)
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+
+		logs = &bytes.Buffer{}
+
+		var err error
+		request, err = http.NewRequest("PUT", "http://example.com/api/phrases/french/f56b84af-7b95-40ff-b360-888169fb7f12", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set(RequestIDHeader, "the-request-id")
+		request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+
+	JustBeforeEach(func() {
+		inner := WithRouteTemplate("/api/phrases/french/{uuid}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, span := tracing.Start(r.Context(), "AddPhraseUseCase.Execute")
+			span.End()
+
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+
+		subject := NewRequestLogger(NewRequestTracing(inner), logs)
+		subject.ServeHTTP(httptest.NewRecorder(), request)
+	})
+
+	It("wraps the request in a server span named after its route", func() {
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+
+		server := spans[1]
+		Expect(server.Name()).To(Equal("PUT /api/phrases/french/{uuid}"))
+		Expect(server.SpanKind()).To(Equal(trace.SpanKindServer))
+		Expect(server.SpanContext().TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+		Expect(server.Parent().SpanID().String()).To(Equal("00f067aa0ba902b7"))
+		Expect(server.Attributes()).To(ContainElement(attribute.String("http.route", "/api/phrases/french/{uuid}")))
+		Expect(server.Attributes()).To(ContainElement(attribute.String("http.target", "/api/phrases/french/{uuid}")))
+		Expect(server.Attributes()).To(ContainElement(attribute.Int("http.status_code", http.StatusInternalServerError)))
+		Expect(server.Attributes()).To(ContainElement(attribute.String("http.request_id", "the-request-id")))
+		Expect(server.Status().Code).To(Equal(codes.Error))
+		Expect(server.Status().Description).To(Equal("Internal Server Error"))
+	})
+
+	It("keeps the phrase's uuid out of the span", func() {
+		for _, attr := range recorder.Ended()[1].Attributes() {
+			Expect(attr.Value.Emit()).NotTo(ContainSubstring("f56b84af"), string(attr.Key))
+		}
+	})
+
+	It("parents the spans started by handlers on the server span", func() {
+		spans := recorder.Ended()
+		Expect(spans[0].Name()).To(Equal("AddPhraseUseCase.Execute"))
+		Expect(spans[0].Parent().SpanID()).To(Equal(spans[1].SpanContext().SpanID()))
+	})
+
+	It("logs the trace id alongside the request", func() {
+		entry := map[string]interface{}{}
+		Expect(json.Unmarshal(logs.Bytes(), &entry)).To(Succeed())
+		Expect(entry["traceId"]).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+	})
+
+	Context("when tracing is not set up", func() {
+		BeforeEach(func() {
+			otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		})
+
+		It("serves the request without recording anything", func() {
+			Expect(recorder.Ended()).To(BeEmpty())
+
+			entry := map[string]interface{}{}
+			Expect(json.Unmarshal(logs.Bytes(), &entry)).To(Succeed())
+			Expect(entry).NotTo(HaveKey("traceId"))
+		})
+	})
+})
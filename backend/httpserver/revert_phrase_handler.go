@@ -0,0 +1,54 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewRevertPhraseHandler puts a phrase back the way it was at an earlier
+// revision, and responds with the phrase as it is now
+func NewRevertPhraseHandler(
+	useCase usecases.RevertPhraseUseCase,
+	paramReader PhraseRevisionParamReader,
+) http.Handler {
+	return revertPhraseHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type revertPhraseHandler struct {
+	useCase     usecases.RevertPhraseUseCase
+	paramReader PhraseRevisionParamReader
+}
+
+func (handler revertPhraseHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "PhraseRevisionParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	phrase, err := handler.useCase.Execute(request.Context(), usecases.RevertPhraseRequest{
+		UUID:     params.PhraseUUID,
+		Revision: params.Revision,
+		UserUUID: params.UserUUID,
+	})
+	switch err {
+	case nil:
+	case api.ErrPhraseNotFound, api.ErrRevisionNotFound:
+		writeError(writer, err, http.StatusNotFound)
+		return
+	default:
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	writeJSON(writer, phrase)
+}
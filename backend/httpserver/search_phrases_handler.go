@@ -0,0 +1,52 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+type SearchPhrasesHandler interface {
+	http.Handler
+}
+
+func NewSearchPhrasesHandler(
+	useCase usecases.SearchPhrasesUseCase,
+	paramReader SearchPhrasesParamReader,
+) http.Handler {
+	return searchPhrasesHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type searchPhrasesHandler struct {
+	useCase     usecases.SearchPhrasesUseCase
+	paramReader SearchPhrasesParamReader
+}
+
+func (handler searchPhrasesHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	results, err := handler.useCase.Execute(request.Context(), usecases.SearchPhrasesRequest{
+		UserUUID: params.UserUUID,
+		Query:    params.Query,
+	})
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	responseBody, err := json.Marshal(results)
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writer.Write([]byte(responseBody))
+}
@@ -0,0 +1,91 @@
+package httpserver_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/httpserver/httpserverfakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("SearchPhrasesHandler", func() {
+	var subject SearchPhrasesHandler
+	var useCase *usecasesfakes.FakeSearchPhrasesUseCase
+	var paramReader *httpserverfakes.FakeSearchPhrasesParamReader
+	var writer *httptest.ResponseRecorder
+
+	BeforeEach(func() {
+		useCase = new(usecasesfakes.FakeSearchPhrasesUseCase)
+		paramReader = new(httpserverfakes.FakeSearchPhrasesParamReader)
+		writer = httptest.NewRecorder()
+
+		subject = NewSearchPhrasesHandler(useCase, paramReader)
+	})
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("GET", "http://example.com/api/search?q=chat", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		subject.ServeHTTP(writer, request)
+	})
+
+	Describe("a successful request", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns(SearchPhrasesParams{
+				UserUUID: userUUID,
+				Query:    "chat",
+			}, nil)
+			useCase.ExecuteReturns([]usecases.SearchResultResponse{{
+				Uuid:        "the-uuid",
+				Content:     "le chat",
+				Translation: "the cat",
+				PhraseType:  api.FRENCH_TO_ENGLISH,
+				Score:       2,
+				Highlights:  map[string]string{"content": "le <mark>chat</mark>"},
+			}}, nil)
+		})
+
+		It("searches on behalf of the user", func() {
+			Expect(useCase.ExecuteCallCount()).To(Equal(1))
+			_, request := useCase.ExecuteArgsForCall(0)
+			Expect(request).To(Equal(usecases.SearchPhrasesRequest{
+				UserUUID: userUUID,
+				Query:    "chat",
+			}))
+		})
+
+		It("returns JSON describing the matching phrases", func() {
+			expectedBody := `[{"uuid":"the-uuid","content":"le chat","translation":"the cat","phraseType":"FRENCH_TO_ENGLISH","score":2,"highlights":{"content":"le \u003cmark\u003echat\u003c/mark\u003e"}}]`
+			Expect(writer.Body.String()).To(Equal(expectedBody))
+		})
+	})
+
+	Describe("when the params cannot be read", func() {
+		BeforeEach(func() {
+			paramReader.ReadParamsFromRequestReturns(SearchPhrasesParams{}, errors.New("what are we looking for ?"))
+		})
+
+		It("returns an error", func() {
+			Expect(writer.Code).To(Equal(http.StatusBadRequest))
+			Expect(writer.Body.String()).To(Equal(`{"error":"what are we looking for ?"}`))
+		})
+	})
+
+	Describe("when the usecase returns an error", func() {
+		BeforeEach(func() {
+			useCase.ExecuteReturns(nil, errors.New("the index is on fire"))
+		})
+
+		It("returns an error", func() {
+			Expect(writer.Code).To(Equal(http.StatusInternalServerError))
+			Expect(writer.Body.String()).To(Equal(`{"error":"the index is on fire"}`))
+		})
+	})
+})
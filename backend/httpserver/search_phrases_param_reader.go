@@ -0,0 +1,49 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+//go:generate counterfeiter . SearchPhrasesParamReader
+type SearchPhrasesParamReader interface {
+	ReadParamsFromRequest(*http.Request) (SearchPhrasesParams, error)
+}
+
+type SearchPhrasesParams struct {
+	UserUUID uuid.UUID
+	Query    string
+}
+
+func NewSearchPhrasesParamReader() SearchPhrasesParamReader {
+	return searchPhrasesParamReader{}
+}
+
+type searchPhrasesParamReader struct{}
+
+func (paramReader searchPhrasesParamReader) ReadParamsFromRequest(
+	request *http.Request,
+) (SearchPhrasesParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return SearchPhrasesParams{}, errors.New("you done goofed; I'm pretty sure you didn't authenticate")
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return SearchPhrasesParams{}, err
+	}
+
+	query := strings.TrimSpace(request.URL.Query().Get("q"))
+	if query == "" {
+		return SearchPhrasesParams{}, errors.New("You must specify something to search for")
+	}
+
+	return SearchPhrasesParams{
+		UserUUID: userUuid,
+		Query:    query,
+	}, nil
+}
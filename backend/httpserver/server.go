@@ -0,0 +1,68 @@
+package httpserver
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"time"
+)
+
+const DefaultDrainTimeout = 10 * time.Second
+
+// Server wraps http.Server so that a deploy doesn't cut off requests
+// halfway through: on a signal it stops accepting connections, waits for
+// in-flight requests to finish (up to the drain timeout) and then closes
+// whatever resources the requests were using
+type Server struct {
+	httpServer   *http.Server
+	drainTimeout time.Duration
+	closers      []func() error
+}
+
+func NewServer(handler http.Handler, drainTimeout time.Duration) *Server {
+	return &Server{
+		httpServer:   &http.Server{Handler: handler},
+		drainTimeout: drainTimeout,
+	}
+}
+
+// OnShutdown registers a function to run once every request has drained
+func (server *Server) OnShutdown(closer func() error) {
+	server.closers = append(server.closers, closer)
+}
+
+func (server *Server) Serve(listener net.Listener, signals <-chan os.Signal) error {
+	serveErrs := make(chan error, 1)
+	go func() {
+		serveErrs <- server.httpServer.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErrs:
+		server.close()
+		return err
+	case <-signals:
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), server.drainTimeout)
+	defer cancel()
+
+	err := server.httpServer.Shutdown(ctx)
+	if closeErr := server.close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+func (server *Server) close() error {
+	var firstErr error
+	for _, closer := range server.closers {
+		if err := closer(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
@@ -0,0 +1,140 @@
+package httpserver_test
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		subject        *Server
+		listener       net.Listener
+		signals        chan os.Signal
+		requestStarted chan struct{}
+		releaseRequest chan struct{}
+		serveErr       chan error
+		closed         chan struct{}
+		drainTimeout   time.Duration
+		url            string
+	)
+
+	BeforeEach(func() {
+		requestStarted = make(chan struct{})
+		releaseRequest = make(chan struct{})
+		signals = make(chan os.Signal, 1)
+		serveErr = make(chan error, 1)
+		closed = make(chan struct{})
+		drainTimeout = time.Second
+
+		var err error
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		url = "http://" + listener.Addr().String() + "/slow"
+	})
+
+	JustBeforeEach(func() {
+		started, release, done := requestStarted, releaseRequest, closed
+		slowHandler := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			close(started)
+			<-release
+			writer.Write([]byte("all done"))
+		})
+
+		subject = NewServer(slowHandler, drainTimeout)
+		subject.OnShutdown(func() error {
+			close(done)
+			return nil
+		})
+
+		server, errs, sigs := subject, serveErr, signals
+		go func() {
+			errs <- server.Serve(listener, sigs)
+		}()
+	})
+
+	AfterEach(func() {
+		select {
+		case <-releaseRequest:
+		default:
+			close(releaseRequest)
+		}
+	})
+
+	type response struct {
+		body string
+		err  error
+	}
+
+	startSlowRequest := func() chan response {
+		responses := make(chan response, 1)
+		go func() {
+			resp, err := http.Get(url)
+			if err != nil {
+				responses <- response{err: err}
+				return
+			}
+			defer resp.Body.Close()
+			body, err := ioutil.ReadAll(resp.Body)
+			responses <- response{body: string(body), err: err}
+		}()
+
+		Eventually(requestStarted).Should(BeClosed())
+		return responses
+	}
+
+	It("lets a slow request finish before shutting down", func() {
+		responses := startSlowRequest()
+
+		signals <- syscall.SIGTERM
+
+		Consistently(serveErr, 100*time.Millisecond).ShouldNot(Receive())
+		Expect(closed).NotTo(BeClosed())
+
+		close(releaseRequest)
+
+		var r response
+		Eventually(responses).Should(Receive(&r))
+		Expect(r.err).NotTo(HaveOccurred())
+		Expect(r.body).To(Equal("all done"))
+
+		Eventually(serveErr).Should(Receive(BeNil()))
+		Expect(closed).To(BeClosed())
+	})
+
+	It("stops accepting new connections once it starts shutting down", func() {
+		startSlowRequest()
+
+		signals <- syscall.SIGINT
+
+		Eventually(func() error {
+			conn, err := net.Dial("tcp", listener.Addr().String())
+			if err == nil {
+				conn.Close()
+			}
+			return err
+		}).Should(HaveOccurred())
+	})
+
+	Context("when requests take longer than the drain timeout", func() {
+		BeforeEach(func() {
+			drainTimeout = 50 * time.Millisecond
+		})
+
+		It("gives up waiting and still closes its resources", func() {
+			startSlowRequest()
+
+			signals <- syscall.SIGTERM
+
+			Eventually(serveErr).Should(Receive(HaveOccurred()))
+			Expect(closed).To(BeClosed())
+		})
+	})
+})
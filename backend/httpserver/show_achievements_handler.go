@@ -0,0 +1,44 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewShowAchievementsHandler lists every badge, with when the user earned
+// the ones they have. New badges only need adding to the rules
+func NewShowAchievementsHandler(
+	useCase usecases.ShowAchievementsUseCase,
+	paramReader ShowAchievementsParamReader,
+) http.Handler {
+	return showAchievementsHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type showAchievementsHandler struct {
+	useCase     usecases.ShowAchievementsUseCase
+	paramReader ShowAchievementsParamReader
+}
+
+func (handler showAchievementsHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "ShowAchievementsParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	achievements, err := handler.useCase.Execute(request.Context(), usecases.ShowAchievementsRequest(params))
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, achievements)
+}
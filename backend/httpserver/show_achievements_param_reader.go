@@ -0,0 +1,39 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+//go:generate counterfeiter . ShowAchievementsParamReader
+type ShowAchievementsParamReader interface {
+	ReadParamsFromRequest(*http.Request) (ShowAchievementsParams, error)
+}
+
+type ShowAchievementsParams struct {
+	UserUUID uuid.UUID
+}
+
+func NewShowAchievementsParamReader() ShowAchievementsParamReader {
+	return showAchievementsParamReader{}
+}
+
+type showAchievementsParamReader struct{}
+
+func (paramReader showAchievementsParamReader) ReadParamsFromRequest(
+	request *http.Request,
+) (ShowAchievementsParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return ShowAchievementsParams{}, errors.New("you done goofed; I'm pretty sure you didn't authenticate")
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return ShowAchievementsParams{}, err
+	}
+
+	return ShowAchievementsParams{UserUUID: userUuid}, nil
+}
@@ -0,0 +1,53 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewShowExamplesHandler responds with {"examples": [...]},
+// the phrase's example sentences in order
+func NewShowExamplesHandler(
+	useCase usecases.ShowExamplesUseCase,
+	paramReader PhraseExamplesParamReader,
+) http.Handler {
+	return showExamplesHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type showExamplesHandler struct {
+	useCase     usecases.ShowExamplesUseCase
+	paramReader PhraseExamplesParamReader
+}
+
+func (handler showExamplesHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "PhraseExamplesParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	examples, err := handler.useCase.Execute(request.Context(), usecases.ShowExamplesRequest{
+		UUID:     params.PhraseUUID,
+		UserUUID: params.UserUUID,
+	})
+	switch err {
+	case nil:
+	case api.ErrPhraseNotFound:
+		writeError(writer, err, http.StatusNotFound)
+		return
+	default:
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, examples)
+}
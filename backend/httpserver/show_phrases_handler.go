@@ -30,22 +30,30 @@ func (handler showPhrasesHandler) ServeHTTP(writer http.ResponseWriter, request
 		return
 	}
 
-	phrases, err := handler.useCase.Execute(usecases.ShowPhrasesRequest{
-		UserUUID: params.UserUUID,
+	phrases, err := handler.useCase.Execute(request.Context(), usecases.ShowPhrasesRequest{
+		UserUUID:   params.UserUUID,
+		Sort:       params.Sort,
+		Descending: params.Descending,
+		Search:     params.Search,
+		Cursor:     params.Cursor,
+		Limit:      params.Limit,
 	})
 
 	if err != nil {
-		writer.WriteHeader(http.StatusBadRequest)
-		writer.Write([]byte(err.Error()))
+		writeError(writer, err, http.StatusBadRequest)
 		return
 	}
 
-	responseBody, err := json.Marshal(phrases)
+	responseBody, err := json.Marshal(phrases.Phrases)
 	if err != nil {
 		writer.WriteHeader(http.StatusInternalServerError)
 		writer.Write([]byte(err.Error()))
 		return
 	}
 
+	if phrases.NextCursor != "" {
+		writer.Header().Set("X-Next-Cursor", phrases.NextCursor)
+	}
+
 	writer.Write([]byte(responseBody))
 }
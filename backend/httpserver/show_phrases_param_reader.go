@@ -3,8 +3,10 @@ package httpserver
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
 )
 
 //go:generate counterfeiter . ShowPhrasesParamReader
@@ -13,7 +15,12 @@ type ShowPhrasesParamReader interface {
 }
 
 type ShowPhrasesParams struct {
-	UserUUID uuid.UUID
+	UserUUID   uuid.UUID
+	Sort       api.PhraseSort
+	Descending bool
+	Search     string
+	Cursor     string
+	Limit      int
 }
 
 func NewShowPhrasesParamReader() ShowPhrasesParamReader {
@@ -35,7 +42,38 @@ func (paramReader showPhrasesParamReader) ReadParamsFromRequest(
 		return ShowPhrasesParams{}, err
 	}
 
+	query := request.URL.Query()
+
+	limit := 0
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return ShowPhrasesParams{}, errors.New(`{"err": "limit must be a positive number"}`)
+		}
+	}
+
+	sort := api.PhraseSort(query.Get("sort"))
+	switch sort {
+	case "", api.SORT_CREATED, api.SORT_UPDATED, api.SORT_ALPHABETICAL, api.SORT_DUE:
+	default:
+		return ShowPhrasesParams{}, errors.New(`{"err": "sort must be one of created, updated, alphabetical or due"}`)
+	}
+
+	descending := false
+	switch query.Get("order") {
+	case "", "asc":
+	case "desc":
+		descending = true
+	default:
+		return ShowPhrasesParams{}, errors.New(`{"err": "order must be either asc or desc"}`)
+	}
+
 	return ShowPhrasesParams{
-		UserUUID: userUuid,
+		UserUUID:   userUuid,
+		Sort:       sort,
+		Descending: descending,
+		Search:     query.Get("q"),
+		Cursor:     query.Get("cursor"),
+		Limit:      limit,
 	}, nil
 }
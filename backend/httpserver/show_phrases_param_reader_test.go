@@ -0,0 +1,91 @@
+package httpserver_test
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("ShowPhrasesParamReader", func() {
+	var (
+		subject   ShowPhrasesParamReader
+		result    ShowPhrasesParams
+		resultErr error
+	)
+
+	var request *http.Request
+	var url string
+
+	BeforeEach(func() {
+		url = "http://example.com/api/phrases/french"
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		request, err = http.NewRequest("GET", url, nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("X-User-Token", userUUID.String())
+
+		subject = NewShowPhrasesParamReader()
+		result, resultErr = subject.ReadParamsFromRequest(request)
+	})
+
+	Describe("when no query parameters are provided", func() {
+		It("leaves the paging options empty", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ShowPhrasesParams{UserUUID: userUUID}))
+		})
+	})
+
+	Describe("when paging, sorting and search parameters are provided", func() {
+		BeforeEach(func() {
+			url += "?limit=20&cursor=the-cursor&sort=alphabetical&order=desc&q=caf%C3%A9"
+		})
+
+		It("returns them all", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ShowPhrasesParams{
+				UserUUID:   userUUID,
+				Sort:       api.SORT_ALPHABETICAL,
+				Descending: true,
+				Search:     "café",
+				Cursor:     "the-cursor",
+				Limit:      20,
+			}))
+		})
+	})
+
+	Describe("when the limit is not a positive number", func() {
+		BeforeEach(func() {
+			url += "?limit=-1"
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(HaveOccurred())
+		})
+	})
+
+	Describe("when the sort order is unknown", func() {
+		BeforeEach(func() {
+			url += "?sort=vibes"
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(HaveOccurred())
+		})
+	})
+
+	Describe("when the order is neither asc nor desc", func() {
+		BeforeEach(func() {
+			url += "?order=sideways"
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewShowStatsHandler shows the user their streak, how far along today's
+// goal they are, and a calendar of the last ?days= days (a year by default)
+func NewShowStatsHandler(
+	useCase usecases.ShowStatsUseCase,
+	paramReader ShowStatsParamReader,
+) http.Handler {
+	return showStatsHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type showStatsHandler struct {
+	useCase     usecases.ShowStatsUseCase
+	paramReader ShowStatsParamReader
+}
+
+func (handler showStatsHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "ShowStatsParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	stats, err := handler.useCase.Execute(request.Context(), usecases.ShowStatsRequest(params))
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, stats)
+}
@@ -0,0 +1,55 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+//go:generate counterfeiter . ShowStatsParamReader
+type ShowStatsParamReader interface {
+	ReadParamsFromRequest(*http.Request) (ShowStatsParams, error)
+}
+
+// ShowStatsParams.CalendarDays is 0 when the client leaves it to us
+type ShowStatsParams struct {
+	UserUUID     uuid.UUID
+	CalendarDays int
+}
+
+func NewShowStatsParamReader() ShowStatsParamReader {
+	return showStatsParamReader{}
+}
+
+type showStatsParamReader struct{}
+
+func (paramReader showStatsParamReader) ReadParamsFromRequest(
+	request *http.Request,
+) (ShowStatsParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return ShowStatsParams{}, errors.New("you done goofed; I'm pretty sure you didn't authenticate")
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return ShowStatsParams{}, err
+	}
+
+	days := 0
+	if value := request.URL.Query().Get("days"); value != "" {
+		days, err = strconv.Atoi(value)
+		if err != nil || days < 1 || days > usecases.MaxCalendarDays {
+			return ShowStatsParams{}, fmt.Errorf("days must be between 1 and %d", usecases.MaxCalendarDays)
+		}
+	}
+
+	return ShowStatsParams{
+		UserUUID:     userUuid,
+		CalendarDays: days,
+	}, nil
+}
@@ -0,0 +1,179 @@
+package httpserver
+
+import (
+	"compress/gzip"
+	"errors"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+)
+
+var errStaticFileNotFound = errors.New("not found")
+
+// the single page app handles these routes itself,
+// so they all get index.html
+var spaRoutes = []string{"/practice/", "/leaderboard"}
+
+// files the frontend expects somewhere other than where they live in the repo
+var staticAliases = map[string]string{
+	"/favicon.ico": "/assets/drapeau_francais_favicon.ico",
+}
+
+var staticContentTypes = map[string]string{
+	".appcache": "text/cache-manifest",
+	".css":      "text/css; charset=utf-8",
+	".html":     "text/html; charset=utf-8",
+	".ico":      "image/x-icon",
+	".js":       "application/javascript",
+	".png":      "image/png",
+}
+
+// NewStaticHandler serves the frontend, so that the app and the API
+// can run as one process without nginx in front of them. Files are read
+// from an http.FileSystem, either http.Dir or assets embedded in the binary
+func NewStaticHandler(files http.FileSystem) http.Handler {
+	return &staticHandler{files: files}
+}
+
+type staticHandler struct {
+	files http.FileSystem
+}
+
+func (handler *staticHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != "GET" && request.Method != "HEAD" {
+		writeError(writer, errors.New("method not allowed"), http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := path.Clean("/" + request.URL.Path)
+	if name == "/" || isSPARoute(name) {
+		name = "/index.html"
+	}
+
+	file, info, err := handler.open(name)
+	if err != nil {
+		if alias, ok := staticAliases[name]; ok {
+			name = alias
+			file, info, err = handler.open(name)
+		}
+	}
+	if err != nil {
+		writeError(writer, errStaticFileNotFound, http.StatusNotFound)
+		return
+	}
+	defer file.Close()
+
+	contentType, ok := staticContentTypes[path.Ext(name)]
+	if !ok {
+		contentType = "application/octet-stream"
+	}
+	writer.Header().Set("Content-Type", contentType)
+	writer.Header().Set("Cache-Control", cacheControlFor(name))
+
+	if isCompressible(contentType) {
+		writer.Header().Add("Vary", "Accept-Encoding")
+		if acceptsGzip(request) {
+			// ranges would be of the uncompressed file, so serve it whole
+			request.Header.Del("Range")
+			gzipWriter := &gzipResponseWriter{ResponseWriter: writer}
+			defer gzipWriter.Close()
+			writer = gzipWriter
+		}
+	}
+
+	http.ServeContent(writer, request, name, info.ModTime(), file)
+}
+
+func (handler *staticHandler) open(name string) (http.File, os.FileInfo, error) {
+	file, err := handler.files.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		file.Close()
+		return nil, nil, errStaticFileNotFound
+	}
+
+	return file, info, nil
+}
+
+func isSPARoute(name string) bool {
+	for _, route := range spaRoutes {
+		if name == strings.TrimSuffix(route, "/") || strings.HasPrefix(name, route) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// The application cache only notices a new version when the manifest
+// changes, and then refetches everything through the browser's HTTP cache.
+// Our assets aren't versioned, so anything the manifest lists has to be
+// revalidated every time or the app gets stuck on old javascript
+func cacheControlFor(name string) string {
+	switch path.Ext(name) {
+	case ".html", ".appcache", ".js", ".css":
+		return "no-cache"
+	default:
+		return "public, max-age=86400"
+	}
+}
+
+func isCompressible(contentType string) bool {
+	return strings.HasPrefix(contentType, "text/") || contentType == "application/javascript"
+}
+
+func acceptsGzip(request *http.Request) bool {
+	for _, encoding := range strings.Split(request.Header.Get("Accept-Encoding"), ",") {
+		if strings.TrimSpace(strings.SplitN(encoding, ";", 2)[0]) == "gzip" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// gzipResponseWriter only starts compressing once there is a body,
+// so that 304s and HEAD requests stay empty
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	gzip        *gzip.Writer
+	wroteHeader bool
+}
+
+func (writer *gzipResponseWriter) WriteHeader(status int) {
+	if !writer.wroteHeader {
+		writer.wroteHeader = true
+		writer.Header().Del("Content-Length")
+		if status == http.StatusOK {
+			writer.Header().Set("Content-Encoding", "gzip")
+		}
+	}
+	writer.ResponseWriter.WriteHeader(status)
+}
+
+func (writer *gzipResponseWriter) Write(bytes []byte) (int, error) {
+	if !writer.wroteHeader {
+		writer.WriteHeader(http.StatusOK)
+	}
+	if writer.Header().Get("Content-Encoding") != "gzip" {
+		return writer.ResponseWriter.Write(bytes)
+	}
+	if writer.gzip == nil {
+		writer.gzip = gzip.NewWriter(writer.ResponseWriter)
+	}
+
+	return writer.gzip.Write(bytes)
+}
+
+func (writer *gzipResponseWriter) Close() error {
+	if writer.gzip == nil {
+		return nil
+	}
+
+	return writer.gzip.Close()
+}
@@ -0,0 +1,154 @@
+package httpserver_test
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("StaticHandler", func() {
+	var (
+		dir     string
+		writer  *httptest.ResponseRecorder
+		request *http.Request
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "static")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(os.MkdirAll(filepath.Join(dir, "assets"), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("<html>the app</html>"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "assets", "index.js"), []byte("var app = 'elm';"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "assets", "application.appcache"), []byte("CACHE MANIFEST"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "assets", "drapeau_francais_favicon.ico"), []byte("icon"), 0644)).To(Succeed())
+
+		writer = httptest.NewRecorder()
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	get := func(path string) {
+		var err error
+		request, err = http.NewRequest("GET", path, nil)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	JustBeforeEach(func() {
+		NewStaticHandler(http.Dir(dir)).ServeHTTP(writer, request)
+	})
+
+	Context("when an asset is requested", func() {
+		BeforeEach(func() {
+			get("/assets/index.js")
+		})
+
+		It("serves it and has it revalidated every time", func() {
+			Expect(writer.Code).To(Equal(http.StatusOK))
+			Expect(writer.Body.String()).To(Equal("var app = 'elm';"))
+			Expect(writer.Header().Get("Content-Type")).To(Equal("application/javascript"))
+			Expect(writer.Header().Get("Cache-Control")).To(Equal("no-cache"))
+			Expect(writer.Header().Get("Last-Modified")).NotTo(BeEmpty())
+		})
+	})
+
+	Context("when the appcache manifest is requested", func() {
+		BeforeEach(func() {
+			get("/assets/application.appcache")
+		})
+
+		It("serves it as a cache manifest", func() {
+			Expect(writer.Header().Get("Content-Type")).To(Equal("text/cache-manifest"))
+			Expect(writer.Body.String()).To(Equal("CACHE MANIFEST"))
+		})
+	})
+
+	for _, route := range []string{"/", "/practice/french", "/practice/english/123", "/leaderboard"} {
+		route := route
+
+		Context("when the single page app route "+route+" is requested", func() {
+			BeforeEach(func() {
+				get(route)
+			})
+
+			It("serves index.html", func() {
+				Expect(writer.Code).To(Equal(http.StatusOK))
+				Expect(writer.Body.String()).To(Equal("<html>the app</html>"))
+				Expect(writer.Header().Get("Content-Type")).To(Equal("text/html; charset=utf-8"))
+			})
+		})
+	}
+
+	Context("when the favicon is requested", func() {
+		BeforeEach(func() {
+			get("/favicon.ico")
+		})
+
+		It("serves it from the assets", func() {
+			Expect(writer.Body.String()).To(Equal("icon"))
+			Expect(writer.Header().Get("Cache-Control")).To(Equal("public, max-age=86400"))
+		})
+	})
+
+	Context("when a file doesn't exist", func() {
+		BeforeEach(func() {
+			get("/assets/nope.js")
+		})
+
+		It("is not found", func() {
+			Expect(writer.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Context("when the path tries to escape the directory", func() {
+		BeforeEach(func() {
+			get("/../../etc/passwd")
+		})
+
+		It("is not found", func() {
+			Expect(writer.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Context("when the client accepts gzip", func() {
+		BeforeEach(func() {
+			get("/assets/index.js")
+			request.Header.Set("Accept-Encoding", "deflate, gzip;q=0.9")
+		})
+
+		It("compresses the response", func() {
+			Expect(writer.Header().Get("Content-Encoding")).To(Equal("gzip"))
+			Expect(writer.Header()["Vary"]).To(ContainElement("Accept-Encoding"))
+
+			reader, err := gzip.NewReader(writer.Body)
+			Expect(err).NotTo(HaveOccurred())
+			body, err := ioutil.ReadAll(reader)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal("var app = 'elm';"))
+		})
+
+		Context("and already has the latest version", func() {
+			BeforeEach(func() {
+				info, err := os.Stat(filepath.Join(dir, "assets", "index.js"))
+				Expect(err).NotTo(HaveOccurred())
+				request.Header.Set("If-Modified-Since", info.ModTime().UTC().Format(http.TimeFormat))
+			})
+
+			It("sends nothing at all", func() {
+				Expect(writer.Code).To(Equal(http.StatusNotModified))
+				Expect(writer.Header().Get("Content-Encoding")).To(BeEmpty())
+				Expect(writer.Body.Len()).To(Equal(0))
+			})
+		})
+	})
+})
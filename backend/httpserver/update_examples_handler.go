@@ -0,0 +1,58 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewUpdateExamplesHandler replaces all of a phrase's example sentences
+// with the {"examples": [...]} in the body, and responds with them
+func NewUpdateExamplesHandler(
+	useCase usecases.UpdateExamplesUseCase,
+	paramReader PhraseExamplesParamReader,
+) http.Handler {
+	return updateExamplesHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type updateExamplesHandler struct {
+	useCase     usecases.UpdateExamplesUseCase
+	paramReader PhraseExamplesParamReader
+}
+
+func (handler updateExamplesHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "PhraseExamplesParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	examples, err := handler.useCase.Execute(request.Context(), usecases.UpdateExamplesRequest{
+		UUID:     params.PhraseUUID,
+		UserUUID: params.UserUUID,
+		Examples: params.Examples,
+	})
+	if validationErr, ok := err.(usecases.ValidationError); ok {
+		writeValidationError(writer, validationErr)
+		return
+	}
+	switch err {
+	case nil:
+	case api.ErrPhraseNotFound:
+		writeError(writer, err, http.StatusNotFound)
+		return
+	default:
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, examples)
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
 	"github.com/tjarratt/doit-etre-rad/backend/usecases"
 )
 
@@ -25,7 +27,14 @@ type updatePhraseHandler struct {
 }
 
 func (handler updatePhraseHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "UpdatePhraseParamReader.ReadParamsFromRequest")
 	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if bodyErr, ok := err.(RequestBodyError); ok {
+		writeError(writer, bodyErr, bodyErr.Status)
+		return
+	}
 	if err != nil {
 		writer.WriteHeader(http.StatusBadRequest)
 		writer.Write([]byte(err.Error()))
@@ -37,18 +46,28 @@ func (handler updatePhraseHandler) ServeHTTP(writer http.ResponseWriter, request
 	if err != nil {
 		writer.WriteHeader(http.StatusBadRequest)
 		writer.Write([]byte(`{"err": "invalid phrase uuid"}`))
+		return
 	}
 
-	phrase, err := handler.useCase.Execute(usecases.UpdatePhraseRequest{
+	phrase, err := handler.useCase.Execute(request.Context(), usecases.UpdatePhraseRequest{
 		UserUUID:    params.UserUUID,
 		UUID:        phraseUUID,
 		Content:     params.Content,
 		Translation: params.Translation,
 	})
 
+	if validationErr, ok := err.(usecases.ValidationError); ok {
+		writeValidationError(writer, validationErr)
+		return
+	}
+
+	if err == api.ErrPhraseNotFound {
+		writeError(writer, err, http.StatusNotFound)
+		return
+	}
+
 	if err != nil {
-		writer.WriteHeader(http.StatusBadRequest)
-		writer.Write([]byte(err.Error()))
+		writeError(writer, err, http.StatusBadRequest)
 		return
 	}
 
@@ -1,9 +1,7 @@
 package httpserver
 
 import (
-	"encoding/json"
 	"errors"
-	"io/ioutil"
 	"net/http"
 
 	"github.com/google/uuid"
@@ -14,8 +12,8 @@ type UpdatePhraseParamReader interface {
 }
 
 type updatePhraseParams struct {
-	Content     string
-	Translation string
+	Content     *string
+	Translation *string
 	UserUUID    uuid.UUID
 }
 
@@ -36,29 +34,22 @@ func (reader updatePhraseParamReader) ReadParamsFromRequest(request *http.Reques
 		return updatePhraseParams{}, err
 	}
 
-	bodyStr, err := ioutil.ReadAll(request.Body)
+	// the client sends the phrase's uuid along with it,
+	// but the one in the path is the one that counts
+	requestObj := struct {
+		UUID        string  `json:"uuid"`
+		Content     *string `json:"content"`
+		Translation *string `json:"translation"`
+	}{}
+	err = decodeJSONBody(request, &requestObj)
 	if err != nil {
 		return updatePhraseParams{}, err
 	}
 
-	requestObj := map[string]string{}
-	err = json.Unmarshal(bodyStr, &requestObj)
-	if err != nil {
-		return updatePhraseParams{}, err
-	}
-
-	content, ok := requestObj["content"]
-	if !ok {
-		content = ""
-	}
-	translation, ok := requestObj["translation"]
-	if !ok {
-		translation = ""
-	}
-
+	// anything the client didn't send is left nil, so it isn't overwritten
 	return updatePhraseParams{
+		Content:     requestObj.Content,
+		Translation: requestObj.Translation,
 		UserUUID:    userUuid,
-		Content:     content,
-		Translation: translation,
 	}, nil
 }
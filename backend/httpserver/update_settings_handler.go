@@ -0,0 +1,48 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+// NewUpdateSettingsHandler changes the user's timezone and daily goal,
+// and responds with all of their settings
+func NewUpdateSettingsHandler(
+	useCase usecases.UpdateSettingsUseCase,
+	paramReader UpdateSettingsParamReader,
+) http.Handler {
+	return updateSettingsHandler{
+		useCase:     useCase,
+		paramReader: paramReader,
+	}
+}
+
+type updateSettingsHandler struct {
+	useCase     usecases.UpdateSettingsUseCase
+	paramReader UpdateSettingsParamReader
+}
+
+func (handler updateSettingsHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	_, readSpan := tracing.Start(request.Context(), "UpdateSettingsParamReader.ReadParamsFromRequest")
+	params, err := handler.paramReader.ReadParamsFromRequest(request)
+	readSpan.RecordError(err)
+	readSpan.End()
+	if err != nil {
+		writeError(writer, err, http.StatusBadRequest)
+		return
+	}
+
+	settings, err := handler.useCase.Execute(request.Context(), usecases.UpdateSettingsRequest(params))
+	if validationErr, ok := err.(usecases.ValidationError); ok {
+		writeValidationError(writer, validationErr)
+		return
+	}
+	if err != nil {
+		writeError(writer, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(writer, settings)
+}
@@ -0,0 +1,66 @@
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+)
+
+//go:generate counterfeiter . UpdateSettingsParamReader
+type UpdateSettingsParamReader interface {
+	ReadParamsFromRequest(*http.Request) (UpdateSettingsParams, error)
+}
+
+type UpdateSettingsParams struct {
+	UserUUID  uuid.UUID
+	Timezone  *string
+	DailyGoal *api.DailyGoal
+}
+
+func NewUpdateSettingsParamReader() UpdateSettingsParamReader {
+	return updateSettingsParamReader{}
+}
+
+type updateSettingsParamReader struct{}
+
+func (paramReader updateSettingsParamReader) ReadParamsFromRequest(
+	request *http.Request,
+) (UpdateSettingsParams, error) {
+	tokens, ok := request.Header["X-User-Token"]
+	if !ok {
+		return UpdateSettingsParams{}, errors.New("you done goofed; I'm pretty sure you didn't authenticate")
+	}
+
+	userUuid, err := uuid.Parse(tokens[0])
+	if err != nil {
+		return UpdateSettingsParams{}, err
+	}
+
+	// anything the client didn't send is left nil, so it isn't overwritten
+	requestObj := struct {
+		Timezone  *string `json:"timezone"`
+		DailyGoal *struct {
+			Type   string `json:"type"`
+			Target uint   `json:"target"`
+		} `json:"dailyGoal"`
+	}{}
+	err = decodeJSONBody(request, &requestObj)
+	if err != nil {
+		return UpdateSettingsParams{}, err
+	}
+
+	params := UpdateSettingsParams{
+		UserUUID: userUuid,
+		Timezone: requestObj.Timezone,
+	}
+	if requestObj.DailyGoal != nil {
+		params.DailyGoal = &api.DailyGoal{
+			Type:   api.GoalType(requestObj.DailyGoal.Type),
+			Target: requestObj.DailyGoal.Target,
+		}
+	}
+
+	return params, nil
+}
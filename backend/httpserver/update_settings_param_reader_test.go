@@ -0,0 +1,62 @@
+package httpserver_test
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/httpserver"
+)
+
+var _ = Describe("UpdateSettingsParamReader", func() {
+	var (
+		body      string
+		result    UpdateSettingsParams
+		resultErr error
+	)
+
+	JustBeforeEach(func() {
+		request, err := http.NewRequest("PATCH", "/api/me/settings", strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("X-User-Token", userUUID.String())
+
+		result, resultErr = NewUpdateSettingsParamReader().ReadParamsFromRequest(request)
+	})
+
+	Describe("with every setting", func() {
+		BeforeEach(func() {
+			body = `{"timezone": "Europe/Paris", "dailyGoal": {"type": "phrases", "target": 5}}`
+		})
+
+		It("reads them all", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result.UserUUID).To(Equal(userUUID))
+			Expect(*result.Timezone).To(Equal("Europe/Paris"))
+			Expect(*result.DailyGoal).To(Equal(api.DailyGoal{Type: api.GOAL_PHRASES, Target: 5}))
+		})
+	})
+
+	Describe("with only some of them", func() {
+		BeforeEach(func() {
+			body = `{"timezone": "Europe/Paris"}`
+		})
+
+		It("leaves the others alone", func() {
+			Expect(resultErr).NotTo(HaveOccurred())
+			Expect(result.DailyGoal).To(BeNil())
+		})
+	})
+
+	Describe("with a setting we don't have", func() {
+		BeforeEach(func() {
+			body = `{"theme": "dark"}`
+		})
+
+		It("returns an error", func() {
+			Expect(resultErr).To(MatchError("unknown field 'theme'"))
+		})
+	})
+})
@@ -1,18 +1,33 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"math/rand"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
 	"github.com/tjarratt/doit-etre-rad/backend/api"
-	"github.com/tjarratt/doit-etre-rad/backend/db"
+	dbpkg "github.com/tjarratt/doit-etre-rad/backend/db"
 	"github.com/tjarratt/doit-etre-rad/backend/httpserver"
+	"github.com/tjarratt/doit-etre-rad/backend/metrics"
+	"github.com/tjarratt/doit-etre-rad/backend/ratelimit"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
 	"github.com/tjarratt/doit-etre-rad/backend/usecases"
 
 	cfenv "github.com/cloudfoundry-community/go-cfenv"
 	_ "github.com/go-sql-driver/mysql"
+
+	// users' timezones have to work even where the system has no zoneinfo
+	_ "time/tzdata"
 )
 
 func main() {
@@ -23,40 +38,364 @@ func main() {
 		panic(err.Error())
 	}
 
-	db := db.OpenConnectionOrPanic(app)
+	db := dbpkg.OpenConnectionOrPanic(app)
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err = runMigrateCommand(db, os.Args[2:], os.Stdout)
+		db.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "grant-role" {
+		err = runGrantRoleCommand(
+			usecases.NewChangeRoleUseCase(api.NewAdminRepository(db)),
+			os.Args[2:],
+			os.Stdout,
+		)
+		db.Close()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
+	// with SKIP_MIGRATIONS set, migrations are left to `main migrate up`
+	// and /readyz fails until someone runs it
+	if os.Getenv("SKIP_MIGRATIONS") == "" {
+		dbpkg.MigrateOrPanic(db)
+	}
+
+	rateLimits := os.Getenv("RATE_LIMITS")
+	if rateLimits == "" {
+		rateLimits = ratelimit.DefaultRules
+	}
+	rules, err := ratelimit.ParseRules(rateLimits)
+	if err != nil {
+		panic(err.Error())
+	}
+	achievementRules, err := achievements.ParseRules(achievements.DefaultRules)
+	if err != nil {
+		panic(err.Error())
+	}
+	trustedProxyHops := 0
+	if hops := os.Getenv("TRUSTED_PROXY_HOPS"); hops != "" {
+		trustedProxyHops, err = strconv.Atoi(hops)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+	usersRepository := api.NewUsersRepository(db)
+	routes := apiRoutes{
+		router:   router,
+		limiter:  httpserver.NewRateLimiter(ratelimit.NewMemoryStore(time.Now), rules, trustedProxyHops),
+		accounts: httpserver.NewAccountCheck(usersRepository, time.Now),
+	}
 	frenchPhraseRepository := api.NewPhrasesRepository(api.FRENCH_TO_ENGLISH, db)
 	englishPhraseRepository := api.NewPhrasesRepository(api.ENGLISH_TO_FRENCH, db)
+	progressRepository := api.NewProgressRepository(db)
+	achievementsRepository := api.NewAchievementsRepository(db)
+	awarder := usecases.NewAchievementAwarder(achievementsRepository, progressRepository, achievementRules, time.Now)
+	serviceMetrics := metrics.New()
 
 	showFrenchHandler := ShowPhrasesHandler(frenchPhraseRepository)
-	router.Handle("/api/phrases/french", showFrenchHandler).Methods("GET")
+	routes.handle("/api/phrases/french", showFrenchHandler).Methods("GET")
 
 	showEnglishHandler := ShowPhrasesHandler(englishPhraseRepository)
-	router.Handle("/api/phrases/english", showEnglishHandler).Methods("GET")
+	routes.handle("/api/phrases/english", showEnglishHandler).Methods("GET")
+
+	addFrenchHandler := AddPhraseHandler(frenchPhraseRepository, awarder, serviceMetrics)
+	routes.handle("/api/phrases/french", addFrenchHandler).Methods("POST")
+
+	addEnglishHandler := AddPhraseHandler(englishPhraseRepository, awarder, serviceMetrics)
+	routes.handle("/api/phrases/english", addEnglishHandler).Methods("POST")
+
+	frenchMergeHandler := MergePhrasesHandler(frenchPhraseRepository)
+	routes.handle("/api/phrases/french/merge", frenchMergeHandler).Methods("POST")
+
+	englishMergeHandler := MergePhrasesHandler(englishPhraseRepository)
+	routes.handle("/api/phrases/english/merge", englishMergeHandler).Methods("POST")
+
+	frenchUpdateHandler := UpdatePhraseHandler(frenchPhraseRepository, serviceMetrics)
+	routes.handle("/api/phrases/french/{uuid}", frenchUpdateHandler).Methods("PUT")
+
+	englishUpdateHandler := UpdatePhraseHandler(englishPhraseRepository, serviceMetrics)
+	routes.handle("/api/phrases/english/{uuid}", englishUpdateHandler).Methods("PUT")
+
+	frenchPatchHandler := PatchPhraseHandler(frenchPhraseRepository, serviceMetrics)
+	routes.handle("/api/phrases/french/{uuid}", frenchPatchHandler).Methods("PATCH")
+
+	englishPatchHandler := PatchPhraseHandler(englishPhraseRepository, serviceMetrics)
+	routes.handle("/api/phrases/english/{uuid}", englishPatchHandler).Methods("PATCH")
+
+	frenchPracticeHandler := RecordPracticeSessionHandler(api.NewPracticeRepository(api.FRENCH_TO_ENGLISH, db), awarder)
+	routes.handle("/api/phrases/french/practice", frenchPracticeHandler).Methods("POST")
+
+	englishPracticeHandler := RecordPracticeSessionHandler(api.NewPracticeRepository(api.ENGLISH_TO_FRENCH, db), awarder)
+	routes.handle("/api/phrases/english/practice", englishPracticeHandler).Methods("POST")
+
+	frenchHistoryHandler := PhraseHistoryHandler(frenchPhraseRepository)
+	routes.handle("/api/phrases/french/{uuid}/history", frenchHistoryHandler).Methods("GET")
+
+	englishHistoryHandler := PhraseHistoryHandler(englishPhraseRepository)
+	routes.handle("/api/phrases/english/{uuid}/history", englishHistoryHandler).Methods("GET")
+
+	frenchRevertHandler := RevertPhraseHandler(frenchPhraseRepository, serviceMetrics)
+	routes.handle("/api/phrases/french/{uuid}/revert/{revision}", frenchRevertHandler).Methods("POST")
+
+	englishRevertHandler := RevertPhraseHandler(englishPhraseRepository, serviceMetrics)
+	routes.handle("/api/phrases/english/{uuid}/revert/{revision}", englishRevertHandler).Methods("POST")
+
+	frenchQuizRepository := api.NewQuizRepository(api.FRENCH_TO_ENGLISH, db)
+	englishQuizRepository := api.NewQuizRepository(api.ENGLISH_TO_FRENCH, db)
+	frenchQuizSweeper := usecases.NewExpiredQuizSweeper(frenchQuizRepository, time.Now)
+	englishQuizSweeper := usecases.NewExpiredQuizSweeper(englishQuizRepository, time.Now)
+	random := usecases.NewRandom(time.Now().UnixNano())
+
+	frenchQuizHandler := GenerateQuizHandler(frenchQuizRepository, frenchQuizSweeper, random)
+	routes.handle("/api/quizzes/french", frenchQuizHandler).Methods("GET")
+
+	englishQuizHandler := GenerateQuizHandler(englishQuizRepository, englishQuizSweeper, random)
+	routes.handle("/api/quizzes/english", englishQuizHandler).Methods("GET")
 
-	addFrenchHandler := AddPhraseHandler(frenchPhraseRepository)
-	router.Handle("/api/phrases/french", addFrenchHandler).Methods("POST")
+	frenchGradeQuizHandler := GradeQuizHandler(frenchQuizRepository, awarder)
+	routes.handle("/api/quizzes/french/{uuid}", frenchGradeQuizHandler).Methods("POST")
 
-	addEnglishHandler := AddPhraseHandler(englishPhraseRepository)
-	router.Handle("/api/phrases/english", addEnglishHandler).Methods("POST")
+	englishGradeQuizHandler := GradeQuizHandler(englishQuizRepository, awarder)
+	routes.handle("/api/quizzes/english/{uuid}", englishGradeQuizHandler).Methods("POST")
 
-	frenchUpdateHandler := UpdatePhraseHandler(frenchPhraseRepository)
-	router.Handle("/api/phrases/french/{uuid}", frenchUpdateHandler).Methods("PUT")
+	frenchExamplesRepository := api.NewExamplesRepository(api.FRENCH_TO_ENGLISH, db)
+	englishExamplesRepository := api.NewExamplesRepository(api.ENGLISH_TO_FRENCH, db)
 
-	englishUpdateHandler := UpdatePhraseHandler(englishPhraseRepository)
-	router.Handle("/api/phrases/english/{uuid}", englishUpdateHandler).Methods("PUT")
+	frenchShowExamplesHandler := ShowExamplesHandler(frenchExamplesRepository)
+	routes.handle("/api/phrases/french/{uuid}/examples", frenchShowExamplesHandler).Methods("GET")
 
-	adminHandler := AdminHandler(api.NewAdminRepository(db))
-	router.Handle("/api/admin", adminHandler).Methods("GET")
+	englishShowExamplesHandler := ShowExamplesHandler(englishExamplesRepository)
+	routes.handle("/api/phrases/english/{uuid}/examples", englishShowExamplesHandler).Methods("GET")
+
+	frenchUpdateExamplesHandler := UpdateExamplesHandler(frenchExamplesRepository)
+	routes.handle("/api/phrases/french/{uuid}/examples", frenchUpdateExamplesHandler).Methods("PUT")
+
+	englishUpdateExamplesHandler := UpdateExamplesHandler(englishExamplesRepository)
+	routes.handle("/api/phrases/english/{uuid}/examples", englishUpdateExamplesHandler).Methods("PUT")
+
+	frenchClozeHandler := GenerateClozeHandler(frenchExamplesRepository, frenchQuizRepository, frenchQuizSweeper, api.FRENCH_TO_ENGLISH, random)
+	routes.handle("/api/cloze/french", frenchClozeHandler).Methods("GET")
+
+	englishClozeHandler := GenerateClozeHandler(englishExamplesRepository, englishQuizRepository, englishQuizSweeper, api.ENGLISH_TO_FRENCH, random)
+	routes.handle("/api/cloze/english", englishClozeHandler).Methods("GET")
+
+	// cloze items are kept as quizzes, so they're graded the same way
+	routes.handle("/api/cloze/french/{uuid}", frenchGradeQuizHandler).Methods("POST")
+	routes.handle("/api/cloze/english/{uuid}", englishGradeQuizHandler).Methods("POST")
+
+	statsHandler := ShowStatsHandler(progressRepository)
+	routes.handle("/api/me/stats", statsHandler).Methods("GET")
+
+	settingsHandler := UpdateSettingsHandler(progressRepository)
+	routes.handle("/api/me/settings", settingsHandler).Methods("PATCH")
+
+	achievementsHandler := ShowAchievementsHandler(achievementsRepository, achievementRules)
+	routes.handle("/api/me/achievements", achievementsHandler).Methods("GET")
+
+	searchHandler := SearchPhrasesHandler(api.NewSearchRepository(db))
+	routes.handle("/api/search", searchHandler).Methods("GET")
+
+	// the first admin gets their staff key from `main grant-role`,
+	// and can hand out keys to everyone else from then on
+	authorizer := httpserver.NewAuthorizer(usersRepository)
+	moderators := func(handler http.Handler) http.Handler {
+		return authorizer.Require(api.ROLE_MODERATOR, handler)
+	}
+	admins := func(handler http.Handler) http.Handler {
+		return authorizer.Require(api.ROLE_ADMIN, handler)
+	}
+
+	adminRepository := api.NewAdminRepository(db)
+
+	adminHandler := admins(httpserver.NewAdminHandler(adminRepository))
+	routes.handle("/api/admin", adminHandler).Methods("GET")
+
+	adminUsersHandler := moderators(httpserver.NewAdminUsersHandler(
+		usecases.NewShowUsersUseCase(adminRepository),
+		httpserver.NewAdminUsersParamReader(),
+	))
+	routes.handle("/api/admin/users", adminUsersHandler).Methods("GET")
+
+	adminUserHandler := moderators(httpserver.NewAdminUserHandler(
+		usecases.NewShowUserUseCase(adminRepository),
+		httpserver.NewAdminUserParamReader(),
+	))
+	routes.handle("/api/admin/users/{uuid}", adminUserHandler).Methods("GET")
+
+	adminUserPhrasesHandler := admins(httpserver.NewAdminUserPhrasesHandler(
+		usecases.NewDeleteUserPhrasesUseCase(adminRepository),
+		httpserver.NewAdminUserParamReader(),
+	))
+	routes.handle("/api/admin/users/{uuid}/phrases", adminUserPhrasesHandler).Methods("DELETE")
+
+	adminPhraseHandler := moderators(httpserver.NewAdminPhraseHandler(
+		usecases.NewEditUserPhraseUseCase(adminRepository),
+		usecases.NewDeleteUserPhraseUseCase(adminRepository),
+		httpserver.NewAdminPhraseParamReader(),
+	))
+	routes.handle("/api/admin/users/{uuid}/phrases/{phraseUuid}", adminPhraseHandler).Methods("PATCH", "DELETE")
+
+	adminAccountHandler := moderators(httpserver.NewAdminAccountHandler(
+		usecases.NewChangeAccountUseCase(adminRepository),
+		httpserver.NewAdminAccountParamReader(),
+	))
+	routes.handle("/api/admin/users/{uuid}/disabled", adminAccountHandler).Methods("PUT", "DELETE")
+
+	adminRoleHandler := admins(httpserver.NewAdminRoleHandler(
+		usecases.NewChangeRoleUseCase(adminRepository),
+		httpserver.NewAdminRoleParamReader(),
+	))
+	routes.handle("/api/admin/users/{uuid}/role", adminRoleHandler).Methods("PUT")
+
+	adminStatsHandler := admins(httpserver.NewAdminStatsHandler(
+		usecases.NewShowAdminStatsUseCase(adminRepository, time.Now),
+		httpserver.NewAdminStatsParamReader(),
+	))
+	routes.handle("/api/admin/stats", adminStatsHandler).Methods("GET")
+
+	adminAnalyticsHandler := admins(httpserver.NewAdminAnalyticsHandler(
+		usecases.NewShowAnalyticsUseCase(api.NewAnalyticsRepository(db)),
+		httpserver.NewAdminAnalyticsParamReader(time.Now),
+	))
+	routes.handle("/api/admin/analytics/{series}", adminAnalyticsHandler).Methods("GET")
+
+	adminAuditHandler := admins(httpserver.NewAdminAuditHandler(api.NewAuditRepository(db)))
+	routes.handle("/api/admin/audit", adminAuditHandler).Methods("GET")
+
+	// the metrics say how many users there are and what they're doing,
+	// so scrapers need a staff key like anyone else looking at that
+	serviceMetrics.RegisterDB(db, "doit-etre-db")
+	routes.unlimited("/metrics", admins(serviceMetrics.Handler())).Methods("GET")
+
+	routes.unlimited("/healthz", httpserver.NewLivenessHandler()).Methods("GET")
+	routes.unlimited("/readyz", httpserver.NewReadinessHandler([]httpserver.HealthCheck{
+		{Name: "database", Check: dbpkg.Ping(db)},
+		{Name: "migrations", Check: dbpkg.CheckMigrations(db)},
+	})).Methods("GET")
 
 	router.NotFoundHandler = http.HandlerFunc(NotFoundHandler)
+	if staticDir := os.Getenv("STATIC_DIR"); staticDir != "" {
+		router.NotFoundHandler = StaticHandler(http.Dir(staticDir))
+	}
+
+	drainTimeout := httpserver.DefaultDrainTimeout
+	if timeout := os.Getenv("SHUTDOWN_TIMEOUT"); timeout != "" {
+		drainTimeout, err = time.ParseDuration(timeout)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+
+	requestTimeout := httpserver.DefaultRequestTimeout
+	if timeout := os.Getenv("REQUEST_TIMEOUT"); timeout != "" {
+		requestTimeout, err = time.ParseDuration(timeout)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+
+	tracerProvider, err := tracing.NewProviderFromEnvironment(context.Background(), os.Stdout)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	// the logger has to wrap everything else, since it is
+	// what tells the others which route served the request
+	var handler http.Handler = router
+	handler = httpserver.NewRequestTimeout(handler, requestTimeout)
+	handler = httpserver.NewCORS(handler, corsConfig())
+	handler = httpserver.NewRequestTracing(handler)
+	handler = httpserver.NewRequestMetrics(handler, serviceMetrics)
+	handler = httpserver.NewRequestLogger(handler, os.Stdout)
+
+	server := httpserver.NewServer(handler, drainTimeout)
+	server.OnShutdown(db.Close)
+
+	if tracerProvider != nil {
+		tracing.Install(tracerProvider, os.Stderr)
+		server.OnShutdown(func() error {
+			// flushes whatever spans are still queued
+			return tracerProvider.Shutdown(context.Background())
+		})
+	}
 
 	port := app.Port
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		panic(err.Error())
+	}
 	fmt.Fprintln(os.Stdout, "listening on port ", port)
 
-	err = http.ListenAndServe(fmt.Sprintf(":%d", port), router)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	err = server.Serve(listener, signals)
 	if err != nil {
 		panic(err.Error())
 	}
+	fmt.Fprintln(os.Stdout, "shut down cleanly")
+}
+
+type apiRoutes struct {
+	router   *mux.Router
+	limiter  *httpserver.RateLimiter
+	accounts *httpserver.AccountCheck
+}
+
+// handle rate limits before looking up the account,
+// so a disabled user hammering us doesn't hammer MySQL too
+func (routes apiRoutes) handle(template string, handler http.Handler) *mux.Route {
+	handler = routes.limiter.Limit(template, routes.accounts.Check(handler))
+	return routes.router.Handle(template, httpserver.WithRouteTemplate(template, handler))
+}
+
+// unlimited is for the platform's probes and scrapes. They aren't users,
+// and shouldn't be turned away for checking on us as often as they like
+func (routes apiRoutes) unlimited(template string, handler http.Handler) *mux.Route {
+	return routes.router.Handle(template, httpserver.WithRouteTemplate(template, handler))
+}
+
+// corsConfig reads CORS_ALLOWED_ORIGINS, CORS_ALLOWED_METHODS,
+// CORS_ALLOWED_HEADERS (all comma separated) and CORS_MAX_AGE
+func corsConfig() httpserver.CORSConfig {
+	config := httpserver.DefaultCORSConfig
+	config.AllowedOrigins = splitList(os.Getenv("CORS_ALLOWED_ORIGINS"))
+	if methods := splitList(os.Getenv("CORS_ALLOWED_METHODS")); len(methods) > 0 {
+		config.AllowedMethods = methods
+	}
+	if headers := splitList(os.Getenv("CORS_ALLOWED_HEADERS")); len(headers) > 0 {
+		config.AllowedHeaders = headers
+	}
+	if maxAge := os.Getenv("CORS_MAX_AGE"); maxAge != "" {
+		duration, err := time.ParseDuration(maxAge)
+		if err != nil {
+			panic(err.Error())
+		}
+		config.MaxAge = duration
+	}
+
+	return config
+}
+
+func splitList(value string) []string {
+	result := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+
+	return result
 }
 
 func NotFoundHandler(rw http.ResponseWriter, req *http.Request) {
@@ -65,20 +404,109 @@ func NotFoundHandler(rw http.ResponseWriter, req *http.Request) {
 	rw.Write([]byte(fmt.Sprintf("You done goofed son : '%s'", path)))
 }
 
-func UpdatePhraseHandler(repo api.PhrasesRepository) http.Handler {
+// StaticHandler serves the frontend for anything that isn't an API route
+func StaticHandler(files http.FileSystem) http.Handler {
+	static := httpserver.WithRouteTemplate("static", httpserver.NewStaticHandler(files))
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if strings.HasPrefix(req.URL.Path, "/api/") {
+			NotFoundHandler(rw, req)
+			return
+		}
+		static.ServeHTTP(rw, req)
+	})
+}
+
+func UpdatePhraseHandler(repo api.PhrasesRepository, metrics usecases.PhraseMetrics) http.Handler {
 	return httpserver.NewUpdatePhraseHandler(
-		usecases.NewUpdatePhraseUseCase(repo),
+		usecases.NewUpdatePhraseUseCase(repo, metrics),
 		httpserver.NewUpdatePhraseParamReader(),
 	)
 }
 
-func AddPhraseHandler(repo api.PhrasesRepository) http.Handler {
+func PatchPhraseHandler(repo api.PhrasesRepository, metrics usecases.PhraseMetrics) http.Handler {
+	return httpserver.NewUpdatePhraseHandler(
+		usecases.NewUpdatePhraseUseCase(repo, metrics),
+		httpserver.NewMergePatchPhraseParamReader(),
+	)
+}
+
+func AddPhraseHandler(repo api.PhrasesRepository, awarder usecases.AchievementAwarder, metrics usecases.PhraseMetrics) http.Handler {
 	return httpserver.NewAddPhraseHandler(
-		usecases.NewAddPhraseUseCase(repo),
+		usecases.NewAddPhraseUseCase(repo, awarder, metrics),
 		httpserver.NewAddPhraseParamReader(),
 	)
 }
 
+func MergePhrasesHandler(repo api.PhrasesRepository) http.Handler {
+	return httpserver.NewMergePhrasesHandler(
+		usecases.NewMergePhrasesUseCase(repo),
+		httpserver.NewMergePhrasesParamReader(),
+	)
+}
+
+func PhraseHistoryHandler(repo api.PhrasesRepository) http.Handler {
+	return httpserver.NewPhraseHistoryHandler(
+		usecases.NewPhraseHistoryUseCase(repo),
+		httpserver.NewPhraseRevisionParamReader(),
+	)
+}
+
+func RevertPhraseHandler(repo api.PhrasesRepository, metrics usecases.PhraseMetrics) http.Handler {
+	return httpserver.NewRevertPhraseHandler(
+		usecases.NewRevertPhraseUseCase(repo, metrics),
+		httpserver.NewPhraseRevisionParamReader(),
+	)
+}
+
+func RecordPracticeSessionHandler(repo api.PracticeRepository, awarder usecases.AchievementAwarder) http.Handler {
+	return httpserver.NewRecordPracticeSessionHandler(
+		usecases.NewRecordPracticeSessionUseCase(repo, awarder),
+		httpserver.NewRecordPracticeSessionParamReader(),
+	)
+}
+
+func GenerateQuizHandler(repo api.QuizRepository, sweeper usecases.ExpiredQuizSweeper, random *rand.Rand) http.Handler {
+	return httpserver.NewGenerateQuizHandler(
+		usecases.NewGenerateQuizUseCase(repo, sweeper, random.Shuffle),
+		httpserver.NewGenerateQuizParamReader(),
+	)
+}
+
+func GradeQuizHandler(repo api.QuizRepository, awarder usecases.AchievementAwarder) http.Handler {
+	return httpserver.NewGradeQuizHandler(
+		usecases.NewGradeQuizUseCase(repo, awarder, time.Now),
+		httpserver.NewGradeQuizParamReader(),
+	)
+}
+
+func GenerateClozeHandler(
+	examples api.ExamplesRepository,
+	quizzes api.QuizRepository,
+	sweeper usecases.ExpiredQuizSweeper,
+	phraseType api.PhraseType,
+	random *rand.Rand,
+) http.Handler {
+	return httpserver.NewGenerateQuizHandler(
+		usecases.NewGenerateClozeUseCase(examples, quizzes, sweeper, phraseType, random.Shuffle),
+		httpserver.NewGenerateQuizParamReader(),
+	)
+}
+
+func ShowExamplesHandler(repo api.ExamplesRepository) http.Handler {
+	return httpserver.NewShowExamplesHandler(
+		usecases.NewShowExamplesUseCase(repo),
+		httpserver.NewPhraseExamplesParamReader(),
+	)
+}
+
+func UpdateExamplesHandler(repo api.ExamplesRepository) http.Handler {
+	return httpserver.NewUpdateExamplesHandler(
+		usecases.NewUpdateExamplesUseCase(repo),
+		httpserver.NewPhraseExamplesParamReader(),
+	)
+}
+
 func ShowPhrasesHandler(repo api.PhrasesRepository) http.Handler {
 	return httpserver.NewShowPhrasesHandler(
 		usecases.NewShowPhrasesUseCase(repo),
@@ -86,11 +514,30 @@ func ShowPhrasesHandler(repo api.PhrasesRepository) http.Handler {
 	)
 }
 
-func AdminHandler(repo api.AdminRepository) http.Handler {
-	password := os.Getenv("REALLY_CLEVER_PASSWORD")
+func ShowStatsHandler(repo api.ProgressRepository) http.Handler {
+	return httpserver.NewShowStatsHandler(
+		usecases.NewShowStatsUseCase(repo, time.Now),
+		httpserver.NewShowStatsParamReader(),
+	)
+}
+
+func UpdateSettingsHandler(repo api.ProgressRepository) http.Handler {
+	return httpserver.NewUpdateSettingsHandler(
+		usecases.NewUpdateSettingsUseCase(repo),
+		httpserver.NewUpdateSettingsParamReader(),
+	)
+}
+
+func ShowAchievementsHandler(repo api.AchievementsRepository, rules achievements.Rules) http.Handler {
+	return httpserver.NewShowAchievementsHandler(
+		usecases.NewShowAchievementsUseCase(repo, rules),
+		httpserver.NewShowAchievementsParamReader(),
+	)
+}
 
-	return httpserver.NewAdminHandler(
-		repo,
-		password,
+func SearchPhrasesHandler(repo api.SearchRepository) http.Handler {
+	return httpserver.NewSearchPhrasesHandler(
+		usecases.NewSearchPhrasesUseCase(repo),
+		httpserver.NewSearchPhrasesParamReader(),
 	)
 }
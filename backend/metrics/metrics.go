@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics are everything served on /metrics. They have a registry of their
+// own, rather than the client library's global one, so that each test can
+// start counting from zero
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests        *prometheus.CounterVec
+	httpRequestDuration *prometheus.HistogramVec
+	phrasesCreated      prometheus.Counter
+	phrasesUpdated      prometheus.Counter
+	syncsPerformed      prometheus.Counter
+}
+
+func New() *Metrics {
+	metrics := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Number of HTTP requests served, by route template, method and status.",
+		}, []string{"route", "method", "status"}),
+		httpRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Time spent serving HTTP requests, by route template, method and status.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"route", "method", "status"}),
+		phrasesCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "doit_phrases_created_total",
+			Help: "Number of phrases created.",
+		}),
+		phrasesUpdated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "doit_phrases_updated_total",
+			Help: "Number of phrases updated, either directly or through a sync.",
+		}),
+		syncsPerformed: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "doit_syncs_total",
+			Help: "Number of batches of phrases synced from clients.",
+		}),
+	}
+
+	metrics.registry.MustRegister(
+		metrics.httpRequests,
+		metrics.httpRequestDuration,
+		metrics.phrasesCreated,
+		metrics.phrasesUpdated,
+		metrics.syncsPerformed,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return metrics
+}
+
+// RegisterDB exposes the connection pool statistics of the database
+func (metrics *Metrics) RegisterDB(db *sql.DB, name string) {
+	metrics.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+func (metrics *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(metrics.registry, promhttp.HandlerOpts{})
+}
+
+func (metrics *Metrics) RequestServed(route, method, status string, duration time.Duration) {
+	metrics.httpRequests.WithLabelValues(route, method, status).Inc()
+	metrics.httpRequestDuration.WithLabelValues(route, method, status).Observe(duration.Seconds())
+}
+
+func (metrics *Metrics) PhraseCreated() {
+	metrics.phrasesCreated.Inc()
+}
+
+func (metrics *Metrics) PhraseUpdated() {
+	metrics.phrasesUpdated.Inc()
+}
+
+func (metrics *Metrics) SyncPerformed() {
+	metrics.syncsPerformed.Inc()
+}
@@ -0,0 +1,57 @@
+package metrics_test
+
+import (
+	"net/http/httptest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/tjarratt/doit-etre-rad/backend/metrics"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Metrics", func() {
+	var subject *metrics.Metrics
+
+	BeforeEach(func() {
+		subject = metrics.New()
+	})
+
+	scrape := func() string {
+		writer := httptest.NewRecorder()
+		subject.Handler().ServeHTTP(writer, httptest.NewRequest("GET", "/metrics", nil))
+		Expect(writer.Header().Get("Content-Type")).To(HavePrefix("text/plain; version=0.0.4"))
+		return writer.Body.String()
+	}
+
+	It("counts what happens to phrases", func() {
+		subject.PhraseCreated()
+		subject.PhraseCreated()
+		subject.PhraseUpdated()
+		subject.SyncPerformed()
+
+		body := scrape()
+		Expect(body).To(ContainSubstring("doit_phrases_created_total 2\n"))
+		Expect(body).To(ContainSubstring("doit_phrases_updated_total 1\n"))
+		Expect(body).To(ContainSubstring("doit_syncs_total 1\n"))
+	})
+
+	It("times requests into histogram buckets", func() {
+		subject.RequestServed("/api/search", "GET", "200", 30*time.Millisecond)
+
+		body := scrape()
+		Expect(body).To(ContainSubstring(`http_request_duration_seconds_bucket{method="GET",route="/api/search",status="200",le="0.025"} 0`))
+		Expect(body).To(ContainSubstring(`http_request_duration_seconds_bucket{method="GET",route="/api/search",status="200",le="0.05"} 1`))
+	})
+
+	It("exposes the database connection pool", func() {
+		db, _, err := sqlmock.New()
+		Expect(err).NotTo(HaveOccurred())
+		defer db.Close()
+
+		subject.RegisterDB(db, "doit-etre-db")
+
+		Expect(scrape()).To(ContainSubstring(`go_sql_open_connections{db_name="doit-etre-db"}`))
+	})
+})
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	dbpkg "github.com/tjarratt/doit-etre-rad/backend/db"
+)
+
+const migrateUsage = `usage: main migrate COMMAND [--dry-run] [--drop-tables]
+
+commands:
+  status          show the current version and any pending migrations
+  up [N]          run the next N migrations, or all of them
+  down N          roll back the last N migrations
+  force VERSION   mark VERSION as run without running anything,
+                  after cleaning up a failed migration by hand (-1 for none)
+
+--dry-run prints the SQL that up or down would run, without running it
+--drop-tables lets down roll back migrations that create tables,
+              which throws away everything in them`
+
+// runMigrateCommand is `main migrate ...`, for running migrations by hand,
+// e.g. with "cf run-task doit-etre-rad-backend './main migrate status'"
+func runMigrateCommand(conn *sql.DB, args []string, out io.Writer) error {
+	dryRun := false
+	dropTables := false
+	positional := []string{}
+	for _, arg := range args {
+		switch arg {
+		case "--dry-run", "-dry-run", "-n":
+			dryRun = true
+		case "--drop-tables", "-drop-tables":
+			dropTables = true
+		case "--help", "-help", "-h":
+			fmt.Fprintln(out, migrateUsage)
+			return nil
+		default:
+			positional = append(positional, arg)
+		}
+	}
+	if len(positional) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	migrator, err := dbpkg.NewMigrator(conn, out)
+	if err != nil {
+		return err
+	}
+
+	command, rest := positional[0], positional[1:]
+	switch command {
+	case "status":
+		return printMigrationStatus(migrator, out)
+	case "up", "down":
+		steps := 0
+		if len(rest) > 0 {
+			steps, err = strconv.Atoi(rest[0])
+			if err != nil || steps <= 0 {
+				return fmt.Errorf("'%s' is not a number of migrations", rest[0])
+			}
+		}
+
+		if dryRun {
+			plan, err := migrator.Plan(command, steps)
+			if err != nil {
+				return err
+			}
+			return printMigrationPlan(plan, out)
+		}
+
+		var ran []dbpkg.Migration
+		if command == dbpkg.UP {
+			ran, err = migrator.Up(steps)
+		} else {
+			ran, err = migrator.Down(steps, dropTables)
+		}
+		if errors.Is(err, dbpkg.ErrDropsTables) {
+			return fmt.Errorf("%s, run it again with --drop-tables if that's really what you want", err)
+		}
+		if err != nil {
+			return err
+		}
+		if len(ran) == 0 {
+			fmt.Fprintln(out, "nothing to do, the database is up to date")
+		}
+		return printMigrationStatus(migrator, out)
+	case "force":
+		if len(rest) != 1 {
+			return errors.New("force needs exactly one VERSION")
+		}
+		version, err := strconv.Atoi(rest[0])
+		if err != nil {
+			return fmt.Errorf("'%s' is not a migration version", rest[0])
+		}
+		if dryRun {
+			fmt.Fprintf(out, "would mark the database as being at migration %d\n", version)
+			return nil
+		}
+		if err = migrator.Force(version); err != nil {
+			return err
+		}
+		return printMigrationStatus(migrator, out)
+	default:
+		return fmt.Errorf("unknown migrate command '%s'\n\n%s", command, migrateUsage)
+	}
+}
+
+func printMigrationStatus(migrator *dbpkg.Migrator, out io.Writer) error {
+	status, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+
+	if status.Version == 0 {
+		fmt.Fprintln(out, "no migrations have been run")
+	} else {
+		fmt.Fprintf(out, "database is at migration %d of %d\n", status.Version, status.Latest)
+	}
+	if status.Dirty {
+		fmt.Fprintf(out, "migration %d failed and left the database dirty, fix it by hand then run 'migrate force VERSION'\n", status.Version)
+		return nil
+	}
+
+	if len(status.Pending) == 0 {
+		fmt.Fprintln(out, "no pending migrations")
+	}
+	for _, migration := range status.Pending {
+		fmt.Fprintf(out, "pending: %s\n", migration.FileName)
+	}
+
+	return nil
+}
+
+func printMigrationPlan(plan []dbpkg.Migration, out io.Writer) error {
+	if len(plan) == 0 {
+		fmt.Fprintln(out, "nothing to do, the database is up to date")
+		return nil
+	}
+
+	for _, migration := range plan {
+		sql, err := migration.SQL()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "-- %s\n%s\n", migration.FileName, sql)
+	}
+	fmt.Fprintln(out, "-- dry run, nothing was changed")
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit allows a burst of Requests, refilled evenly over Per.
+// The zero Limit means unlimited
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+func (limit Limit) IsUnlimited() bool {
+	return limit.Requests <= 0 || limit.Per <= 0
+}
+
+func (limit Limit) String() string {
+	return fmt.Sprintf("%d/%s", limit.Requests, limit.Per)
+}
+
+// ParseLimit reads limits written as "30/1m"
+func ParseLimit(value string) (Limit, error) {
+	parts := strings.SplitN(value, "/", 2)
+	if len(parts) != 2 {
+		return Limit{}, fmt.Errorf("malformed rate limit '%s', expected something like 30/1m", value)
+	}
+
+	requests, err := strconv.Atoi(parts[0])
+	if err != nil || requests <= 0 {
+		return Limit{}, fmt.Errorf("malformed rate limit '%s', expected a positive number of requests", value)
+	}
+
+	per, err := time.ParseDuration(parts[1])
+	if err != nil || per <= 0 {
+		return Limit{}, fmt.Errorf("malformed rate limit '%s', expected a positive duration", value)
+	}
+
+	return Limit{Requests: requests, Per: per}, nil
+}
+
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+	Reset      time.Duration
+}
+
+// Store keeps a token bucket per key. The in memory store is fine for a
+// single instance; running several means implementing this on top of
+// something they share, or each instance hands out its own allowance
+//
+//go:generate counterfeiter . Store
+type Store interface {
+	Take(key string, limit Limit) (Result, error)
+}
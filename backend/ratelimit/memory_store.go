@@ -0,0 +1,86 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+func NewMemoryStore(clock func() time.Time) Store {
+	return &memoryStore{
+		clock:     clock,
+		buckets:   map[string]*bucket{},
+		lastSweep: clock(),
+	}
+}
+
+type memoryStore struct {
+	clock func() time.Time
+
+	mutex     sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	fullAt  time.Time
+}
+
+func (store *memoryStore) Take(key string, limit Limit) (Result, error) {
+	if limit.IsUnlimited() {
+		return Result{Allowed: true}, nil
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	now := store.clock()
+	store.sweep(now)
+
+	capacity := float64(limit.Requests)
+	perToken := limit.Per / time.Duration(limit.Requests)
+
+	b, ok := store.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, updated: now}
+		store.buckets[key] = b
+	}
+
+	elapsed := now.Sub(b.updated)
+	b.tokens = math.Min(capacity, b.tokens+float64(elapsed)/float64(perToken))
+	b.updated = now
+
+	result := Result{Limit: limit.Requests}
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - b.tokens) * float64(perToken))
+	}
+
+	result.Remaining = int(b.tokens)
+	result.Reset = time.Duration((capacity - b.tokens) * float64(perToken))
+	b.fullAt = now.Add(result.Reset)
+
+	return result, nil
+}
+
+// sweep forgets buckets that have refilled, since a new bucket
+// would start out full anyway. Without it every client we have
+// ever seen would stay in memory
+func (store *memoryStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < sweepInterval {
+		return
+	}
+	store.lastSweep = now
+
+	for key, b := range store.buckets {
+		if !now.Before(b.fullAt) {
+			delete(store.buckets, key)
+		}
+	}
+}
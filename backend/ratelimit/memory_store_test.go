@@ -0,0 +1,83 @@
+package ratelimit_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/ratelimit"
+)
+
+var _ = Describe("MemoryStore", func() {
+	var (
+		now   time.Time
+		store Store
+		limit Limit
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2018, 3, 1, 12, 0, 0, 0, time.UTC)
+		store = NewMemoryStore(func() time.Time { return now })
+		limit = Limit{Requests: 3, Per: 3 * time.Second}
+	})
+
+	take := func(key string) Result {
+		result, err := store.Take(key, limit)
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+
+	It("allows a burst of requests up to the limit", func() {
+		Expect(take("user")).To(Equal(Result{Allowed: true, Limit: 3, Remaining: 2, Reset: time.Second}))
+		Expect(take("user")).To(Equal(Result{Allowed: true, Limit: 3, Remaining: 1, Reset: 2 * time.Second}))
+		Expect(take("user")).To(Equal(Result{Allowed: true, Limit: 3, Remaining: 0, Reset: 3 * time.Second}))
+	})
+
+	It("refuses requests once the bucket is empty, saying when to retry", func() {
+		take("user")
+		take("user")
+		take("user")
+
+		now = now.Add(500 * time.Millisecond)
+		result := take("user")
+		Expect(result.Allowed).To(BeFalse())
+		Expect(result.Remaining).To(Equal(0))
+		Expect(result.RetryAfter).To(Equal(500 * time.Millisecond))
+	})
+
+	It("refills the bucket over time", func() {
+		take("user")
+		take("user")
+		take("user")
+
+		now = now.Add(time.Second)
+		Expect(take("user").Allowed).To(BeTrue())
+		Expect(take("user").Allowed).To(BeFalse())
+	})
+
+	It("keeps a separate bucket per key", func() {
+		take("user")
+		take("user")
+		take("user")
+
+		Expect(take("someone else").Allowed).To(BeTrue())
+	})
+
+	It("never refuses an unlimited request", func() {
+		limit = Limit{}
+		for i := 0; i < 10; i++ {
+			Expect(take("user").Allowed).To(BeTrue())
+		}
+	})
+
+	It("forgets buckets that have refilled", func() {
+		take("user")
+		take("user")
+		take("user")
+
+		now = now.Add(time.Hour)
+		take("someone else")
+
+		Expect(take("user").Remaining).To(Equal(2))
+	})
+})
@@ -0,0 +1,101 @@
+// This file was generated by counterfeiter
+package ratelimitfakes
+
+import (
+	"sync"
+
+	"github.com/tjarratt/doit-etre-rad/backend/ratelimit"
+)
+
+type FakeStore struct {
+	TakeStub        func(string, ratelimit.Limit) (ratelimit.Result, error)
+	takeMutex       sync.RWMutex
+	takeArgsForCall []struct {
+		arg1 string
+		arg2 ratelimit.Limit
+	}
+	takeReturns struct {
+		result1 ratelimit.Result
+		result2 error
+	}
+	takeReturnsOnCall map[int]struct {
+		result1 ratelimit.Result
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStore) Take(arg1 string, arg2 ratelimit.Limit) (ratelimit.Result, error) {
+	fake.takeMutex.Lock()
+	ret, specificReturn := fake.takeReturnsOnCall[len(fake.takeArgsForCall)]
+	fake.takeArgsForCall = append(fake.takeArgsForCall, struct {
+		arg1 string
+		arg2 ratelimit.Limit
+	}{arg1, arg2})
+	fake.recordInvocation("Take", []interface{}{arg1, arg2})
+	fake.takeMutex.Unlock()
+	if fake.TakeStub != nil {
+		return fake.TakeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.takeReturns.result1, fake.takeReturns.result2
+}
+
+func (fake *FakeStore) TakeCallCount() int {
+	fake.takeMutex.RLock()
+	defer fake.takeMutex.RUnlock()
+	return len(fake.takeArgsForCall)
+}
+
+func (fake *FakeStore) TakeArgsForCall(i int) (string, ratelimit.Limit) {
+	fake.takeMutex.RLock()
+	defer fake.takeMutex.RUnlock()
+	return fake.takeArgsForCall[i].arg1, fake.takeArgsForCall[i].arg2
+}
+
+func (fake *FakeStore) TakeReturns(result1 ratelimit.Result, result2 error) {
+	fake.TakeStub = nil
+	fake.takeReturns = struct {
+		result1 ratelimit.Result
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) TakeReturnsOnCall(i int, result1 ratelimit.Result, result2 error) {
+	fake.TakeStub = nil
+	if fake.takeReturnsOnCall == nil {
+		fake.takeReturnsOnCall = make(map[int]struct {
+			result1 ratelimit.Result
+			result2 error
+		})
+	}
+	fake.takeReturnsOnCall[i] = struct {
+		result1 ratelimit.Result
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.takeMutex.RLock()
+	defer fake.takeMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeStore) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ ratelimit.Store = new(FakeStore)
@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strings"
+)
+
+const defaultRoute = "default"
+
+// DefaultRules keeps a client stuck retrying a sync from hammering the
+// database, while leaving plenty of room for people actually practising
+const DefaultRules = "default user=300/1m ip=1200/1m; " +
+	"POST /api/phrases/french user=60/1m ip=240/1m; " +
+	"POST /api/phrases/english user=60/1m ip=240/1m"
+
+// Rule limits each user and each client IP separately
+type Rule struct {
+	PerUser Limit
+	PerIP   Limit
+}
+
+type Rules struct {
+	Default Rule
+	Routes  map[string]Rule
+}
+
+// For finds the rule for a method and route template, like "POST /api/phrases/french"
+func (rules Rules) For(method string, template string) Rule {
+	if rule, ok := rules.Routes[method+" "+template]; ok {
+		return rule
+	}
+
+	return rules.Default
+}
+
+// ParseRules reads rules separated by semicolons, each a route (or "default")
+// followed by its limits, e.g. "POST /api/phrases/french user=30/1m ip=120/1m".
+// A rule without a user or ip limit leaves that unlimited
+func ParseRules(value string) (Rules, error) {
+	rules := Rules{Routes: map[string]Rule{}}
+
+	for _, entry := range strings.Split(value, ";") {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 {
+			continue
+		}
+
+		route := []string{}
+		rule := Rule{}
+		for _, field := range fields {
+			keyValue := strings.SplitN(field, "=", 2)
+			if len(keyValue) != 2 {
+				route = append(route, field)
+				continue
+			}
+
+			limit, err := ParseLimit(keyValue[1])
+			if err != nil {
+				return Rules{}, err
+			}
+
+			switch keyValue[0] {
+			case "user":
+				rule.PerUser = limit
+			case "ip":
+				rule.PerIP = limit
+			default:
+				return Rules{}, fmt.Errorf("unknown rate limit '%s', expected user or ip", keyValue[0])
+			}
+		}
+
+		name := strings.Join(route, " ")
+		switch {
+		case name == defaultRoute:
+			rules.Default = rule
+		case len(route) == 2:
+			rules.Routes[name] = rule
+		default:
+			return Rules{}, fmt.Errorf("malformed rate limit rule '%s', expected a method and a route", strings.TrimSpace(entry))
+		}
+	}
+
+	return rules, nil
+}
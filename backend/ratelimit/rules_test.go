@@ -0,0 +1,48 @@
+package ratelimit_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/ratelimit"
+)
+
+var _ = Describe("Rules", func() {
+	It("parses a default and per route rules", func() {
+		rules, err := ParseRules("default user=300/1m ip=1200/1m; POST /api/phrases/french user=30/10s")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(rules.For("GET", "/api/phrases/french")).To(Equal(Rule{
+			PerUser: Limit{Requests: 300, Per: time.Minute},
+			PerIP:   Limit{Requests: 1200, Per: time.Minute},
+		}))
+		Expect(rules.For("POST", "/api/phrases/french")).To(Equal(Rule{
+			PerUser: Limit{Requests: 30, Per: 10 * time.Second},
+		}))
+	})
+
+	It("parses the default rules", func() {
+		_, err := ParseRules(DefaultRules)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("rejects malformed limits", func() {
+		_, err := ParseRules("default user=lots")
+		Expect(err).To(MatchError("malformed rate limit 'lots', expected something like 30/1m"))
+
+		_, err = ParseRules("default user=0/1m")
+		Expect(err).To(MatchError("malformed rate limit '0/1m', expected a positive number of requests"))
+
+		_, err = ParseRules("default user=10/fortnight")
+		Expect(err).To(MatchError("malformed rate limit '10/fortnight', expected a positive duration"))
+	})
+
+	It("rejects unknown keys and routes without a method", func() {
+		_, err := ParseRules("default session=10/1m")
+		Expect(err).To(MatchError("unknown rate limit 'session', expected user or ip"))
+
+		_, err = ParseRules("/api/phrases/french user=10/1m")
+		Expect(err).To(MatchError("malformed rate limit rule '/api/phrases/french user=10/1m', expected a method and a route"))
+	})
+})
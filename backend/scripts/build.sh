@@ -6,12 +6,8 @@ set -ex
 cd $(dirname $0)/..
 rm -rf tmp/*
 
-# put migrations into place
-mkdir -p tmp/db/migrations
-cp db/migrations/*.sql tmp/db/migrations
-
-# build our application
-go build main.go
+# build our application, the migrations are embedded in it
+go build -o main .
 
 # move our application into place
 mv main tmp/
@@ -0,0 +1,238 @@
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+)
+
+const exactMatchScore = 1.0
+const stemMatchScore = 0.75
+const prefixMatchScore = 0.5
+const minimumPrefixLength = 3
+const snippetLength = 120
+
+type Field struct {
+	Name     string
+	Text     string
+	Language Language
+	Weight   float64
+}
+
+type Document struct {
+	ID     string
+	Fields []Field
+}
+
+type Result struct {
+	ID         string
+	Score      float64
+	Highlights map[string]string
+}
+
+type occurrence struct {
+	document int
+	field    int
+	start    int
+	end      int
+}
+
+type Index struct {
+	documents   []Document
+	terms       map[string][]occurrence
+	stems       map[string][]occurrence
+	sortedTerms []string
+}
+
+func NewIndex(documents []Document) *Index {
+	index := &Index{
+		documents: documents,
+		terms:     map[string][]occurrence{},
+		stems:     map[string][]occurrence{},
+	}
+
+	for d, document := range documents {
+		for f, field := range document.Fields {
+			for _, t := range tokenize(field.Text) {
+				o := occurrence{document: d, field: f, start: t.start, end: t.end}
+				if _, ok := index.terms[t.folded]; !ok {
+					index.sortedTerms = append(index.sortedTerms, t.folded)
+				}
+				index.terms[t.folded] = append(index.terms[t.folded], o)
+
+				key := stemKey(Stem(t.folded, field.Language), field.Language)
+				index.stems[key] = append(index.stems[key], o)
+			}
+		}
+	}
+
+	sort.Strings(index.sortedTerms)
+	return index
+}
+
+// Search returns the documents matching every word of the query,
+// best matches first. Exact words rank above words sharing the same stem,
+// which in turn rank above words that merely start with the query
+func (index *Index) Search(query string) []Result {
+	queryTokens := tokenize(query)
+	if len(queryTokens) == 0 {
+		return []Result{}
+	}
+
+	scores := map[int]float64{}
+	matches := map[int][]occurrence{}
+	for i, t := range queryTokens {
+		termScores, termMatches := index.match(t.folded)
+
+		for document, score := range termScores {
+			if i == 0 {
+				scores[document] = score
+			} else if _, ok := scores[document]; ok {
+				scores[document] += score
+			}
+			matches[document] = append(matches[document], termMatches[document]...)
+		}
+
+		// every word of the query has to match somewhere in the document
+		for document := range scores {
+			if _, ok := termScores[document]; !ok {
+				delete(scores, document)
+			}
+		}
+	}
+
+	results := []Result{}
+	for document, score := range scores {
+		results = append(results, Result{
+			ID:         index.documents[document].ID,
+			Score:      score,
+			Highlights: index.highlight(document, matches[document]),
+		})
+	}
+
+	order := map[string]int{}
+	for d, document := range index.documents {
+		order[document.ID] = d
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return order[results[i].ID] < order[results[j].ID]
+	})
+
+	return results
+}
+
+// match scores every document containing the term, keeping only the best
+// scoring occurrence per document, along with every occurrence that matched
+func (index *Index) match(term string) (map[int]float64, map[int][]occurrence) {
+	scores := map[int]float64{}
+	matches := map[int][]occurrence{}
+
+	record := func(occurrences []occurrence, score float64) {
+		for _, o := range occurrences {
+			weighted := score * index.documents[o.document].Fields[o.field].Weight
+			if weighted > scores[o.document] {
+				scores[o.document] = weighted
+			}
+			matches[o.document] = append(matches[o.document], o)
+		}
+	}
+
+	record(index.terms[term], exactMatchScore)
+
+	for _, language := range []Language{FRENCH, ENGLISH} {
+		stemmed := []occurrence{}
+		for _, o := range index.stems[stemKey(Stem(term, language), language)] {
+			if index.documents[o.document].Fields[o.field].Language == language {
+				stemmed = append(stemmed, o)
+			}
+		}
+		record(stemmed, stemMatchScore)
+	}
+
+	if len(term) >= minimumPrefixLength {
+		i := sort.SearchStrings(index.sortedTerms, term)
+		for ; i < len(index.sortedTerms) && strings.HasPrefix(index.sortedTerms[i], term); i++ {
+			record(index.terms[index.sortedTerms[i]], prefixMatchScore)
+		}
+	}
+
+	return scores, matches
+}
+
+func (index *Index) highlight(document int, occurrences []occurrence) map[string]string {
+	byField := map[int][]occurrence{}
+	for _, o := range occurrences {
+		byField[o.field] = append(byField[o.field], o)
+	}
+
+	highlights := map[string]string{}
+	for f, fieldOccurrences := range byField {
+		field := index.documents[document].Fields[f]
+		highlights[field.Name] = snippet(field.Text, fieldOccurrences)
+	}
+
+	return highlights
+}
+
+// snippet escapes the text for html and wraps every match in <mark> tags,
+// trimming long texts down to a window around the first match
+func snippet(text string, occurrences []occurrence) string {
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].start < occurrences[j].start
+	})
+
+	windowStart, windowEnd := 0, len(text)
+	if len(text) > snippetLength {
+		windowStart = wordBoundaryBefore(text, occurrences[0].start-snippetLength/3)
+		windowEnd = wordBoundaryBefore(text, windowStart+snippetLength)
+		if windowEnd <= occurrences[0].end {
+			windowEnd = occurrences[0].end
+		}
+	}
+
+	result := strings.Builder{}
+	if windowStart > 0 {
+		result.WriteString("…")
+	}
+
+	position := windowStart
+	for _, o := range occurrences {
+		if o.start < position || o.end > windowEnd {
+			continue
+		}
+		result.WriteString(html.EscapeString(text[position:o.start]))
+		result.WriteString("<mark>")
+		result.WriteString(html.EscapeString(text[o.start:o.end]))
+		result.WriteString("</mark>")
+		position = o.end
+	}
+	result.WriteString(html.EscapeString(text[position:windowEnd]))
+
+	if windowEnd < len(text) {
+		result.WriteString("…")
+	}
+
+	return result.String()
+}
+
+func wordBoundaryBefore(text string, offset int) int {
+	if offset <= 0 {
+		return 0
+	}
+	if offset >= len(text) {
+		return len(text)
+	}
+
+	if space := strings.LastIndexByte(text[:offset], ' '); space >= 0 {
+		return space + 1
+	}
+
+	return 0
+}
+
+func stemKey(stem string, language Language) string {
+	return string(language) + ":" + stem
+}
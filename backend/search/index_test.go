@@ -0,0 +1,98 @@
+package search_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/search"
+)
+
+var _ = Describe("Index", func() {
+	var subject *Index
+
+	BeforeEach(func() {
+		subject = NewIndex([]Document{
+			document("horses", "Les chevaux sont rapides", "The horses are fast"),
+			document("coffee", "Un café, s'il vous plaît", "A coffee, please"),
+			document("summer", "L'été dernier", "Last summer"),
+			document("running", "Je cours", "I am running"),
+		})
+	})
+
+	ids := func(results []Result) []string {
+		found := []string{}
+		for _, r := range results {
+			found = append(found, r.ID)
+		}
+		return found
+	}
+
+	It("ignores accents and case", func() {
+		Expect(ids(subject.Search("CAFE"))).To(Equal([]string{"coffee"}))
+		Expect(ids(subject.Search("ete"))).To(Equal([]string{"summer"}))
+	})
+
+	It("matches french words sharing the same stem", func() {
+		Expect(ids(subject.Search("cheval"))).To(Equal([]string{"horses"}))
+	})
+
+	It("matches english words sharing the same stem", func() {
+		Expect(ids(subject.Search("run"))).To(Equal([]string{"running"}))
+		Expect(ids(subject.Search("horse"))).To(Equal([]string{"horses"}))
+	})
+
+	It("matches the beginning of words", func() {
+		Expect(ids(subject.Search("summ"))).To(Equal([]string{"summer"}))
+	})
+
+	It("requires every word of the query to match", func() {
+		Expect(ids(subject.Search("chevaux fast"))).To(Equal([]string{"horses"}))
+		Expect(ids(subject.Search("chevaux coffee"))).To(BeEmpty())
+	})
+
+	It("ranks exact matches above partial ones", func() {
+		subject = NewIndex([]Document{
+			document("prefix", "les chats", "cats"),
+			document("exact", "le chat", "the cat"),
+		})
+
+		Expect(ids(subject.Search("chat"))).To(Equal([]string{"exact", "prefix"}))
+	})
+
+	It("highlights the matching words", func() {
+		results := subject.Search("cafe")
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Highlights).To(Equal(map[string]string{
+			"content": "Un <mark>café</mark>, s&#39;il vous plaît",
+		}))
+	})
+
+	It("trims long texts around the first match", func() {
+		long := "Il était une fois, dans un pays lointain, une petite fille qui vivait avec sa grand-mère au bord de la forêt profonde et sombre"
+		subject = NewIndex([]Document{document("long", long, "once upon a time")})
+
+		results := subject.Search("sombre")
+		Expect(results).To(HaveLen(1))
+		Expect(results[0].Highlights["content"]).To(HavePrefix("…"))
+		Expect(results[0].Highlights["content"]).To(HaveSuffix("<mark>sombre</mark>"))
+	})
+
+	It("returns nothing for an empty query", func() {
+		Expect(subject.Search("  ")).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Fold", func() {
+	It("lowercases and strips accents", func() {
+		Expect(Fold("Œuvre Ça Été")).To(Equal("oeuvre ca ete"))
+	})
+})
+
+func document(id, content, translation string) Document {
+	return Document{
+		ID: id,
+		Fields: []Field{
+			{Name: "content", Text: content, Language: FRENCH, Weight: 2},
+			{Name: "translation", Text: translation, Language: ENGLISH, Weight: 1},
+		},
+	}
+}
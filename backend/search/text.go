@@ -0,0 +1,153 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+type Language string
+
+const FRENCH Language = "fr"
+const ENGLISH Language = "en"
+
+type token struct {
+	folded string
+	start  int
+	end    int
+}
+
+var foldedRunes = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a",
+	'æ': "ae",
+	'ç': "c",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i",
+	'ñ': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o",
+	'œ': "oe",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u",
+	'ý': "y", 'ÿ': "y",
+	'ß': "ss",
+}
+
+// Fold lowercases a word and strips the accents from it,
+// so that "Été" and "ete" are considered the same word
+func Fold(word string) string {
+	folded := strings.Builder{}
+	for _, r := range word {
+		r = unicode.ToLower(r)
+		if replacement, ok := foldedRunes[r]; ok {
+			folded.WriteString(replacement)
+		} else {
+			folded.WriteRune(r)
+		}
+	}
+
+	return folded.String()
+}
+
+// tokenize splits text into folded words, remembering the byte offsets
+// of each word in the original text so that matches can be highlighted
+func tokenize(text string) []token {
+	tokens := []token{}
+	start := -1
+	for i, r := range text {
+		isWordRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWordRune && start < 0 {
+			start = i
+		} else if !isWordRune && start >= 0 {
+			tokens = append(tokens, token{folded: Fold(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{folded: Fold(text[start:]), start: start, end: len(text)})
+	}
+
+	return tokens
+}
+
+// FindPhrase finds the first place a phrase is used in a sentence, word for
+// word, whatever the accents, case or inflections, so "chevaux" is found
+// in "J'aime les chevaux" for the phrase "cheval". It returns the byte
+// offsets of the words in the sentence, or false if the phrase isn't there
+func FindPhrase(sentence string, phrase string, language Language) (int, int, bool) {
+	words := tokenize(phrase)
+	tokens := tokenize(sentence)
+	if len(words) == 0 {
+		return 0, 0, false
+	}
+
+	for i := 0; i+len(words) <= len(tokens); i++ {
+		matched := true
+		for j, word := range words {
+			if Stem(tokens[i+j].folded, language) != Stem(word.folded, language) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return tokens[i].start, tokens[i+len(words)-1].end, true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// Stem strips the most common inflections from a folded word.
+// This is nowhere near a real stemmer, but it is enough for
+// "chevaux" to find "cheval" and "running" to find "run"
+func Stem(word string, language Language) string {
+	switch language {
+	case FRENCH:
+		return stemFrench(word)
+	case ENGLISH:
+		return stemEnglish(word)
+	default:
+		return word
+	}
+}
+
+func stemFrench(word string) string {
+	switch {
+	case len(word) > 5 && strings.HasSuffix(word, "eaux"):
+		return strings.TrimSuffix(word, "x")
+	case len(word) > 4 && strings.HasSuffix(word, "aux"):
+		return strings.TrimSuffix(word, "aux") + "al"
+	case len(word) > 3 && (strings.HasSuffix(word, "s") || strings.HasSuffix(word, "x")):
+		word = word[:len(word)-1]
+	}
+
+	if len(word) > 3 && strings.HasSuffix(word, "e") {
+		word = word[:len(word)-1]
+	}
+
+	return word
+}
+
+func stemEnglish(word string) string {
+	switch {
+	case len(word) > 4 && strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case len(word) > 4 && strings.HasSuffix(word, "sses"):
+		return strings.TrimSuffix(word, "es")
+	case len(word) > 5 && strings.HasSuffix(word, "ing"):
+		return undouble(strings.TrimSuffix(word, "ing"))
+	case len(word) > 4 && strings.HasSuffix(word, "ed"):
+		return undouble(strings.TrimSuffix(word, "ed"))
+	case len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return strings.TrimSuffix(word, "s")
+	}
+
+	return word
+}
+
+// undouble turns "runn" (from "running") back into "run"
+func undouble(word string) string {
+	n := len(word)
+	if n > 2 && word[n-1] == word[n-2] && !strings.ContainsRune("lsz", rune(word[n-1])) {
+		return word[:n-1]
+	}
+
+	return word
+}
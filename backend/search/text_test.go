@@ -0,0 +1,35 @@
+package search_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/search"
+)
+
+var _ = Describe("FindPhrase", func() {
+	It("finds the phrase whatever its case, accents or inflections", func() {
+		sentence := "Les Chevaux sont dans le pré."
+		start, end, ok := FindPhrase(sentence, "cheval", FRENCH)
+		Expect(ok).To(BeTrue())
+		Expect(sentence[start:end]).To(Equal("Chevaux"))
+
+		start, end, ok = FindPhrase(sentence, "dans le pre", FRENCH)
+		Expect(ok).To(BeTrue())
+		Expect(sentence[start:end]).To(Equal("dans le pré"))
+
+		sentence = "Il fait très chaud en été !"
+		start, end, ok = FindPhrase(sentence, "ete", FRENCH)
+		Expect(ok).To(BeTrue())
+		Expect(sentence[start:end]).To(Equal("été"))
+	})
+
+	It("only matches whole words", func() {
+		_, _, ok := FindPhrase("The category is empty", "cat", ENGLISH)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("doesn't find an empty phrase", func() {
+		_, _, ok := FindPhrase("Anything at all", " ... ", ENGLISH)
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+)
+
+const DefaultServiceName = "doit-etre-rad"
+
+// NewProviderFromEnvironment reads the standard OpenTelemetry variables:
+// OTEL_TRACES_EXPORTER picks "otlp", "console" or "none", and the OTLP
+// exporter reads OTEL_EXPORTER_OTLP_ENDPOINT and OTEL_EXPORTER_OTLP_HEADERS
+// itself. Tracing stays off (a nil provider) unless one of them is set.
+//
+// Spans are exported in batches from a bounded queue, so a slow collector
+// drops spans rather than holding on to them
+func NewProviderFromEnvironment(ctx context.Context, console io.Writer) (*sdktrace.TracerProvider, error) {
+	kind := os.Getenv("OTEL_TRACES_EXPORTER")
+	if kind == "" && os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" {
+		kind = "otlp"
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch kind {
+	case "", "none":
+		return nil, nil
+	case "console", "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(console))
+	case "otlp":
+		exporter, err = otlptracehttp.New(ctx)
+	default:
+		return nil, fmt.Errorf("unknown traces exporter '%s'", kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES win over the default name
+	serviceResource, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceNameKey.String(DefaultServiceName)),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(serviceResource),
+	), nil
+}
+
+// Install makes the provider the one Start uses, continues the caller's
+// trace from W3C traceparent headers, and reports spans that could not
+// be exported to errors instead of dropping them silently
+func Install(provider *sdktrace.TracerProvider, errors io.Writer) {
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		fmt.Fprintf(errors, "tracing: %s\n", err)
+	}))
+}
@@ -0,0 +1,104 @@
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+var _ = Describe("NewProviderFromEnvironment", func() {
+	var console *bytes.Buffer
+
+	BeforeEach(func() {
+		console = &bytes.Buffer{}
+		os.Unsetenv("OTEL_TRACES_EXPORTER")
+		os.Unsetenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+		os.Unsetenv("OTEL_SERVICE_NAME")
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("OTEL_TRACES_EXPORTER")
+		os.Unsetenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+		os.Unsetenv("OTEL_SERVICE_NAME")
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+
+	It("leaves tracing off when nothing is configured", func() {
+		provider, err := NewProviderFromEnvironment(context.Background(), console)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider).To(BeNil())
+	})
+
+	It("exports to a collector when it is given an OTLP endpoint", func() {
+		os.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4318")
+
+		provider, err := NewProviderFromEnvironment(context.Background(), console)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider).NotTo(BeNil())
+	})
+
+	It("rejects exporters it doesn't know", func() {
+		os.Setenv("OTEL_TRACES_EXPORTER", "zipkin")
+
+		_, err := NewProviderFromEnvironment(context.Background(), console)
+		Expect(err).To(MatchError("unknown traces exporter 'zipkin'"))
+	})
+
+	Context("with the console exporter", func() {
+		BeforeEach(func() {
+			os.Setenv("OTEL_TRACES_EXPORTER", "console")
+		})
+
+		It("writes the spans out, tagged with the service name, once they are flushed", func() {
+			provider, err := NewProviderFromEnvironment(context.Background(), console)
+			Expect(err).NotTo(HaveOccurred())
+			Install(provider, &bytes.Buffer{})
+
+			_, span := Start(context.Background(), "AddPhraseUseCase.Execute")
+			span.End()
+			Expect(provider.Shutdown(context.Background())).To(Succeed())
+
+			exported := map[string]interface{}{}
+			Expect(json.Unmarshal(console.Bytes(), &exported)).To(Succeed())
+			Expect(exported["Name"]).To(Equal("AddPhraseUseCase.Execute"))
+			Expect(console.String()).To(ContainSubstring(`"Value":"doit-etre-rad"`))
+		})
+
+		It("names the service after OTEL_SERVICE_NAME when it is set", func() {
+			os.Setenv("OTEL_SERVICE_NAME", "doit-etre-staging")
+
+			provider, err := NewProviderFromEnvironment(context.Background(), console)
+			Expect(err).NotTo(HaveOccurred())
+			Install(provider, &bytes.Buffer{})
+
+			_, span := Start(context.Background(), "AddPhraseUseCase.Execute")
+			span.End()
+			Expect(provider.Shutdown(context.Background())).To(Succeed())
+
+			Expect(console.String()).To(ContainSubstring(`"Value":"doit-etre-staging"`))
+		})
+	})
+
+	Describe("Install", func() {
+		It("reports errors from the SDK instead of dropping them", func() {
+			os.Setenv("OTEL_TRACES_EXPORTER", "console")
+			errorLog := &bytes.Buffer{}
+			provider, err := NewProviderFromEnvironment(context.Background(), console)
+			Expect(err).NotTo(HaveOccurred())
+			Install(provider, errorLog)
+
+			otel.Handle(errors.New("failed to upload spans"))
+
+			Expect(errorLog.String()).To(Equal("tracing: failed to upload spans\n"))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/tjarratt/doit-etre-rad/backend"
+
+// Start begins a span as a child of whatever span is in ctx, using the
+// global tracer provider. Until a provider is installed, the spans
+// record nothing, so code can be instrumented without caring whether
+// tracing has been set up
+func Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, Span) {
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, name, options...)
+	return ctx, Span{Span: span}
+}
+
+// Span is an OpenTelemetry span that marks itself as failed when it
+// records an error
+type Span struct {
+	trace.Span
+}
+
+// RecordError ignores nil errors, so it can be called
+// unconditionally with whatever came back
+func (span Span) RecordError(err error, options ...trace.EventOption) {
+	if err == nil {
+		return
+	}
+
+	span.Span.RecordError(err, options...)
+	span.Span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,81 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+var _ = Describe("Start", func() {
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	})
+
+	It("starts child spans in the same trace as their parent", func() {
+		ctx, parent := Start(context.Background(), "parent")
+		_, child := Start(ctx, "child")
+		child.SetAttributes(attribute.Int("phrases.count", 3))
+		child.End()
+		parent.End()
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Name()).To(Equal("child"))
+		Expect(spans[0].Parent().SpanID()).To(Equal(spans[1].SpanContext().SpanID()))
+		Expect(spans[0].SpanContext().TraceID()).To(Equal(spans[1].SpanContext().TraceID()))
+		Expect(spans[0].Attributes()).To(ContainElement(attribute.Int("phrases.count", 3)))
+	})
+
+	It("marks spans that record an error as failed", func() {
+		_, span := Start(context.Background(), "whoops")
+		span.RecordError(errors.New("whoops"))
+		span.End()
+
+		spans := recorder.Ended()
+		Expect(spans[0].Status().Code).To(Equal(codes.Error))
+		Expect(spans[0].Status().Description).To(Equal("whoops"))
+		Expect(spans[0].Events()).To(HaveLen(1))
+	})
+
+	It("ignores nil errors", func() {
+		_, span := Start(context.Background(), "fine")
+		span.RecordError(nil)
+		span.End()
+
+		spans := recorder.Ended()
+		Expect(spans[0].Status().Code).To(Equal(codes.Unset))
+		Expect(spans[0].Events()).To(BeEmpty())
+	})
+
+	Context("when tracing is not set up", func() {
+		BeforeEach(func() {
+			otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		})
+
+		It("hands out spans that record nothing", func() {
+			_, span := Start(context.Background(), "ignored")
+			span.RecordError(errors.New("whoops"))
+			span.End()
+
+			Expect(span.IsRecording()).To(BeFalse())
+			Expect(recorder.Ended()).To(BeEmpty())
+		})
+	})
+})
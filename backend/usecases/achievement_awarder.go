@@ -0,0 +1,138 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// AchievementResponse is a badge and whether the user has it yet
+type AchievementResponse struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Earned      bool       `json:"earned"`
+	AwardedAt   *time.Time `json:"awardedAt,omitempty"`
+}
+
+// AchievementAwarder checks the rules whenever a user does something,
+// and gives them any badges they have just earned
+//
+//go:generate counterfeiter . AchievementAwarder
+type AchievementAwarder interface {
+	Award(context.Context, uuid.UUID, achievements.Event) ([]AchievementResponse, error)
+}
+
+func NewAchievementAwarder(
+	repository api.AchievementsRepository,
+	progress api.ProgressRepository,
+	rules achievements.Rules,
+	clock func() time.Time,
+) AchievementAwarder {
+	return achievementAwarder{
+		repository: repository,
+		progress:   progress,
+		rules:      rules,
+		clock:      clock,
+	}
+}
+
+type achievementAwarder struct {
+	repository api.AchievementsRepository
+	progress   api.ProgressRepository
+	rules      achievements.Rules
+	clock      func() time.Time
+}
+
+// Award adds the user's totals and current streak to what the event
+// already knows. Anything the user does can lengthen their streak,
+// so the streak badges are checked along with every event
+func (awarder achievementAwarder) Award(ctx context.Context, userUuid uuid.UUID, event achievements.Event) (awarded []AchievementResponse, err error) {
+	ctx, span := tracing.Start(ctx, "AchievementAwarder.Award")
+	span.SetAttributes(attribute.String("achievements.event", string(event.Type)))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	facts, err := awarder.facts(ctx, userUuid)
+	if err != nil {
+		return nil, err
+	}
+	for name, value := range event.Facts {
+		facts[name] = value
+	}
+
+	existing, err := awarder.repository.AchievementsForUser(ctx, userUuid)
+	if err != nil {
+		return nil, err
+	}
+	has := map[string]bool{}
+	for _, achievement := range existing {
+		has[achievement.ID] = true
+	}
+
+	earned := awarder.rules.Evaluate(achievements.Event{Type: event.Type, Facts: facts}, has)
+	if event.Type != achievements.STREAK_REACHED {
+		earned = append(earned, awarder.rules.Evaluate(achievements.Event{
+			Type:  achievements.STREAK_REACHED,
+			Facts: facts,
+		}, has)...)
+	}
+
+	ids := []string{}
+	for _, badge := range earned {
+		ids = append(ids, badge.ID)
+	}
+	err = awarder.repository.AwardAchievementsForUser(ctx, ids, userUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	now := awarder.clock()
+	awarded = []AchievementResponse{}
+	for _, badge := range earned {
+		awarded = append(awarded, achievementResponse(badge, &now))
+	}
+
+	return awarded, nil
+}
+
+func (awarder achievementAwarder) facts(ctx context.Context, userUuid uuid.UUID) (achievements.Facts, error) {
+	totals, err := awarder.repository.TotalsForUser(ctx, userUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := awarder.progress.SettingsForUser(ctx, userUuid)
+	if err != nil {
+		return nil, err
+	}
+	days, err := awarder.progress.ProgressForUser(ctx, userUuid)
+	if err != nil {
+		return nil, err
+	}
+	today, _ := time.Parse("2006-01-02", api.LocalDay(awarder.clock(), settings.Timezone))
+
+	return achievements.Facts{
+		achievements.FACT_PHRASES:  totals.Phrases,
+		achievements.FACT_SESSIONS: totals.Sessions,
+		achievements.FACT_CARDS:    totals.CardsReviewed,
+		achievements.FACT_STREAK:   streaks(days, today).Current,
+	}, nil
+}
+
+func achievementResponse(badge achievements.Badge, awardedAt *time.Time) AchievementResponse {
+	return AchievementResponse{
+		ID:          badge.ID,
+		Name:        badge.Name,
+		Description: badge.Description,
+		Earned:      awardedAt != nil,
+		AwardedAt:   awardedAt,
+	}
+}
@@ -0,0 +1,98 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("AchievementAwarder", func() {
+	var subject AchievementAwarder
+	var fakeRepo *apifakes.FakeAchievementsRepository
+	var fakeProgress *apifakes.FakeProgressRepository
+	var event achievements.Event
+
+	var awarded []AchievementResponse
+	var err error
+
+	now := time.Date(2018, 3, 31, 18, 30, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		rules, err := achievements.ParseRules(`[
+			{"id": "first-phrase", "name": "First words", "on": "phrase.added", "when": {"phrases": {"atLeast": 1}}},
+			{"id": "first-session", "name": "Warming up", "on": "session.completed", "when": {"sessions": {"atLeast": 1}}},
+			{"id": "flawless", "name": "Flawless", "on": "session.completed", "when": {"sessionCards": {"atLeast": 10}, "sessionAccuracy": {"atLeast": 100}}},
+			{"id": "streak-3", "name": "Three in a row", "on": "streak.reached", "when": {"streak": {"atLeast": 3}}}
+		]`)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeRepo = new(apifakes.FakeAchievementsRepository)
+		fakeRepo.TotalsForUserReturns(api.AchievementTotals{Phrases: 12, Sessions: 4, CardsReviewed: 80}, nil)
+		fakeRepo.AchievementsForUserReturns([]api.AwardedAchievement{{ID: "first-session"}}, nil)
+
+		fakeProgress = new(apifakes.FakeProgressRepository)
+		fakeProgress.SettingsForUserReturns(api.UserSettings{Timezone: "UTC"}, nil)
+		fakeProgress.ProgressForUserReturns([]api.DailyProgress{
+			{Day: "2018-03-29", CardsReviewed: 10},
+			{Day: "2018-03-30", PhrasesAdded: 1},
+			{Day: "2018-03-31", CardsReviewed: 12},
+		}, nil)
+
+		subject = NewAchievementAwarder(fakeRepo, fakeProgress, rules, func() time.Time { return now })
+		event = achievements.Event{
+			Type:  achievements.SESSION_COMPLETED,
+			Facts: achievements.Facts{achievements.FACT_SESSION_CARDS: 12, achievements.FACT_SESSION_ACCURACY: 100},
+		}
+	})
+
+	JustBeforeEach(func() {
+		awarded, err = subject.Award(context.Background(), userUUID, event)
+	})
+
+	It("awards the badges earned by the event and the user's streak, skipping ones they have", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeRepo.AwardAchievementsForUserCallCount()).To(Equal(1))
+		_, ids, userUuid := fakeRepo.AwardAchievementsForUserArgsForCall(0)
+		Expect(userUuid).To(Equal(userUUID))
+		Expect(ids).To(Equal([]string{"flawless", "streak-3"}))
+
+		Expect(awarded).To(Equal([]AchievementResponse{
+			{ID: "flawless", Name: "Flawless", Earned: true, AwardedAt: &now},
+			{ID: "streak-3", Name: "Three in a row", Earned: true, AwardedAt: &now},
+		}))
+	})
+
+	Context("when the streak was broken", func() {
+		BeforeEach(func() {
+			fakeProgress.ProgressForUserReturns([]api.DailyProgress{
+				{Day: "2018-03-27", CardsReviewed: 10},
+				{Day: "2018-03-28", PhrasesAdded: 1},
+				{Day: "2018-03-29", CardsReviewed: 12},
+			}, nil)
+		})
+
+		It("doesn't count it", func() {
+			_, ids, _ := fakeRepo.AwardAchievementsForUserArgsForCall(0)
+			Expect(ids).To(Equal([]string{"flawless"}))
+		})
+	})
+
+	Context("when the totals can't be counted", func() {
+		BeforeEach(func() {
+			fakeRepo.TotalsForUserReturns(api.AchievementTotals{}, errors.New("RUH ROH"))
+		})
+
+		It("returns the error without awarding anything", func() {
+			Expect(err).To(MatchError("RUH ROH"))
+			Expect(fakeRepo.AwardAchievementsForUserCallCount()).To(Equal(0))
+		})
+	})
+})
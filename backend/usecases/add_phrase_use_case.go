@@ -1,8 +1,14 @@
 package usecases
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
 	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type PhraseResponse struct {
@@ -11,52 +17,121 @@ type PhraseResponse struct {
 	Translation string `json:"translation"`
 }
 
+// SyncedPhraseResponse is what each phrase in a sync was saved as.
+// A Duplicate wasn't saved: the user already has a phrase with the same
+// content but a different translation or spelling, and this is that phrase.
+// Sending exactly what was saved again isn't a duplicate
+type SyncedPhraseResponse struct {
+	PhraseResponse
+	Duplicate bool `json:"duplicate,omitempty"`
+}
+
 //go:generate counterfeiter . AddPhraseUseCase
 type AddPhraseUseCase interface {
-	Execute(AddPhraseRequest) ([]PhraseResponse, error)
+	Execute(context.Context, AddPhraseRequest) ([]SyncedPhraseResponse, error)
 }
 
 func NewAddPhraseUseCase(
 	repository api.PhrasesRepository,
+	awarder AchievementAwarder,
+	metrics PhraseMetrics,
 ) AddPhraseUseCase {
 	return addPhraseUseCase{
 		repository: repository,
+		awarder:    awarder,
+		metrics:    metrics,
 	}
 }
 
 type addPhraseUseCase struct {
 	repository api.PhrasesRepository
+	awarder    AchievementAwarder
+	metrics    PhraseMetrics
 }
 
-func (usecase addPhraseUseCase) Execute(request AddPhraseRequest) ([]PhraseResponse, error) {
-	response := []PhraseResponse{}
-	for _, phrase := range request.Phrases {
-		var p api.Phrase
-		var err error
-		if phrase.UUID != nil {
-			p, err = usecase.repository.UpdatePhraseForUserWithUUID(
-				phrase.Phrase,
-				phrase.Translation,
-				*phrase.UUID,
-				request.UserUUID,
-			)
-		} else {
-			p, err = usecase.repository.AddPhraseForUserWithUUID(
-				phrase.Phrase,
-				phrase.Translation,
-				request.UserUUID,
-			)
-		}
+func (usecase addPhraseUseCase) Execute(ctx context.Context, request AddPhraseRequest) (response []SyncedPhraseResponse, err error) {
+	ctx, span := tracing.Start(ctx, "AddPhraseUseCase.Execute")
+	span.SetAttributes(attribute.Int("phrases.count", len(request.Phrases)))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
 
-		if err != nil {
-			return []PhraseResponse{}, err
-		}
+	phrases := make([]AddPhraseItem, len(request.Phrases))
+	copy(phrases, request.Phrases)
+
+	fieldErrors := []FieldError{}
+	for i := range phrases {
+		fieldErrors = append(fieldErrors, validatePhraseText(fmt.Sprintf("phrases[%d].content", i), &phrases[i].Phrase, true)...)
+		fieldErrors = append(fieldErrors, validatePhraseText(fmt.Sprintf("phrases[%d].translation", i), &phrases[i].Translation, false)...)
+	}
+	if len(fieldErrors) > 0 {
+		return []SyncedPhraseResponse{}, ValidationError{Errors: fieldErrors}
+	}
 
-		response = append(response, PhraseResponse(p))
+	response, created, err := usecase.save(ctx, phrases, request.UserUUID)
+	if err != nil {
+		return []SyncedPhraseResponse{}, err
 	}
 
+	if created {
+		usecase.award(ctx, request.UserUUID)
+	}
 	return response, nil
+}
+
+// save answers a new phrase that has already been saved, because the client
+// is re-sending it or has it twice in the batch, with the phrase that was
+// saved first. Clients only know to replace what they sent with what they
+// get back, so failing the sync would have them send it again forever
+func (usecase addPhraseUseCase) save(ctx context.Context, phrases []AddPhraseItem, userUuid uuid.UUID) ([]SyncedPhraseResponse, bool, error) {
+	ctx, span := tracing.Start(ctx, "AddPhraseUseCase.save")
+	defer span.End()
+
+	syncs := make([]api.PhraseSync, len(phrases))
+	for i, phrase := range phrases {
+		syncs[i] = api.PhraseSync{
+			Content:     phrase.Phrase,
+			Translation: phrase.Translation,
+			Uuid:        phrase.UUID,
+		}
+	}
+
+	synced, err := usecase.repository.SyncPhrasesForUserWithUUID(ctx, syncs, userUuid)
+	if err != nil {
+		span.RecordError(err)
+		return []SyncedPhraseResponse{}, false, err
+	}
+
+	created := false
+	response := make([]SyncedPhraseResponse, len(synced))
+	for i, phrase := range synced {
+		switch {
+		case phrase.Created:
+			usecase.metrics.PhraseCreated()
+			created = true
+		case syncs[i].Uuid != nil:
+			usecase.metrics.PhraseUpdated()
+		}
+		response[i] = SyncedPhraseResponse{
+			PhraseResponse: PhraseResponse(phrase.Phrase),
+			Duplicate: phrase.Duplicate &&
+				(phrase.Content != syncs[i].Content || phrase.Translation != syncs[i].Translation),
+		}
+	}
+
+	usecase.metrics.SyncPerformed()
+	return response, created, nil
+}
+
+// award is best effort: the phrases are saved by now, and failing
+// the request would only have the client send them all again
+func (usecase addPhraseUseCase) award(ctx context.Context, userUuid uuid.UUID) {
+	ctx, span := tracing.Start(ctx, "AddPhraseUseCase.award")
+	defer span.End()
 
+	_, err := usecase.awarder.Award(ctx, userUuid, achievements.Event{Type: achievements.PHRASE_ADDED})
+	span.RecordError(err)
 }
 
 type AddPhraseRequest struct {
@@ -1,11 +1,15 @@
 package usecases_test
 
 import (
+	"context"
 	"errors"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
 	"github.com/tjarratt/doit-etre-rad/backend/api"
 	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -15,82 +19,257 @@ import (
 var _ = Describe("AddPhraseUseCase", func() {
 	var subject AddPhraseUseCase
 	var fakeRepo *apifakes.FakePhrasesRepository
+	var fakeAwarder *usecasesfakes.FakeAchievementAwarder
+	var fakeMetrics *usecasesfakes.FakePhraseMetrics
 
 	BeforeEach(func() {
 		fakeRepo = new(apifakes.FakePhrasesRepository)
-		subject = NewAddPhraseUseCase(fakeRepo)
+		fakeAwarder = new(usecasesfakes.FakeAchievementAwarder)
+		fakeMetrics = new(usecasesfakes.FakePhraseMetrics)
+		subject = NewAddPhraseUseCase(fakeRepo, fakeAwarder, fakeMetrics)
 	})
 
-	var response []PhraseResponse
+	var response []SyncedPhraseResponse
 	var err error
 
+	var firstPhrase string
+	var ctx context.Context
+
+	BeforeEach(func() {
+		firstPhrase = "I've got a lovely bunch of coconuts"
+		ctx = context.Background()
+	})
+
 	JustBeforeEach(func() {
-		request := AddPhraseRequest{
+		response, err = subject.Execute(ctx, AddPhraseRequest{
 			UserUUID: userUUID,
 			Phrases: []AddPhraseItem{{
-				Phrase:      "I've got a lovely bunch of coconuts",
+				Phrase:      firstPhrase,
 				Translation: "whoops",
 			}, {
 				Phrase:      "There they are all standing in a row",
 				Translation: "oh my",
 				UUID:        &phraseUUID,
 			}},
-		}
-		response, err = subject.Execute(request)
+		})
 	})
 
 	Context("when the repository agrees to save things", func() {
 		BeforeEach(func() {
-			fakeRepo.AddPhraseForUserWithUUIDStub = addStub
-			fakeRepo.UpdatePhraseForUserWithUUIDStub = updateStub
+			fakeRepo.SyncPhrasesForUserWithUUIDStub = syncStub
 		})
 
-		It("saves new phrases", func() {
-			Expect(fakeRepo.AddPhraseForUserWithUUIDCallCount()).To(Equal(1))
-		})
-
-		It("updates existing phrases", func() {
-			Expect(fakeRepo.UpdatePhraseForUserWithUUIDCallCount()).To(Equal(1))
+		It("saves the whole batch at once", func() {
+			Expect(fakeRepo.SyncPhrasesForUserWithUUIDCallCount()).To(Equal(1))
+			_, phrases, userUuid := fakeRepo.SyncPhrasesForUserWithUUIDArgsForCall(0)
+			Expect(userUuid).To(Equal(userUUID))
+			Expect(phrases).To(Equal([]api.PhraseSync{{
+				Content:     "I've got a lovely bunch of coconuts",
+				Translation: "whoops",
+			}, {
+				Content:     "There they are all standing in a row",
+				Translation: "oh my",
+				Uuid:        &phraseUUID,
+			}}))
 		})
 
 		It("packages up all the saved values into a single response", func() {
 			Expect(response).To(HaveLen(2))
-			Expect(response[0]).To(Equal(PhraseResponse{
+			Expect(response[0]).To(Equal(SyncedPhraseResponse{PhraseResponse: PhraseResponse{
 				Uuid:        newPhraseUUID.String(),
 				Content:     "I've got a lovely bunch of coconuts",
 				Translation: "whoops",
-			}))
-			Expect(response[1]).To(Equal(PhraseResponse{
+			}}))
+			Expect(response[1]).To(Equal(SyncedPhraseResponse{PhraseResponse: PhraseResponse{
 				Uuid:        phraseUUID.String(),
 				Content:     "There they are all standing in a row",
 				Translation: "oh my",
-			}))
+			}}))
 		})
 
 		It("does not return an error", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("counts the sync and what it changed", func() {
+			Expect(fakeMetrics.PhraseCreatedCallCount()).To(Equal(1))
+			Expect(fakeMetrics.PhraseUpdatedCallCount()).To(Equal(1))
+			Expect(fakeMetrics.SyncPerformedCallCount()).To(Equal(1))
+		})
+
+		It("checks whether the new phrases earned any achievements", func() {
+			Expect(fakeAwarder.AwardCallCount()).To(Equal(1))
+			_, userUuid, event := fakeAwarder.AwardArgsForCall(0)
+			Expect(userUuid).To(Equal(userUUID))
+			Expect(event.Type).To(Equal(achievements.PHRASE_ADDED))
+		})
+
+		Context("when awarding achievements fails", func() {
+			BeforeEach(func() {
+				fakeAwarder.AwardReturns(nil, errors.New("RUH ROH"))
+			})
+
+			It("still reports the phrases as saved", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response).To(HaveLen(2))
+			})
+		})
 	})
 
-	Context("when the repository returns an error saving a phrase", func() {
+	Context("when a phrase needs tidying up", func() {
 		BeforeEach(func() {
-			fakeRepo.AddPhraseForUserWithUUIDStub = addStubReturnsErr
-			fakeRepo.UpdatePhraseForUserWithUUIDStub = updateStub
+			fakeRepo.SyncPhrasesForUserWithUUIDStub = syncStub
+			firstPhrase = "  I’ve got a lovely bunch of coconuts\n"
 		})
 
-		It("returns an error", func() {
-			Expect(err).To(HaveOccurred())
+		It("trims whitespace and straightens apostrophes before saving", func() {
+			Expect(err).NotTo(HaveOccurred())
+			_, phrases, _ := fakeRepo.SyncPhrasesForUserWithUUIDArgsForCall(0)
+			Expect(phrases[0].Content).To(Equal("I've got a lovely bunch of coconuts"))
+		})
+	})
+
+	Context("when a phrase is composed of decomposed characters", func() {
+		BeforeEach(func() {
+			fakeRepo.SyncPhrasesForUserWithUUIDStub = syncStub
+			firstPhrase = "e\u0301te\u0301"
+		})
+
+		It("normalizes it to its composed form", func() {
+			_, phrases, _ := fakeRepo.SyncPhrasesForUserWithUUIDArgsForCall(0)
+			Expect(phrases[0].Content).To(Equal("\u00e9t\u00e9"))
+		})
+	})
+
+	Context("when a phrase is invalid", func() {
+		BeforeEach(func() {
+			firstPhrase = "   "
+		})
+
+		It("returns an error for each invalid field", func() {
+			Expect(err).To(Equal(ValidationError{
+				Errors: []FieldError{{Field: "phrases[0].content", Message: "must not be empty"}},
+			}))
+		})
+
+		It("does not save anything", func() {
+			Expect(fakeRepo.SyncPhrasesForUserWithUUIDCallCount()).To(Equal(0))
 		})
 	})
 
-	Context("when the repository returns an error updating a phrase", func() {
+	Context("when a phrase is too long", func() {
 		BeforeEach(func() {
-			fakeRepo.AddPhraseForUserWithUUIDStub = addStub
-			fakeRepo.UpdatePhraseForUserWithUUIDStub = updateStubReturnsErr
+			firstPhrase = strings.Repeat("é", MaxPhraseLength+1)
 		})
 
 		It("returns an error", func() {
-			Expect(err).To(HaveOccurred())
+			Expect(err).To(Equal(ValidationError{
+				Errors: []FieldError{{Field: "phrases[0].content", Message: "must be at most 500 characters long"}},
+			}))
+		})
+	})
+
+	Context("when a new phrase has already been saved differently", func() {
+		BeforeEach(func() {
+			fakeRepo.SyncPhrasesForUserWithUUIDReturns([]api.SyncedPhrase{{
+				Phrase: api.Phrase{
+					Uuid:        newPhraseUUID.String(),
+					Content:     "i've got a lovely   bunch of coconuts",
+					Translation: "whoops",
+				},
+				Duplicate: true,
+			}, {
+				Phrase: api.Phrase{
+					Uuid:        phraseUUID.String(),
+					Content:     "There they are all standing in a row",
+					Translation: "oh my",
+				},
+			}}, nil)
+		})
+
+		It("responds with the existing phrase in its place, marked as a duplicate", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal([]SyncedPhraseResponse{{
+				PhraseResponse: PhraseResponse{
+					Uuid:        newPhraseUUID.String(),
+					Content:     "i've got a lovely   bunch of coconuts",
+					Translation: "whoops",
+				},
+				Duplicate: true,
+			}, {
+				PhraseResponse: PhraseResponse{
+					Uuid:        phraseUUID.String(),
+					Content:     "There they are all standing in a row",
+					Translation: "oh my",
+				},
+			}}))
+		})
+
+		It("doesn't count or check for achievements, as nothing new was added", func() {
+			Expect(fakeMetrics.PhraseCreatedCallCount()).To(Equal(0))
+			Expect(fakeMetrics.PhraseUpdatedCallCount()).To(Equal(1))
+			Expect(fakeAwarder.AwardCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a new phrase is sent again exactly as it was saved", func() {
+		BeforeEach(func() {
+			fakeRepo.SyncPhrasesForUserWithUUIDReturns([]api.SyncedPhrase{{
+				Phrase: api.Phrase{
+					Uuid:        newPhraseUUID.String(),
+					Content:     "I've got a lovely bunch of coconuts",
+					Translation: "whoops",
+				},
+				Duplicate: true,
+			}, {
+				Phrase: api.Phrase{
+					Uuid:        phraseUUID.String(),
+					Content:     "There they are all standing in a row",
+					Translation: "oh my",
+				},
+			}}, nil)
+		})
+
+		It("responds with the saved phrase as if it had just been saved", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response[0]).To(Equal(SyncedPhraseResponse{PhraseResponse: PhraseResponse{
+				Uuid:        newPhraseUUID.String(),
+				Content:     "I've got a lovely bunch of coconuts",
+				Translation: "whoops",
+			}}))
+		})
+	})
+
+	Context("when the request has a context", func() {
+		type key string
+
+		BeforeEach(func() {
+			ctx = context.WithValue(context.Background(), key("request"), "the-request")
+			fakeRepo.SyncPhrasesForUserWithUUIDStub = syncStub
+		})
+
+		It("saves the batch under it", func() {
+			Expect(err).NotTo(HaveOccurred())
+
+			syncCtx, _, _ := fakeRepo.SyncPhrasesForUserWithUUIDArgsForCall(0)
+			Expect(syncCtx.Value(key("request"))).To(Equal("the-request"))
+		})
+	})
+
+	Context("when the repository returns an error saving the batch", func() {
+		BeforeEach(func() {
+			fakeRepo.SyncPhrasesForUserWithUUIDReturns(nil, context.DeadlineExceeded)
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(Equal(context.DeadlineExceeded))
+			Expect(response).To(BeEmpty())
+		})
+
+		It("doesn't count a sync or check for achievements", func() {
+			Expect(fakeMetrics.SyncPerformedCallCount()).To(Equal(0))
+			Expect(fakeMetrics.PhraseCreatedCallCount()).To(Equal(0))
+			Expect(fakeAwarder.AwardCallCount()).To(Equal(0))
 		})
 	})
 })
@@ -99,26 +278,21 @@ var userUUID = uuid.Must(uuid.Parse("f2f282d9-f738-463c-ab2d-27fcb5645bca"))
 var phraseUUID = uuid.Must(uuid.Parse("f56b84af-7b95-40ff-b360-888169fb7f12"))
 var newPhraseUUID = uuid.Must(uuid.Parse("67d6547d-99ac-4053-8713-e63410af9dc1"))
 
-func addStub(content, translation string, uuid uuid.UUID) (api.Phrase, error) {
-	return api.Phrase{
-		Uuid:        newPhraseUUID.String(),
-		Content:     content,
-		Translation: translation,
-	}, nil
-}
-
-func addStubReturnsErr(_, _ string, _ uuid.UUID) (api.Phrase, error) {
-	return api.Phrase{}, errors.New("RUH ROH")
-}
-
-func updateStub(content, translation string, phraseUuid, userUuid uuid.UUID) (api.Phrase, error) {
-	return api.Phrase{
-		Uuid:        phraseUuid.String(),
-		Content:     content,
-		Translation: translation,
-	}, nil
-}
+func syncStub(_ context.Context, phrases []api.PhraseSync, _ uuid.UUID) ([]api.SyncedPhrase, error) {
+	synced := []api.SyncedPhrase{}
+	for _, phrase := range phrases {
+		saved := api.SyncedPhrase{Phrase: api.Phrase{
+			Uuid:        newPhraseUUID.String(),
+			Content:     phrase.Content,
+			Translation: phrase.Translation,
+		}}
+		if phrase.Uuid != nil {
+			saved.Uuid = phrase.Uuid.String()
+		} else {
+			saved.Created = true
+		}
+		synced = append(synced, saved)
+	}
 
-func updateStubReturnsErr(_, _ string, _, _ uuid.UUID) (api.Phrase, error) {
-	return api.Phrase{}, errors.New("RUH ROH")
+	return synced, nil
 }
@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tjarratt/doit-etre-rad/backend/search"
+)
+
+// AnswerMatches is forgiving about what doesn't matter when typing an
+// answer in: case, accents, punctuation, spacing and the odd typo.
+// A translation with alternatives, like "to be; to exist" or
+// "car/automobile", accepts any one of them
+func AnswerMatches(given string, expected string) bool {
+	answer := foldAnswer(given)
+	if answer == "" {
+		return false
+	}
+
+	for _, alternative := range strings.FieldsFunc(expected, isAlternativeSeparator) {
+		want := foldAnswer(alternative)
+		if want != "" && typos(answer, want) <= allowedTypos(want) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// commas aren't separators, or "yes" would be a right answer to "yes, please"
+func isAlternativeSeparator(r rune) bool {
+	return r == ';' || r == '/'
+}
+
+// foldAnswer keeps only the words, folded, one space apart
+func foldAnswer(text string) string {
+	folded := search.Fold(normalizePhraseText(text))
+	words := strings.FieldsFunc(folded, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(words, " ")
+}
+
+// allowedTypos grows with the answer. Short words get none,
+// since one letter is often all that tells them apart
+func allowedTypos(answer string) int {
+	length := utf8.RuneCountInString(answer)
+	switch {
+	case length < 5:
+		return 0
+	case length < 12:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// typos counts the letters that were added, dropped or changed, and
+// neighbouring letters that were swapped (an optimal string alignment distance)
+func typos(a string, b string) int {
+	x, y := []rune(a), []rune(b)
+	distances := make([][]int, len(x)+1)
+	for i := range distances {
+		distances[i] = make([]int, len(y)+1)
+		distances[i][0] = i
+	}
+	for j := range distances[0] {
+		distances[0][j] = j
+	}
+
+	for i := 1; i <= len(x); i++ {
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			distances[i][j] = smallest(
+				distances[i-1][j]+1,
+				distances[i][j-1]+1,
+				distances[i-1][j-1]+cost,
+			)
+			if i > 1 && j > 1 && x[i-1] == y[j-2] && x[i-2] == y[j-1] {
+				distances[i][j] = smallest(distances[i][j], distances[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return distances[len(x)][len(y)]
+}
+
+func smallest(first int, rest ...int) int {
+	for _, value := range rest {
+		if value < first {
+			first = value
+		}
+	}
+
+	return first
+}
@@ -0,0 +1,33 @@
+package usecases_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("AnswerMatches", func() {
+	It("ignores case, accents, punctuation and spacing", func() {
+		Expect(AnswerMatches("  ete ", "Été")).To(BeTrue())
+		Expect(AnswerMatches("je ne sais pas", "Je ne sais pas !")).To(BeTrue())
+		Expect(AnswerMatches("l’eau", "l'eau")).To(BeTrue())
+	})
+
+	It("forgives a typo in longer answers, but not in short ones", func() {
+		Expect(AnswerMatches("bonjuor", "bonjour")).To(BeTrue())
+		Expect(AnswerMatches("bonjor", "bonjour")).To(BeTrue())
+		Expect(AnswerMatches("bnjr", "bonjour")).To(BeFalse())
+		Expect(AnswerMatches("chat", "chas")).To(BeFalse())
+	})
+
+	It("accepts any of the alternatives in the translation", func() {
+		Expect(AnswerMatches("to exist", "to be; to exist")).To(BeTrue())
+		Expect(AnswerMatches("automobile", "car/automobile")).To(BeTrue())
+		Expect(AnswerMatches("yes", "yes, please")).To(BeFalse())
+	})
+
+	It("never accepts an empty answer", func() {
+		Expect(AnswerMatches(" ?! ", "")).To(BeFalse())
+		Expect(AnswerMatches("", "a")).To(BeFalse())
+	})
+})
@@ -0,0 +1,59 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+var ErrOutranked = errors.New("only an admin can change an admin's account")
+
+//go:generate counterfeiter . ChangeAccountUseCase
+type ChangeAccountUseCase interface {
+	Execute(context.Context, ChangeAccountRequest) error
+}
+
+func NewChangeAccountUseCase(
+	repository api.AdminRepository,
+) ChangeAccountUseCase {
+	return changeAccountUseCase{
+		repository: repository,
+	}
+}
+
+type changeAccountUseCase struct {
+	repository api.AdminRepository
+}
+
+// Execute disables a user's account, with a reason, or enables it again.
+// Disabled users get a 403 on every request once the AccountCheck notices
+func (usecase changeAccountUseCase) Execute(ctx context.Context, request ChangeAccountRequest) (err error) {
+	ctx, span := tracing.Start(ctx, "ChangeAccountUseCase.Execute")
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	// moderators can't lock admins out
+	user, err := usecase.repository.User(ctx, request.UserUUID)
+	if err != nil && err != api.ErrUserNotFound {
+		return err
+	}
+	if err == nil && !api.ActorFromContext(ctx).Role.Includes(user.Role) {
+		return ErrOutranked
+	}
+
+	if request.Disabled {
+		return usecase.repository.DisableUser(ctx, request.UserUUID, request.Reason)
+	}
+	return usecase.repository.EnableUser(ctx, request.UserUUID)
+}
+
+type ChangeAccountRequest struct {
+	UserUUID uuid.UUID
+	Disabled bool
+	Reason   string
+}
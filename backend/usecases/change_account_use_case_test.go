@@ -0,0 +1,100 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("ChangeAccountUseCase", func() {
+	var subject ChangeAccountUseCase
+	var fakeRepo *apifakes.FakeAdminRepository
+	var request ChangeAccountRequest
+	var ctx context.Context
+	var err error
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeAdminRepository)
+		fakeRepo.UserReturns(api.User{Uuid: userUUID.String(), Role: api.ROLE_USER}, nil)
+		subject = NewChangeAccountUseCase(fakeRepo)
+		request = ChangeAccountRequest{UserUUID: userUUID, Disabled: true, Reason: "spam"}
+		ctx = api.WithActor(context.Background(), api.Actor{Role: api.ROLE_MODERATOR})
+	})
+
+	JustBeforeEach(func() {
+		err = subject.Execute(ctx, request)
+	})
+
+	It("disables the account, saying why", func() {
+		Expect(err).NotTo(HaveOccurred())
+
+		_, disabled, reason := fakeRepo.DisableUserArgsForCall(0)
+		Expect(disabled).To(Equal(userUUID))
+		Expect(reason).To(Equal("spam"))
+		Expect(fakeRepo.EnableUserCallCount()).To(Equal(0))
+	})
+
+	Context("when enabling the account again", func() {
+		BeforeEach(func() {
+			request = ChangeAccountRequest{UserUUID: userUUID}
+		})
+
+		It("enables it", func() {
+			Expect(err).NotTo(HaveOccurred())
+
+			_, enabled := fakeRepo.EnableUserArgsForCall(0)
+			Expect(enabled).To(Equal(userUUID))
+			Expect(fakeRepo.DisableUserCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the user has never been seen", func() {
+		BeforeEach(func() {
+			fakeRepo.UserReturns(api.User{}, api.ErrUserNotFound)
+		})
+
+		It("disables them before they get the chance", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeRepo.DisableUserCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when a moderator goes after an admin", func() {
+		BeforeEach(func() {
+			fakeRepo.UserReturns(api.User{Uuid: userUUID.String(), Role: api.ROLE_ADMIN}, nil)
+		})
+
+		It("is outranked", func() {
+			Expect(err).To(Equal(ErrOutranked))
+			Expect(fakeRepo.DisableUserCallCount()).To(Equal(0))
+		})
+
+		Context("and it is really an admin", func() {
+			BeforeEach(func() {
+				ctx = api.WithActor(context.Background(), api.Actor{Role: api.ROLE_ADMIN})
+			})
+
+			It("disables them", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeRepo.DisableUserCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when looking up the user fails", func() {
+		BeforeEach(func() {
+			fakeRepo.UserReturns(api.User{}, errors.New("too many connections"))
+		})
+
+		It("returns the error without changing anything", func() {
+			Expect(err).To(MatchError("too many connections"))
+			Expect(fakeRepo.DisableUserCallCount()).To(Equal(0))
+		})
+	})
+})
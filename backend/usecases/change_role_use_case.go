@@ -0,0 +1,71 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+// StaffKeyResponse.StaffKey is only ever shown this once.
+// Regular users don't get one
+type StaffKeyResponse struct {
+	Role     api.Role `json:"role"`
+	StaffKey string   `json:"staffKey,omitempty"`
+}
+
+//go:generate counterfeiter . ChangeRoleUseCase
+type ChangeRoleUseCase interface {
+	Execute(context.Context, ChangeRoleRequest) (StaffKeyResponse, error)
+}
+
+func NewChangeRoleUseCase(
+	repository api.AdminRepository,
+) ChangeRoleUseCase {
+	return changeRoleUseCase{
+		repository: repository,
+	}
+}
+
+type changeRoleUseCase struct {
+	repository api.AdminRepository
+}
+
+// Execute makes a user a moderator or an admin, or takes it away again.
+// Staff get a new staff key every time, and whatever key they had
+// before stops working, so this is also how a lost key gets replaced
+func (usecase changeRoleUseCase) Execute(ctx context.Context, request ChangeRoleRequest) (response StaffKeyResponse, err error) {
+	ctx, span := tracing.Start(ctx, "ChangeRoleUseCase.Execute")
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	role, err := api.ParseRole(request.Role)
+	if err != nil {
+		return StaffKeyResponse{}, ValidationError{
+			Errors: []FieldError{{Field: "role", Message: "must be one of user, moderator or admin"}},
+		}
+	}
+
+	response = StaffKeyResponse{Role: role}
+	var keyHash []byte
+	if role != api.ROLE_USER {
+		response.StaffKey, keyHash, err = api.NewStaffKey(request.UserUUID)
+		if err != nil {
+			return StaffKeyResponse{}, err
+		}
+	}
+
+	if err = usecase.repository.SetRole(ctx, request.UserUUID, role, keyHash); err != nil {
+		return StaffKeyResponse{}, err
+	}
+
+	return response, nil
+}
+
+type ChangeRoleRequest struct {
+	UserUUID uuid.UUID
+	Role     string
+}
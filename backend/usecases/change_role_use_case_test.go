@@ -0,0 +1,91 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("ChangeRoleUseCase", func() {
+	var subject ChangeRoleUseCase
+	var fakeRepo *apifakes.FakeAdminRepository
+	var role string
+
+	var response StaffKeyResponse
+	var err error
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeAdminRepository)
+		subject = NewChangeRoleUseCase(fakeRepo)
+		role = "moderator"
+	})
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), ChangeRoleRequest{UserUUID: userUUID, Role: role})
+	})
+
+	It("gives the user the role along with a new staff key, storing only its hash", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Role).To(Equal(api.ROLE_MODERATOR))
+
+		owner, err := api.ParseStaffKey(response.StaffKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(owner).To(Equal(userUUID))
+
+		_, user, savedRole, keyHash := fakeRepo.SetRoleArgsForCall(0)
+		Expect(user).To(Equal(userUUID))
+		Expect(savedRole).To(Equal(api.ROLE_MODERATOR))
+		Expect(keyHash).To(Equal(api.HashStaffKey(response.StaffKey)))
+	})
+
+	It("hands out a different key every time", func() {
+		again, err := subject.Execute(context.Background(), ChangeRoleRequest{UserUUID: userUUID, Role: role})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again.StaffKey).NotTo(Equal(response.StaffKey))
+	})
+
+	Context("when the role is taken away", func() {
+		BeforeEach(func() {
+			role = "user"
+		})
+
+		It("takes their staff key away too", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(StaffKeyResponse{Role: api.ROLE_USER}))
+
+			_, _, savedRole, keyHash := fakeRepo.SetRoleArgsForCall(0)
+			Expect(savedRole).To(Equal(api.ROLE_USER))
+			Expect(keyHash).To(BeNil())
+		})
+	})
+
+	Context("when there is no such role", func() {
+		BeforeEach(func() {
+			role = "overlord"
+		})
+
+		It("refuses", func() {
+			Expect(err).To(Equal(ValidationError{
+				Errors: []FieldError{{Field: "role", Message: "must be one of user, moderator or admin"}},
+			}))
+			Expect(fakeRepo.SetRoleCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the repository fails", func() {
+		BeforeEach(func() {
+			fakeRepo.SetRoleReturns(errors.New("too many connections"))
+		})
+
+		It("doesn't hand out a key that won't work", func() {
+			Expect(err).To(MatchError("too many connections"))
+			Expect(response.StaffKey).To(BeEmpty())
+		})
+	})
+})
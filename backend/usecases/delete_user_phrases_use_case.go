@@ -0,0 +1,81 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+//go:generate counterfeiter . DeleteUserPhraseUseCase
+type DeleteUserPhraseUseCase interface {
+	Execute(context.Context, DeleteUserPhraseRequest) error
+}
+
+func NewDeleteUserPhraseUseCase(
+	repository api.AdminRepository,
+) DeleteUserPhraseUseCase {
+	return deleteUserPhraseUseCase{
+		repository: repository,
+	}
+}
+
+type deleteUserPhraseUseCase struct {
+	repository api.AdminRepository
+}
+
+// Execute removes one of a user's phrases, whichever direction it is in
+func (usecase deleteUserPhraseUseCase) Execute(ctx context.Context, request DeleteUserPhraseRequest) error {
+	ctx, span := tracing.Start(ctx, "DeleteUserPhraseUseCase.Execute")
+	defer span.End()
+
+	err := usecase.repository.DeletePhrase(ctx, request.PhraseUUID, request.UserUUID)
+	span.RecordError(err)
+
+	return err
+}
+
+type DeleteUserPhraseRequest struct {
+	PhraseUUID uuid.UUID
+	UserUUID   uuid.UUID
+}
+
+type DeletedPhrasesResponse struct {
+	Deleted int64 `json:"deleted"`
+}
+
+//go:generate counterfeiter . DeleteUserPhrasesUseCase
+type DeleteUserPhrasesUseCase interface {
+	Execute(context.Context, DeleteUserPhrasesRequest) (DeletedPhrasesResponse, error)
+}
+
+func NewDeleteUserPhrasesUseCase(
+	repository api.AdminRepository,
+) DeleteUserPhrasesUseCase {
+	return deleteUserPhrasesUseCase{
+		repository: repository,
+	}
+}
+
+type deleteUserPhrasesUseCase struct {
+	repository api.AdminRepository
+}
+
+// Execute deletes everything a user has saved
+func (usecase deleteUserPhrasesUseCase) Execute(ctx context.Context, request DeleteUserPhrasesRequest) (DeletedPhrasesResponse, error) {
+	ctx, span := tracing.Start(ctx, "DeleteUserPhrasesUseCase.Execute")
+	defer span.End()
+
+	deleted, err := usecase.repository.DeletePhrasesForUser(ctx, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return DeletedPhrasesResponse{}, err
+	}
+
+	return DeletedPhrasesResponse{Deleted: deleted}, nil
+}
+
+type DeleteUserPhrasesRequest struct {
+	UserUUID uuid.UUID
+}
@@ -0,0 +1,62 @@
+package usecases_test
+
+import (
+	"context"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("DeleteUserPhraseUseCase", func() {
+	var fakeRepo *apifakes.FakeAdminRepository
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeAdminRepository)
+	})
+
+	It("deletes the phrase, whichever direction it is in", func() {
+		err := NewDeleteUserPhraseUseCase(fakeRepo).Execute(context.Background(), DeleteUserPhraseRequest{
+			PhraseUUID: phraseUUID,
+			UserUUID:   userUUID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, phrase, user := fakeRepo.DeletePhraseArgsForCall(0)
+		Expect(phrase).To(Equal(phraseUUID))
+		Expect(user).To(Equal(userUUID))
+	})
+
+	Context("when it is already gone", func() {
+		BeforeEach(func() {
+			fakeRepo.DeletePhraseReturns(api.ErrPhraseNotFound)
+		})
+
+		It("returns the error", func() {
+			err := NewDeleteUserPhraseUseCase(fakeRepo).Execute(context.Background(), DeleteUserPhraseRequest{
+				PhraseUUID: phraseUUID,
+				UserUUID:   userUUID,
+			})
+			Expect(err).To(Equal(api.ErrPhraseNotFound))
+		})
+	})
+})
+
+var _ = Describe("DeleteUserPhrasesUseCase", func() {
+	It("says how many phrases were deleted", func() {
+		fakeRepo := new(apifakes.FakeAdminRepository)
+		fakeRepo.DeletePhrasesForUserReturns(12, nil)
+
+		response, err := NewDeleteUserPhrasesUseCase(fakeRepo).Execute(context.Background(), DeleteUserPhrasesRequest{
+			UserUUID: userUUID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(Equal(DeletedPhrasesResponse{Deleted: 12}))
+
+		_, user := fakeRepo.DeletePhrasesForUserArgsForCall(0)
+		Expect(user).To(Equal(userUUID))
+	})
+})
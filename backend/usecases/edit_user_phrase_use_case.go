@@ -0,0 +1,54 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+//go:generate counterfeiter . EditUserPhraseUseCase
+type EditUserPhraseUseCase interface {
+	Execute(context.Context, EditUserPhraseRequest) (api.AdminPhrase, error)
+}
+
+func NewEditUserPhraseUseCase(
+	repository api.AdminRepository,
+) EditUserPhraseUseCase {
+	return editUserPhraseUseCase{
+		repository: repository,
+	}
+}
+
+type editUserPhraseUseCase struct {
+	repository api.AdminRepository
+}
+
+// Execute lets a moderator fix one of a user's phrases, whichever
+// direction it is in. It is held to the same rules as the user's own edits
+func (usecase editUserPhraseUseCase) Execute(ctx context.Context, request EditUserPhraseRequest) (api.AdminPhrase, error) {
+	ctx, span := tracing.Start(ctx, "EditUserPhraseUseCase.Execute")
+	defer span.End()
+
+	patch := api.PhrasePatch{
+		Content:     request.Content,
+		Translation: request.Translation,
+	}
+	if err := ValidatePhrasePatch(&patch); err != nil {
+		span.RecordError(err)
+		return api.AdminPhrase{}, err
+	}
+
+	phrase, err := usecase.repository.PatchPhrase(ctx, patch, request.PhraseUUID, request.UserUUID)
+	span.RecordError(err)
+
+	return phrase, err
+}
+
+type EditUserPhraseRequest struct {
+	Content     *string
+	Translation *string
+	PhraseUUID  uuid.UUID
+	UserUUID    uuid.UUID
+}
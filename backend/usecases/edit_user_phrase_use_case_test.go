@@ -0,0 +1,78 @@
+package usecases_test
+
+import (
+	"context"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("EditUserPhraseUseCase", func() {
+	var subject EditUserPhraseUseCase
+	var fakeRepo *apifakes.FakeAdminRepository
+	var request EditUserPhraseRequest
+
+	var response api.AdminPhrase
+	var err error
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeAdminRepository)
+		fakeRepo.PatchPhraseReturns(api.AdminPhrase{
+			Uuid:        phraseUUID.String(),
+			Type:        api.FRENCH_TO_ENGLISH,
+			Content:     "salut",
+			Translation: "hello there",
+		}, nil)
+		subject = NewEditUserPhraseUseCase(fakeRepo)
+
+		translation := "  hello there "
+		request = EditUserPhraseRequest{
+			Translation: &translation,
+			PhraseUUID:  phraseUUID,
+			UserUUID:    userUUID,
+		}
+	})
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	It("only changes what was sent, once it has been cleaned up", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Translation).To(Equal("hello there"))
+
+		_, patch, phrase, user := fakeRepo.PatchPhraseArgsForCall(0)
+		Expect(patch.Content).To(BeNil())
+		Expect(*patch.Translation).To(Equal("hello there"))
+		Expect(phrase).To(Equal(phraseUUID))
+		Expect(user).To(Equal(userUUID))
+	})
+
+	Context("when the content would be emptied out", func() {
+		BeforeEach(func() {
+			content := " "
+			request.Content = &content
+		})
+
+		It("refuses", func() {
+			Expect(err).To(Equal(ValidationError{
+				Errors: []FieldError{{Field: "content", Message: "must not be empty"}},
+			}))
+			Expect(fakeRepo.PatchPhraseCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the phrase doesn't belong to the user", func() {
+		BeforeEach(func() {
+			fakeRepo.PatchPhraseReturns(api.AdminPhrase{}, api.ErrPhraseNotFound)
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(Equal(api.ErrPhraseNotFound))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package usecases
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+// QuizSweepInterval is how often quizzes that were never submitted
+// are cleaned up, as people make new ones
+const QuizSweepInterval = time.Hour
+
+//go:generate counterfeiter . ExpiredQuizSweeper
+type ExpiredQuizSweeper interface {
+	Sweep(context.Context)
+}
+
+// NewExpiredQuizSweeper deletes the quizzes that can no longer be submitted,
+// which is any left unsubmitted for longer than ErrQuizExpired allows.
+// Share one between the use cases that make quizzes of the same type
+func NewExpiredQuizSweeper(repository api.QuizRepository, clock func() time.Time) ExpiredQuizSweeper {
+	return &expiredQuizSweeper{
+		repository: repository,
+		clock:      clock,
+	}
+}
+
+type expiredQuizSweeper struct {
+	repository api.QuizRepository
+	clock      func() time.Time
+	mutex      sync.Mutex
+	lastSweep  time.Time
+}
+
+// Sweep does nothing until QuizSweepInterval has passed since the last
+// sweep. A failed sweep waits as long before it's tried again, the
+// quizzes will still be there
+func (sweeper *expiredQuizSweeper) Sweep(ctx context.Context) {
+	now := sweeper.clock()
+
+	sweeper.mutex.Lock()
+	if now.Sub(sweeper.lastSweep) < QuizSweepInterval {
+		sweeper.mutex.Unlock()
+		return
+	}
+	sweeper.lastSweep = now
+	sweeper.mutex.Unlock()
+
+	ctx, span := tracing.Start(ctx, "ExpiredQuizSweeper.Sweep")
+	defer span.End()
+
+	_, err := sweeper.repository.DeleteExpiredQuizzes(ctx, now.Add(-MaxSessionLength))
+	span.RecordError(err)
+}
@@ -0,0 +1,53 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("ExpiredQuizSweeper", func() {
+	var subject ExpiredQuizSweeper
+	var fakeRepo *apifakes.FakeQuizRepository
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Date(2018, 3, 31, 18, 30, 0, 0, time.UTC)
+		fakeRepo = new(apifakes.FakeQuizRepository)
+		subject = NewExpiredQuizSweeper(fakeRepo, func() time.Time { return now })
+	})
+
+	It("deletes the quizzes that are too old to be submitted", func() {
+		subject.Sweep(context.Background())
+
+		Expect(fakeRepo.DeleteExpiredQuizzesCallCount()).To(Equal(1))
+		_, createdBefore := fakeRepo.DeleteExpiredQuizzesArgsForCall(0)
+		Expect(createdBefore).To(Equal(now.Add(-MaxSessionLength)))
+	})
+
+	It("waits for the sweep interval before sweeping again", func() {
+		subject.Sweep(context.Background())
+
+		now = now.Add(QuizSweepInterval - time.Second)
+		subject.Sweep(context.Background())
+		Expect(fakeRepo.DeleteExpiredQuizzesCallCount()).To(Equal(1))
+
+		now = now.Add(time.Second)
+		subject.Sweep(context.Background())
+		Expect(fakeRepo.DeleteExpiredQuizzesCallCount()).To(Equal(2))
+	})
+
+	It("waits just as long after a sweep fails", func() {
+		fakeRepo.DeleteExpiredQuizzesReturns(0, errors.New("whoops"))
+		subject.Sweep(context.Background())
+
+		subject.Sweep(context.Background())
+		Expect(fakeRepo.DeleteExpiredQuizzesCallCount()).To(Equal(1))
+	})
+})
@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/search"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+// ClozeBlank stands in for the words the user has to fill in
+const ClozeBlank = "_____"
+
+var ErrNoExamplesForCloze = errors.New("add some example sentences that use your phrases first")
+
+// NewGenerateClozeUseCase makes quizzes out of the user's example sentences,
+// with the phrase blanked out of each one. They are graded like any
+// other quiz, so answers are forgiven the way AnswerMatches forgives
+func NewGenerateClozeUseCase(
+	examples api.ExamplesRepository,
+	quizzes api.QuizRepository,
+	sweeper ExpiredQuizSweeper,
+	phraseType api.PhraseType,
+	shuffle func(n int, swap func(i, j int)),
+) GenerateQuizUseCase {
+	return generateClozeUseCase{
+		examples: examples,
+		quizzes:  quizzes,
+		sweeper:  sweeper,
+		language: phraseLanguages[phraseType][0],
+		shuffle:  shuffle,
+	}
+}
+
+type generateClozeUseCase struct {
+	examples api.ExamplesRepository
+	quizzes  api.QuizRepository
+	sweeper  ExpiredQuizSweeper
+	language search.Language
+	shuffle  func(n int, swap func(i, j int))
+}
+
+// Execute picks one example sentence per phrase, so no phrase is asked
+// about twice. Sentences the phrase can't be found in, because it's been
+// conjugated beyond recognition, are left out. The translation of
+// the phrase is given as a hint
+func (usecase generateClozeUseCase) Execute(ctx context.Context, request GenerateQuizRequest) (QuizResponse, error) {
+	ctx, span := tracing.Start(ctx, "GenerateClozeUseCase.Execute")
+	defer span.End()
+
+	examples, err := usecase.examples.ExamplesForUser(ctx, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return QuizResponse{}, err
+	}
+	usecase.shuffle(len(examples), func(i, j int) {
+		examples[i], examples[j] = examples[j], examples[i]
+	})
+
+	count := request.Questions
+	if count <= 0 {
+		count = DefaultQuizQuestions
+	}
+
+	asked := map[string]bool{}
+	questions := []api.QuizQuestion{}
+	for _, example := range examples {
+		if len(questions) == count {
+			break
+		}
+		if asked[example.PhraseUuid] {
+			continue
+		}
+
+		start, end, ok := search.FindPhrase(example.Sentence, example.Content, usecase.language)
+		if !ok {
+			continue
+		}
+		asked[example.PhraseUuid] = true
+
+		questions = append(questions, api.QuizQuestion{
+			Type:       QUESTION_CLOZE,
+			PhraseUuid: example.PhraseUuid,
+			Prompt:     example.Sentence[:start] + ClozeBlank + example.Sentence[end:],
+			Hint:       example.Translation,
+			Answer:     example.Sentence[start:end],
+		})
+	}
+	if len(questions) == 0 {
+		return QuizResponse{}, ErrNoExamplesForCloze
+	}
+
+	response, err := createQuiz(ctx, usecase.quizzes, usecase.sweeper, questions, request.UserUUID)
+	span.RecordError(err)
+
+	return response, err
+}
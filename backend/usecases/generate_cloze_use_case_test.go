@@ -0,0 +1,109 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("GenerateClozeUseCase", func() {
+	var subject GenerateQuizUseCase
+	var fakeExamples *apifakes.FakeExamplesRepository
+	var fakeQuizzes *apifakes.FakeQuizRepository
+	var fakeSweeper *usecasesfakes.FakeExpiredQuizSweeper
+	var request GenerateQuizRequest
+
+	var response QuizResponse
+	var err error
+
+	noShuffle := func(int, func(i, j int)) {}
+
+	BeforeEach(func() {
+		fakeExamples = new(apifakes.FakeExamplesRepository)
+		fakeExamples.ExamplesForUserReturns([]api.PhraseExample{
+			{PhraseUuid: "1", Content: "cheval", Translation: "horse", Sentence: "Les Chevaux courent dans le pré."},
+			{PhraseUuid: "1", Content: "cheval", Translation: "horse", Sentence: "Mon cheval est blanc."},
+			{PhraseUuid: "2", Content: "il pleut", Translation: "it's raining", Sentence: "Il a plu hier."},
+			{PhraseUuid: "3", Content: "été", Translation: "summer", Sentence: "L'été est chaud."},
+		}, nil)
+		fakeQuizzes = new(apifakes.FakeQuizRepository)
+		fakeQuizzes.CreateQuizForUserWithUUIDStub = func(_ context.Context, questions []api.QuizQuestion, _ uuid.UUID) (api.Quiz, error) {
+			return api.Quiz{Uuid: "the-quiz", Questions: questions}, nil
+		}
+
+		fakeSweeper = new(usecasesfakes.FakeExpiredQuizSweeper)
+		subject = NewGenerateClozeUseCase(fakeExamples, fakeQuizzes, fakeSweeper, api.FRENCH_TO_ENGLISH, noShuffle)
+		request = GenerateQuizRequest{UserUUID: userUUID}
+	})
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	It("blanks the phrase out of one example of each phrase, as it was written there", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeQuizzes.CreateQuizForUserWithUUIDCallCount()).To(Equal(1))
+		_, questions, userUuid := fakeQuizzes.CreateQuizForUserWithUUIDArgsForCall(0)
+		Expect(userUuid).To(Equal(userUUID))
+		Expect(questions).To(Equal([]api.QuizQuestion{
+			{Type: QUESTION_CLOZE, PhraseUuid: "1", Prompt: "Les _____ courent dans le pré.", Hint: "horse", Answer: "Chevaux"},
+			{Type: QUESTION_CLOZE, PhraseUuid: "3", Prompt: "L'_____ est chaud.", Hint: "summer", Answer: "été"},
+		}))
+	})
+
+	It("sweeps up expired quizzes as it makes a new one", func() {
+		Expect(fakeSweeper.SweepCallCount()).To(Equal(1))
+	})
+
+	It("leaves the answers out of the response", func() {
+		Expect(response).To(Equal(QuizResponse{
+			Uuid: "the-quiz",
+			Questions: []QuizQuestionResponse{
+				{Type: QUESTION_CLOZE, Prompt: "Les _____ courent dans le pré.", Hint: "horse"},
+				{Type: QUESTION_CLOZE, Prompt: "L'_____ est chaud.", Hint: "summer"},
+			},
+		}))
+	})
+
+	Context("when fewer questions are asked for", func() {
+		BeforeEach(func() {
+			request.Questions = 1
+		})
+
+		It("stops there", func() {
+			_, questions, _ := fakeQuizzes.CreateQuizForUserWithUUIDArgsForCall(0)
+			Expect(questions).To(HaveLen(1))
+		})
+	})
+
+	Context("when none of the examples use their phrase", func() {
+		BeforeEach(func() {
+			fakeExamples.ExamplesForUserReturns([]api.PhraseExample{
+				{PhraseUuid: "2", Content: "il pleut", Translation: "it's raining", Sentence: "Il a plu hier."},
+			}, nil)
+		})
+
+		It("says so, without making a quiz", func() {
+			Expect(err).To(Equal(ErrNoExamplesForCloze))
+			Expect(fakeQuizzes.CreateQuizForUserWithUUIDCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the repository fails", func() {
+		BeforeEach(func() {
+			fakeExamples.ExamplesForUserReturns(nil, errors.New("whoops"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("whoops"))
+		})
+	})
+})
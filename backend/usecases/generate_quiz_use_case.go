@@ -0,0 +1,228 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/search"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+const DefaultQuizQuestions = 10
+const MaxQuizQuestions = 50
+
+// QuizChoices is how many choices a multiple choice question has,
+// the right answer included
+const QuizChoices = 4
+
+// multiple choice questions are graded by which choice was picked,
+// and the others the way AnswerMatches forgives
+const QUESTION_CHOICE = "choice"
+const QUESTION_TEXT = "text"
+const QUESTION_CLOZE = "cloze"
+
+var ErrNoPhrasesForQuiz = errors.New("add some phrases with translations before taking a quiz")
+
+// QuizResponse leaves out the answers, which stay with us until the quiz is submitted
+type QuizResponse struct {
+	Uuid      string                 `json:"uuid"`
+	Questions []QuizQuestionResponse `json:"questions"`
+}
+
+type QuizQuestionResponse struct {
+	Type    string   `json:"type"`
+	Prompt  string   `json:"prompt"`
+	Hint    string   `json:"hint,omitempty"`
+	Choices []string `json:"choices,omitempty"`
+}
+
+//go:generate counterfeiter . GenerateQuizUseCase
+type GenerateQuizUseCase interface {
+	Execute(context.Context, GenerateQuizRequest) (QuizResponse, error)
+}
+
+// NewGenerateQuizUseCase takes the shuffle to use, like the Shuffle
+// of a *rand.Rand from NewRandom
+func NewGenerateQuizUseCase(
+	repository api.QuizRepository,
+	sweeper ExpiredQuizSweeper,
+	shuffle func(n int, swap func(i, j int)),
+) GenerateQuizUseCase {
+	return generateQuizUseCase{
+		repository: repository,
+		sweeper:    sweeper,
+		shuffle:    shuffle,
+	}
+}
+
+type generateQuizUseCase struct {
+	repository api.QuizRepository
+	sweeper    ExpiredQuizSweeper
+	shuffle    func(n int, swap func(i, j int))
+}
+
+// Execute asks about a random selection of the user's phrases. Every other
+// question is multiple choice, as long as the user has enough other
+// translations to choose from, and the rest are answered by typing
+func (usecase generateQuizUseCase) Execute(ctx context.Context, request GenerateQuizRequest) (QuizResponse, error) {
+	ctx, span := tracing.Start(ctx, "GenerateQuizUseCase.Execute")
+	defer span.End()
+
+	phrases, err := usecase.repository.PhrasesForQuiz(ctx, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return QuizResponse{}, err
+	}
+
+	askable := []api.Phrase{}
+	for _, phrase := range phrases {
+		if phrase.Translation != "" {
+			askable = append(askable, phrase)
+		}
+	}
+	if len(askable) == 0 {
+		return QuizResponse{}, ErrNoPhrasesForQuiz
+	}
+	usecase.shuffle(len(askable), func(i, j int) {
+		askable[i], askable[j] = askable[j], askable[i]
+	})
+
+	count := request.Questions
+	if count <= 0 {
+		count = DefaultQuizQuestions
+	}
+	if count > len(askable) {
+		count = len(askable)
+	}
+
+	translations := distinctTranslations(askable)
+	questions := []api.QuizQuestion{}
+	for i, phrase := range askable[:count] {
+		question := api.QuizQuestion{
+			Type:       QUESTION_TEXT,
+			PhraseUuid: phrase.Uuid,
+			Prompt:     phrase.Content,
+			Answer:     phrase.Translation,
+		}
+		if i%2 == 0 {
+			question.Choices = usecase.choicesFor(phrase.Translation, translations)
+		}
+		if len(question.Choices) > 0 {
+			question.Type = QUESTION_CHOICE
+		}
+		questions = append(questions, question)
+	}
+
+	response, err := createQuiz(ctx, usecase.repository, usecase.sweeper, questions, request.UserUUID)
+	span.RecordError(err)
+
+	return response, err
+}
+
+// createQuiz saves the questions and responds with everything
+// but the answers. Quizzes that were never submitted are swept up
+// now and then, as new ones are made
+func createQuiz(ctx context.Context, repository api.QuizRepository, sweeper ExpiredQuizSweeper, questions []api.QuizQuestion, userUuid uuid.UUID) (QuizResponse, error) {
+	sweeper.Sweep(ctx)
+
+	quiz, err := repository.CreateQuizForUserWithUUID(ctx, questions, userUuid)
+	if err != nil {
+		return QuizResponse{}, err
+	}
+
+	response := QuizResponse{Uuid: quiz.Uuid, Questions: []QuizQuestionResponse{}}
+	for _, question := range quiz.Questions {
+		response.Questions = append(response.Questions, QuizQuestionResponse{
+			Type:    question.Type,
+			Prompt:  question.Prompt,
+			Hint:    question.Hint,
+			Choices: question.Choices,
+		})
+	}
+
+	return response, nil
+}
+
+// choicesFor is nil when there aren't enough other translations
+// to make a multiple choice question out of
+func (usecase generateQuizUseCase) choicesFor(answer string, translations []string) []string {
+	choices := distractorsFor(answer, translations)
+	if len(choices) < QuizChoices-1 {
+		return nil
+	}
+
+	choices = append(choices, answer)
+	usecase.shuffle(len(choices), func(i, j int) {
+		choices[i], choices[j] = choices[j], choices[i]
+	})
+
+	return choices
+}
+
+// distinctTranslations keeps the first of any translations that only
+// differ by case or accents, so they can't show up as two choices
+func distinctTranslations(phrases []api.Phrase) []string {
+	seen := map[string]bool{}
+	translations := []string{}
+	for _, phrase := range phrases {
+		key := foldAnswer(phrase.Translation)
+		if !seen[key] {
+			seen[key] = true
+			translations = append(translations, phrase.Translation)
+		}
+	}
+
+	return translations
+}
+
+// distractorsFor picks the wrong answers that look most like the right
+// one, sharing the start of it or being about as long, so the answer
+// can't be spotted for being the odd one out. Ties keep the order of
+// the translations, which were shuffled
+func distractorsFor(answer string, translations []string) []string {
+	folded := search.Fold(answer)
+	candidates := []string{}
+	for _, translation := range translations {
+		if foldAnswer(translation) != foldAnswer(answer) {
+			candidates = append(candidates, translation)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return resemblance(folded, candidates[i]) > resemblance(folded, candidates[j])
+	})
+	if len(candidates) > QuizChoices-1 {
+		candidates = candidates[:QuizChoices-1]
+	}
+
+	return candidates
+}
+
+// resemblance counts each letter of a shared prefix twice,
+// and takes off one for every letter of difference in length
+func resemblance(folded string, candidate string) int {
+	other := []rune(search.Fold(candidate))
+	prefix := 0
+	for _, r := range folded {
+		if prefix >= len(other) || other[prefix] != r {
+			break
+		}
+		prefix++
+	}
+
+	difference := utf8.RuneCountInString(folded) - len(other)
+	if difference < 0 {
+		difference = -difference
+	}
+
+	return 2*prefix - difference
+}
+
+type GenerateQuizRequest struct {
+	UserUUID  uuid.UUID
+	Questions int
+}
@@ -0,0 +1,116 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("GenerateQuizUseCase", func() {
+	var subject GenerateQuizUseCase
+	var fakeRepo *apifakes.FakeQuizRepository
+	var fakeSweeper *usecasesfakes.FakeExpiredQuizSweeper
+	var request GenerateQuizRequest
+
+	var response QuizResponse
+	var err error
+
+	// leaves everything in order, so the questions are predictable
+	noShuffle := func(int, func(i, j int)) {}
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeQuizRepository)
+		fakeRepo.PhrasesForQuizReturns([]api.Phrase{
+			{Uuid: "1", Content: "le chat", Translation: "the cat"},
+			{Uuid: "2", Content: "le chien", Translation: "the dog"},
+			{Uuid: "3", Content: "sans traduction", Translation: ""},
+			{Uuid: "4", Content: "la voiture", Translation: "the car"},
+			{Uuid: "5", Content: "le chaton", Translation: "The Cat"},
+			{Uuid: "6", Content: "la baleine", Translation: "the whale"},
+			{Uuid: "7", Content: "le papillon", Translation: "a butterfly"},
+		}, nil)
+		fakeRepo.CreateQuizForUserWithUUIDStub = func(_ context.Context, questions []api.QuizQuestion, _ uuid.UUID) (api.Quiz, error) {
+			return api.Quiz{Uuid: "the-quiz", Questions: questions}, nil
+		}
+
+		fakeSweeper = new(usecasesfakes.FakeExpiredQuizSweeper)
+		subject = NewGenerateQuizUseCase(fakeRepo, fakeSweeper, noShuffle)
+		request = GenerateQuizRequest{UserUUID: userUUID, Questions: 3}
+	})
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	It("saves the questions, answers and all, for grading later", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeRepo.CreateQuizForUserWithUUIDCallCount()).To(Equal(1))
+		_, questions, userUuid := fakeRepo.CreateQuizForUserWithUUIDArgsForCall(0)
+		Expect(userUuid).To(Equal(userUUID))
+		Expect(questions).To(Equal([]api.QuizQuestion{
+			{Type: QUESTION_CHOICE, PhraseUuid: "1", Prompt: "le chat", Answer: "the cat", Choices: []string{"the car", "the dog", "the whale", "the cat"}},
+			{Type: QUESTION_TEXT, PhraseUuid: "2", Prompt: "le chien", Answer: "the dog"},
+			{Type: QUESTION_CHOICE, PhraseUuid: "4", Prompt: "la voiture", Answer: "the car", Choices: []string{"the cat", "the dog", "the whale", "the car"}},
+		}))
+	})
+
+	It("sweeps up expired quizzes as it makes a new one", func() {
+		Expect(fakeSweeper.SweepCallCount()).To(Equal(1))
+	})
+
+	It("leaves the answers out of the response", func() {
+		Expect(response).To(Equal(QuizResponse{
+			Uuid: "the-quiz",
+			Questions: []QuizQuestionResponse{
+				{Type: QUESTION_CHOICE, Prompt: "le chat", Choices: []string{"the car", "the dog", "the whale", "the cat"}},
+				{Type: QUESTION_TEXT, Prompt: "le chien"},
+				{Type: QUESTION_CHOICE, Prompt: "la voiture", Choices: []string{"the cat", "the dog", "the whale", "the car"}},
+			},
+		}))
+	})
+
+	Context("when the user hasn't got enough other translations to choose from", func() {
+		BeforeEach(func() {
+			fakeRepo.PhrasesForQuizReturns([]api.Phrase{
+				{Uuid: "1", Content: "le chat", Translation: "the cat"},
+				{Uuid: "2", Content: "le chien", Translation: "the dog"},
+			}, nil)
+		})
+
+		It("asks for the answer to be typed in instead", func() {
+			Expect(response.Questions).To(Equal([]QuizQuestionResponse{
+				{Type: QUESTION_TEXT, Prompt: "le chat"},
+				{Type: QUESTION_TEXT, Prompt: "le chien"},
+			}))
+		})
+	})
+
+	Context("when the user has no translated phrases", func() {
+		BeforeEach(func() {
+			fakeRepo.PhrasesForQuizReturns([]api.Phrase{{Uuid: "3", Content: "sans traduction"}}, nil)
+		})
+
+		It("says so, without making a quiz", func() {
+			Expect(err).To(Equal(ErrNoPhrasesForQuiz))
+			Expect(fakeRepo.CreateQuizForUserWithUUIDCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the repository fails", func() {
+		BeforeEach(func() {
+			fakeRepo.PhrasesForQuizReturns(nil, errors.New("RUH ROH"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("RUH ROH"))
+		})
+	})
+})
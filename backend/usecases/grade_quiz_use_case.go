@@ -0,0 +1,138 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+// a quiz is graded as a practice session that started when the quiz
+// was made, so it expires when the session would get too long
+var ErrQuizExpired = errors.New("this quiz has expired, start a new one")
+
+type QuizResultResponse struct {
+	Score     uint                     `json:"score"`
+	Total     uint                     `json:"total"`
+	Questions []GradedQuestionResponse `json:"questions"`
+}
+
+type GradedQuestionResponse struct {
+	Prompt  string `json:"prompt"`
+	Answer  string `json:"answer"`
+	Given   string `json:"given"`
+	Correct bool   `json:"correct"`
+}
+
+//go:generate counterfeiter . GradeQuizUseCase
+type GradeQuizUseCase interface {
+	Execute(context.Context, GradeQuizRequest) (QuizResultResponse, error)
+}
+
+func NewGradeQuizUseCase(
+	repository api.QuizRepository,
+	awarder AchievementAwarder,
+	clock func() time.Time,
+) GradeQuizUseCase {
+	return gradeQuizUseCase{
+		repository: repository,
+		awarder:    awarder,
+		clock:      clock,
+	}
+}
+
+type gradeQuizUseCase struct {
+	repository api.QuizRepository
+	awarder    AchievementAwarder
+	clock      func() time.Time
+}
+
+// Execute takes an answer per question, in order, with unanswered ones
+// left empty. Multiple choice answers have to be the right choice, and
+// typed ones (cloze items included) are graded the way AnswerMatches forgives
+func (usecase gradeQuizUseCase) Execute(ctx context.Context, request GradeQuizRequest) (result QuizResultResponse, err error) {
+	ctx, span := tracing.Start(ctx, "GradeQuizUseCase.Execute")
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+
+	quiz, err := usecase.repository.QuizForUserWithUUID(ctx, request.QuizUUID, request.UserUUID)
+	if err != nil {
+		return QuizResultResponse{}, err
+	}
+	if quiz.SubmittedAt != nil {
+		return QuizResultResponse{}, api.ErrQuizAlreadySubmitted
+	}
+	now := usecase.clock()
+	if now.Sub(quiz.CreatedAt) > MaxSessionLength {
+		return QuizResultResponse{}, ErrQuizExpired
+	}
+
+	fieldErrors := []FieldError{}
+	if len(request.Answers) != len(quiz.Questions) {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   "answers",
+			Message: fmt.Sprintf("must have an answer for each of the %d questions", len(quiz.Questions)),
+		})
+	}
+	for i, answer := range request.Answers {
+		if utf8.RuneCountInString(answer) > MaxPhraseLength {
+			fieldErrors = append(fieldErrors, FieldError{
+				Field:   fmt.Sprintf("answers[%d]", i),
+				Message: fmt.Sprintf("must be at most %d characters long", MaxPhraseLength),
+			})
+		}
+	}
+	if len(fieldErrors) > 0 {
+		return QuizResultResponse{}, ValidationError{Errors: fieldErrors}
+	}
+
+	result = QuizResultResponse{Total: uint(len(quiz.Questions)), Questions: []GradedQuestionResponse{}}
+	reviews := []api.PhraseReview{}
+	for i, question := range quiz.Questions {
+		graded := GradedQuestionResponse{
+			Prompt: question.Prompt,
+			Answer: question.Answer,
+			Given:  request.Answers[i],
+		}
+		if question.Type == QUESTION_CHOICE {
+			graded.Correct = foldAnswer(graded.Given) == foldAnswer(question.Answer)
+		} else {
+			graded.Correct = AnswerMatches(graded.Given, question.Answer)
+		}
+		if graded.Correct {
+			result.Score++
+		}
+		result.Questions = append(result.Questions, graded)
+		reviews = append(reviews, api.PhraseReview{PhraseUuid: question.PhraseUuid, Correct: graded.Correct})
+	}
+
+	err = usecase.repository.SubmitQuizForUserWithUUID(ctx, request.QuizUUID, api.PracticeSession{
+		StartedAt:      quiz.CreatedAt,
+		FinishedAt:     now,
+		CardsReviewed:  result.Total,
+		CorrectAnswers: result.Score,
+	}, reviews, request.UserUUID)
+	if err != nil {
+		return QuizResultResponse{}, err
+	}
+
+	// the quiz is graded either way, so a problem
+	// awarding badges isn't worth failing the request over
+	_, awardErr := usecase.awarder.Award(ctx, request.UserUUID, sessionCompleted(result.Total, result.Score))
+	span.RecordError(awardErr)
+
+	return result, nil
+}
+
+type GradeQuizRequest struct {
+	UserUUID uuid.UUID
+	QuizUUID uuid.UUID
+	Answers  []string
+}
@@ -0,0 +1,142 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("GradeQuizUseCase", func() {
+	var subject GradeQuizUseCase
+	var fakeRepo *apifakes.FakeQuizRepository
+	var fakeAwarder *usecasesfakes.FakeAchievementAwarder
+	var request GradeQuizRequest
+
+	var response QuizResultResponse
+	var err error
+
+	quizUUID := uuid.Must(uuid.Parse("0f9a3c52-52a4-4a3e-9f55-5b1a3c5d0f7e"))
+	createdAt := time.Date(2018, 3, 31, 18, 30, 0, 0, time.UTC)
+	now := createdAt.Add(4 * time.Minute)
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeQuizRepository)
+		fakeRepo.QuizForUserWithUUIDReturns(api.Quiz{
+			Uuid:      quizUUID.String(),
+			CreatedAt: createdAt,
+			Questions: []api.QuizQuestion{
+				{Type: QUESTION_CHOICE, PhraseUuid: "chat-uuid", Prompt: "le chat", Answer: "the cat", Choices: []string{"the car", "the cat", "the dog", "the whale"}},
+				{Type: QUESTION_TEXT, PhraseUuid: "chien-uuid", Prompt: "le chien", Answer: "the dog"},
+				{Type: QUESTION_CHOICE, PhraseUuid: "voiture-uuid", Prompt: "la voiture", Answer: "the car", Choices: []string{"the cat", "the car", "the dog", "the whale"}},
+			},
+		}, nil)
+		fakeAwarder = new(usecasesfakes.FakeAchievementAwarder)
+
+		subject = NewGradeQuizUseCase(fakeRepo, fakeAwarder, func() time.Time { return now })
+		request = GradeQuizRequest{
+			UserUUID: userUUID,
+			QuizUUID: quizUUID,
+			Answers:  []string{"the cat", "teh dog", "the cat"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	It("grades each answer, forgiving typos in typed ones", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(Equal(QuizResultResponse{
+			Score: 2,
+			Total: 3,
+			Questions: []GradedQuestionResponse{
+				{Prompt: "le chat", Answer: "the cat", Given: "the cat", Correct: true},
+				{Prompt: "le chien", Answer: "the dog", Given: "teh dog", Correct: true},
+				{Prompt: "la voiture", Answer: "the car", Given: "the cat", Correct: false},
+			},
+		}))
+	})
+
+	It("records the quiz as a practice session", func() {
+		Expect(fakeRepo.SubmitQuizForUserWithUUIDCallCount()).To(Equal(1))
+		_, submittedUuid, session, _, userUuid := fakeRepo.SubmitQuizForUserWithUUIDArgsForCall(0)
+		Expect(submittedUuid).To(Equal(quizUUID))
+		Expect(userUuid).To(Equal(userUUID))
+		Expect(session).To(Equal(api.PracticeSession{
+			StartedAt:      createdAt,
+			FinishedAt:     now,
+			CardsReviewed:  3,
+			CorrectAnswers: 2,
+		}))
+
+		_, _, event := fakeAwarder.AwardArgsForCall(0)
+		Expect(event.Type).To(Equal(achievements.SESSION_COMPLETED))
+		Expect(event.Facts[achievements.FACT_SESSION_ACCURACY]).To(Equal(uint(66)))
+	})
+
+	It("reschedules each phrase by whether it was answered right", func() {
+		_, _, _, reviews, _ := fakeRepo.SubmitQuizForUserWithUUIDArgsForCall(0)
+		Expect(reviews).To(Equal([]api.PhraseReview{
+			{PhraseUuid: "chat-uuid", Correct: true},
+			{PhraseUuid: "chien-uuid", Correct: true},
+			{PhraseUuid: "voiture-uuid", Correct: false},
+		}))
+	})
+
+	Context("when an answer is missing", func() {
+		BeforeEach(func() {
+			request.Answers = []string{"the cat"}
+		})
+
+		It("doesn't grade anything", func() {
+			Expect(err).To(Equal(ValidationError{
+				Errors: []FieldError{{Field: "answers", Message: "must have an answer for each of the 3 questions"}},
+			}))
+			Expect(fakeRepo.SubmitQuizForUserWithUUIDCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the quiz has already been submitted", func() {
+		BeforeEach(func() {
+			submittedAt := createdAt.Add(time.Minute)
+			fakeRepo.QuizForUserWithUUIDReturns(api.Quiz{CreatedAt: createdAt, SubmittedAt: &submittedAt}, nil)
+		})
+
+		It("isn't graded again", func() {
+			Expect(err).To(Equal(api.ErrQuizAlreadySubmitted))
+			Expect(fakeRepo.SubmitQuizForUserWithUUIDCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the quiz was made too long ago", func() {
+		BeforeEach(func() {
+			fakeRepo.QuizForUserWithUUIDReturns(api.Quiz{CreatedAt: now.Add(-MaxSessionLength - time.Second)}, nil)
+		})
+
+		It("has expired", func() {
+			Expect(err).To(Equal(ErrQuizExpired))
+			Expect(fakeRepo.SubmitQuizForUserWithUUIDCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when awarding achievements fails", func() {
+		BeforeEach(func() {
+			fakeAwarder.AwardReturns(nil, errors.New("RUH ROH"))
+		})
+
+		It("still returns the grades", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Score).To(Equal(uint(2)))
+		})
+	})
+})
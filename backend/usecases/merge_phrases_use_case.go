@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+//go:generate counterfeiter . MergePhrasesUseCase
+type MergePhrasesUseCase interface {
+	Execute(context.Context, MergePhrasesRequest) (PhraseResponse, error)
+}
+
+func NewMergePhrasesUseCase(
+	repository api.PhrasesRepository,
+) MergePhrasesUseCase {
+	return mergePhrasesUseCase{
+		repository: repository,
+	}
+}
+
+type mergePhrasesUseCase struct {
+	repository api.PhrasesRepository
+}
+
+func (usecase mergePhrasesUseCase) Execute(ctx context.Context, request MergePhrasesRequest) (PhraseResponse, error) {
+	ctx, span := tracing.Start(ctx, "MergePhrasesUseCase.Execute")
+	span.SetAttributes(attribute.Int("phrases.duplicates", len(request.Duplicates)))
+	defer span.End()
+
+	if len(request.Duplicates) == 0 {
+		return PhraseResponse{}, errors.New("You must specify at least one duplicate to merge")
+	}
+
+	seen := map[uuid.UUID]bool{request.Into: true}
+	for _, duplicate := range request.Duplicates {
+		if seen[duplicate] {
+			return PhraseResponse{}, errors.New("a phrase cannot be merged into itself")
+		}
+		seen[duplicate] = true
+	}
+
+	phrase, err := usecase.repository.MergePhrasesForUserWithUUID(
+		ctx,
+		request.Into,
+		request.Duplicates,
+		request.UserUUID,
+	)
+	span.RecordError(err)
+	return PhraseResponse(phrase), err
+}
+
+type MergePhrasesRequest struct {
+	UserUUID   uuid.UUID
+	Into       uuid.UUID
+	Duplicates []uuid.UUID
+}
@@ -0,0 +1,96 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("MergePhrasesUseCase", func() {
+	var subject MergePhrasesUseCase
+	var fakeRepo *apifakes.FakePhrasesRepository
+	var request MergePhrasesRequest
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakePhrasesRepository)
+		subject = NewMergePhrasesUseCase(fakeRepo)
+		request = MergePhrasesRequest{
+			UserUUID:   userUUID,
+			Into:       phraseUUID,
+			Duplicates: []uuid.UUID{newPhraseUUID},
+		}
+	})
+
+	var response PhraseResponse
+	var err error
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	Context("when the repository merges the phrases", func() {
+		BeforeEach(func() {
+			fakeRepo.MergePhrasesForUserWithUUIDReturns(api.Phrase{
+				Uuid:        phraseUUID.String(),
+				Content:     "le chat",
+				Translation: "the cat",
+			}, nil)
+		})
+
+		It("merges the duplicates into the surviving phrase", func() {
+			Expect(fakeRepo.MergePhrasesForUserWithUUIDCallCount()).To(Equal(1))
+			_, into, duplicates, userUuid := fakeRepo.MergePhrasesForUserWithUUIDArgsForCall(0)
+			Expect(into).To(Equal(phraseUUID))
+			Expect(duplicates).To(Equal([]uuid.UUID{newPhraseUUID}))
+			Expect(userUuid).To(Equal(userUUID))
+		})
+
+		It("returns the merged phrase", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(PhraseResponse{
+				Uuid:        phraseUUID.String(),
+				Content:     "le chat",
+				Translation: "the cat",
+			}))
+		})
+	})
+
+	Context("when no duplicates are provided", func() {
+		BeforeEach(func() {
+			request.Duplicates = []uuid.UUID{}
+		})
+
+		It("returns an error without touching the repository", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(fakeRepo.MergePhrasesForUserWithUUIDCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a phrase would be merged into itself", func() {
+		BeforeEach(func() {
+			request.Duplicates = []uuid.UUID{newPhraseUUID, phraseUUID}
+		})
+
+		It("returns an error without touching the repository", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(fakeRepo.MergePhrasesForUserWithUUIDCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the repository returns an error", func() {
+		BeforeEach(func() {
+			fakeRepo.MergePhrasesForUserWithUUIDReturns(api.Phrase{}, errors.New("RUH ROH"))
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+type PhraseRevisionResponse struct {
+	Revision     uint      `json:"revision"`
+	Content      string    `json:"content"`
+	Translation  string    `json:"translation"`
+	CreatedAt    time.Time `json:"createdAt"`
+	RevertedFrom uint      `json:"revertedFrom,omitempty"`
+	Device       string    `json:"device"`
+	RequestID    string    `json:"requestId"`
+}
+
+//go:generate counterfeiter . PhraseHistoryUseCase
+type PhraseHistoryUseCase interface {
+	Execute(context.Context, PhraseHistoryRequest) ([]PhraseRevisionResponse, error)
+}
+
+func NewPhraseHistoryUseCase(
+	repository api.PhrasesRepository,
+) PhraseHistoryUseCase {
+	return phraseHistoryUseCase{
+		repository: repository,
+	}
+}
+
+type phraseHistoryUseCase struct {
+	repository api.PhrasesRepository
+}
+
+func (usecase phraseHistoryUseCase) Execute(ctx context.Context, request PhraseHistoryRequest) ([]PhraseRevisionResponse, error) {
+	ctx, span := tracing.Start(ctx, "PhraseHistoryUseCase.Execute")
+	defer span.End()
+
+	revisions, err := usecase.repository.PhraseHistoryForUserWithUUID(
+		ctx,
+		request.UUID,
+		request.UserUUID,
+	)
+	if err != nil {
+		span.RecordError(err)
+		return []PhraseRevisionResponse{}, err
+	}
+
+	response := []PhraseRevisionResponse{}
+	for _, revision := range revisions {
+		response = append(response, PhraseRevisionResponse(revision))
+	}
+
+	return response, nil
+}
+
+type PhraseHistoryRequest struct {
+	UUID     uuid.UUID
+	UserUUID uuid.UUID
+}
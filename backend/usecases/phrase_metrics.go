@@ -0,0 +1,10 @@
+package usecases
+
+// PhraseMetrics counts the changes made to phrases, for /metrics
+//
+//go:generate counterfeiter . PhraseMetrics
+type PhraseMetrics interface {
+	PhraseCreated()
+	PhraseUpdated()
+	SyncPerformed()
+}
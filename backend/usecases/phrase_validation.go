@@ -0,0 +1,93 @@
+package usecases
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"golang.org/x/text/unicode/norm"
+)
+
+const MaxPhraseLength = 500
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (err ValidationError) Error() string {
+	messages := []string{}
+	for _, fieldError := range err.Errors {
+		messages = append(messages, fmt.Sprintf("%s %s", fieldError.Field, fieldError.Message))
+	}
+
+	return strings.Join(messages, ", ")
+}
+
+// phone keyboards love to "helpfully" replace apostrophes with curly ones,
+// which would make "l’eau" and "l'eau" two different phrases
+var apostrophes = strings.NewReplacer(
+	"’", "'", // right single quotation mark
+	"‘", "'", // left single quotation mark
+	"ʼ", "'", // modifier letter apostrophe
+	"＇", "'", // fullwidth apostrophe
+)
+
+func normalizePhraseText(text string) string {
+	return norm.NFC.String(apostrophes.Replace(strings.TrimSpace(text)))
+}
+
+// validatePhraseText normalizes the text in place and describes
+// everything that is wrong with it
+func validatePhraseText(field string, text *string, required bool) []FieldError {
+	*text = normalizePhraseText(*text)
+
+	if *text == "" {
+		if required {
+			return []FieldError{{Field: field, Message: "must not be empty"}}
+		}
+		return []FieldError{}
+	}
+
+	errors := []FieldError{}
+	if utf8.RuneCountInString(*text) > MaxPhraseLength {
+		errors = append(errors, FieldError{
+			Field:   field,
+			Message: fmt.Sprintf("must be at most %d characters long", MaxPhraseLength),
+		})
+	}
+
+	if strings.IndexFunc(*text, unicode.IsControl) >= 0 {
+		errors = append(errors, FieldError{Field: field, Message: "must not contain control characters"})
+	}
+
+	return errors
+}
+
+// ValidatePhrasePatch normalizes the fields a patch changes, in place.
+// Fields missing from the patch are left alone, but the content
+// can't be emptied out
+func ValidatePhrasePatch(patch *api.PhrasePatch) error {
+	fieldErrors := []FieldError{}
+	if patch.Content != nil {
+		content := *patch.Content
+		fieldErrors = append(fieldErrors, validatePhraseText("content", &content, true)...)
+		patch.Content = &content
+	}
+	if patch.Translation != nil {
+		translation := *patch.Translation
+		fieldErrors = append(fieldErrors, validatePhraseText("translation", &translation, false)...)
+		patch.Translation = &translation
+	}
+	if len(fieldErrors) > 0 {
+		return ValidationError{Errors: fieldErrors}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package usecases
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// NewRandom is for shuffling quizzes. The global source is seeded with 1
+// until go1.20, so quizzes shuffled with rand.Shuffle would come out in the
+// same order after every restart. Unlike rand.New, it can be shared
+// between requests
+func NewRandom(seed int64) *rand.Rand {
+	return rand.New(&lockedSource{source: rand.NewSource(seed).(rand.Source64)})
+}
+
+type lockedSource struct {
+	mutex  sync.Mutex
+	source rand.Source64
+}
+
+func (locked *lockedSource) Int63() int64 {
+	locked.mutex.Lock()
+	defer locked.mutex.Unlock()
+	return locked.source.Int63()
+}
+
+func (locked *lockedSource) Uint64() uint64 {
+	locked.mutex.Lock()
+	defer locked.mutex.Unlock()
+	return locked.source.Uint64()
+}
+
+func (locked *lockedSource) Seed(seed int64) {
+	locked.mutex.Lock()
+	defer locked.mutex.Unlock()
+	locked.source.Seed(seed)
+}
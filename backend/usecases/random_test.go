@@ -0,0 +1,25 @@
+package usecases_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("NewRandom", func() {
+	shuffled := func(seed int64) []int {
+		numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+		NewRandom(seed).Shuffle(len(numbers), func(i, j int) {
+			numbers[i], numbers[j] = numbers[j], numbers[i]
+		})
+		return numbers
+	}
+
+	It("shuffles the same way for the same seed", func() {
+		Expect(shuffled(42)).To(Equal(shuffled(42)))
+	})
+
+	It("shuffles differently for another seed", func() {
+		Expect(shuffled(42)).NotTo(Equal(shuffled(43)))
+	})
+})
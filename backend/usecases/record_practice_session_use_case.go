@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// MaxCardsPerSession is far more than anyone gets through in one go,
+// it only keeps made up numbers out of the analytics
+const MaxCardsPerSession = 1000
+
+// MaxSessionLength is how long a session can last before we suspect
+// a tab was left open overnight
+const MaxSessionLength = 12 * time.Hour
+
+//go:generate counterfeiter . RecordPracticeSessionUseCase
+type RecordPracticeSessionUseCase interface {
+	Execute(context.Context, RecordPracticeSessionRequest) error
+}
+
+func NewRecordPracticeSessionUseCase(
+	repository api.PracticeRepository,
+	awarder AchievementAwarder,
+) RecordPracticeSessionUseCase {
+	return recordPracticeSessionUseCase{
+		repository: repository,
+		awarder:    awarder,
+	}
+}
+
+type recordPracticeSessionUseCase struct {
+	repository api.PracticeRepository
+	awarder    AchievementAwarder
+}
+
+func (usecase recordPracticeSessionUseCase) Execute(ctx context.Context, request RecordPracticeSessionRequest) error {
+	ctx, span := tracing.Start(ctx, "RecordPracticeSessionUseCase.Execute")
+	span.SetAttributes(attribute.Int64("practice.cards_reviewed", int64(request.CardsReviewed)))
+	defer span.End()
+
+	fieldErrors := []FieldError{}
+	if request.CardsReviewed == 0 || request.CardsReviewed > MaxCardsPerSession {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   "cardsReviewed",
+			Message: fmt.Sprintf("must be between 1 and %d", MaxCardsPerSession),
+		})
+	}
+	if request.CorrectAnswers > request.CardsReviewed {
+		fieldErrors = append(fieldErrors, FieldError{Field: "correctAnswers", Message: "must not be more than cardsReviewed"})
+	}
+	length := request.FinishedAt.Sub(request.StartedAt)
+	if length < 0 || length > MaxSessionLength {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   "finishedAt",
+			Message: fmt.Sprintf("must be after startedAt, and at most %s later", MaxSessionLength),
+		})
+	}
+	if len(fieldErrors) > 0 {
+		err := ValidationError{Errors: fieldErrors}
+		span.RecordError(err)
+		return err
+	}
+
+	err := usecase.repository.RecordSessionForUserWithUUID(ctx, api.PracticeSession{
+		StartedAt:      request.StartedAt,
+		FinishedAt:     request.FinishedAt,
+		CardsReviewed:  request.CardsReviewed,
+		CorrectAnswers: request.CorrectAnswers,
+	}, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	// the session is recorded either way, so a problem
+	// awarding badges isn't worth failing the request over
+	_, err = usecase.awarder.Award(ctx, request.UserUUID, sessionCompleted(request.CardsReviewed, request.CorrectAnswers))
+	span.RecordError(err)
+
+	return nil
+}
+
+func sessionCompleted(cardsReviewed uint, correctAnswers uint) achievements.Event {
+	return achievements.Event{
+		Type: achievements.SESSION_COMPLETED,
+		Facts: achievements.Facts{
+			achievements.FACT_SESSION_CARDS:    cardsReviewed,
+			achievements.FACT_SESSION_ACCURACY: correctAnswers * 100 / cardsReviewed,
+		},
+	}
+}
+
+type RecordPracticeSessionRequest struct {
+	UserUUID       uuid.UUID
+	StartedAt      time.Time
+	FinishedAt     time.Time
+	CardsReviewed  uint
+	CorrectAnswers uint
+}
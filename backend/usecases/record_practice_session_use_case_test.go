@@ -0,0 +1,119 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("RecordPracticeSessionUseCase", func() {
+	var subject RecordPracticeSessionUseCase
+	var fakeRepo *apifakes.FakePracticeRepository
+	var fakeAwarder *usecasesfakes.FakeAchievementAwarder
+	var request RecordPracticeSessionRequest
+	var err error
+
+	startedAt := time.Date(2018, 3, 31, 18, 30, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakePracticeRepository)
+		fakeAwarder = new(usecasesfakes.FakeAchievementAwarder)
+		subject = NewRecordPracticeSessionUseCase(fakeRepo, fakeAwarder)
+		request = RecordPracticeSessionRequest{
+			UserUUID:       userUUID,
+			StartedAt:      startedAt,
+			FinishedAt:     startedAt.Add(5 * time.Minute),
+			CardsReviewed:  20,
+			CorrectAnswers: 17,
+		}
+	})
+
+	JustBeforeEach(func() {
+		err = subject.Execute(context.Background(), request)
+	})
+
+	It("records the session for the user", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeRepo.RecordSessionForUserWithUUIDCallCount()).To(Equal(1))
+		_, session, userUuid := fakeRepo.RecordSessionForUserWithUUIDArgsForCall(0)
+		Expect(userUuid).To(Equal(userUUID))
+		Expect(session).To(Equal(api.PracticeSession{
+			StartedAt:      startedAt,
+			FinishedAt:     startedAt.Add(5 * time.Minute),
+			CardsReviewed:  20,
+			CorrectAnswers: 17,
+		}))
+	})
+
+	It("checks whether the session earned any achievements", func() {
+		Expect(fakeAwarder.AwardCallCount()).To(Equal(1))
+		_, userUuid, event := fakeAwarder.AwardArgsForCall(0)
+		Expect(userUuid).To(Equal(userUUID))
+		Expect(event).To(Equal(achievements.Event{
+			Type: achievements.SESSION_COMPLETED,
+			Facts: achievements.Facts{
+				achievements.FACT_SESSION_CARDS:    20,
+				achievements.FACT_SESSION_ACCURACY: 85,
+			},
+		}))
+	})
+
+	Context("when awarding achievements fails", func() {
+		BeforeEach(func() {
+			fakeAwarder.AwardReturns(nil, errors.New("RUH ROH"))
+		})
+
+		It("still reports the session as recorded", func() {
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("when the session can't be recorded", func() {
+		BeforeEach(func() {
+			fakeRepo.RecordSessionForUserWithUUIDReturns(errors.New("RUH ROH"))
+		})
+
+		It("returns the error without awarding anything", func() {
+			Expect(err).To(MatchError("RUH ROH"))
+			Expect(fakeAwarder.AwardCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the numbers don't add up", func() {
+		BeforeEach(func() {
+			request.CardsReviewed = 0
+			request.CorrectAnswers = 3
+			request.FinishedAt = startedAt.Add(-time.Minute)
+		})
+
+		It("says what is wrong, without recording anything", func() {
+			Expect(err).To(BeAssignableToTypeOf(ValidationError{}))
+			fields := []string{}
+			for _, fieldError := range err.(ValidationError).Errors {
+				fields = append(fields, fieldError.Field)
+			}
+			Expect(fields).To(Equal([]string{"cardsReviewed", "correctAnswers", "finishedAt"}))
+			Expect(fakeRepo.RecordSessionForUserWithUUIDCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the session lasted all night", func() {
+		BeforeEach(func() {
+			request.FinishedAt = startedAt.Add(MaxSessionLength + time.Second)
+		})
+
+		It("is not recorded", func() {
+			Expect(err).To(BeAssignableToTypeOf(ValidationError{}))
+			Expect(fakeRepo.RecordSessionForUserWithUUIDCallCount()).To(Equal(0))
+		})
+	})
+})
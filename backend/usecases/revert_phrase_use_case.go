@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+//go:generate counterfeiter . RevertPhraseUseCase
+type RevertPhraseUseCase interface {
+	Execute(context.Context, RevertPhraseRequest) (PhraseResponse, error)
+}
+
+func NewRevertPhraseUseCase(
+	repository api.PhrasesRepository,
+	metrics PhraseMetrics,
+) RevertPhraseUseCase {
+	return revertPhraseUseCase{
+		repository: repository,
+		metrics:    metrics,
+	}
+}
+
+type revertPhraseUseCase struct {
+	repository api.PhrasesRepository
+	metrics    PhraseMetrics
+}
+
+func (usecase revertPhraseUseCase) Execute(ctx context.Context, request RevertPhraseRequest) (PhraseResponse, error) {
+	ctx, span := tracing.Start(ctx, "RevertPhraseUseCase.Execute")
+	span.SetAttributes(attribute.Int64("phrase.revision", int64(request.Revision)))
+	defer span.End()
+
+	if request.Revision == 0 {
+		return PhraseResponse{}, errors.New("revisions are numbered from 1")
+	}
+
+	phrase, err := usecase.repository.RevertPhraseForUserWithUUID(
+		ctx,
+		request.UUID,
+		request.Revision,
+		request.UserUUID,
+	)
+	if err == nil {
+		usecase.metrics.PhraseUpdated()
+	}
+	span.RecordError(err)
+
+	return PhraseResponse(phrase), err
+}
+
+type RevertPhraseRequest struct {
+	UUID     uuid.UUID
+	Revision uint
+	UserUUID uuid.UUID
+}
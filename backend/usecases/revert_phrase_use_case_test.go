@@ -0,0 +1,85 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("RevertPhraseUseCase", func() {
+	var subject RevertPhraseUseCase
+	var fakeRepo *apifakes.FakePhrasesRepository
+	var request RevertPhraseRequest
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakePhrasesRepository)
+		subject = NewRevertPhraseUseCase(fakeRepo, new(usecasesfakes.FakePhraseMetrics))
+		request = RevertPhraseRequest{
+			UUID:     phraseUUID,
+			Revision: 2,
+			UserUUID: userUUID,
+		}
+	})
+
+	var response PhraseResponse
+	var err error
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	Context("when the repository reverts the phrase", func() {
+		BeforeEach(func() {
+			fakeRepo.RevertPhraseForUserWithUUIDReturns(api.Phrase{
+				Uuid:        phraseUUID.String(),
+				Content:     "le chat",
+				Translation: "the cat",
+			}, nil)
+		})
+
+		It("reverts the user's phrase to the revision", func() {
+			Expect(fakeRepo.RevertPhraseForUserWithUUIDCallCount()).To(Equal(1))
+			_, phraseUuid, revision, userUuid := fakeRepo.RevertPhraseForUserWithUUIDArgsForCall(0)
+			Expect(phraseUuid).To(Equal(phraseUUID))
+			Expect(revision).To(Equal(uint(2)))
+			Expect(userUuid).To(Equal(userUUID))
+		})
+
+		It("returns the phrase as it is now", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(PhraseResponse{
+				Uuid:        phraseUUID.String(),
+				Content:     "le chat",
+				Translation: "the cat",
+			}))
+		})
+	})
+
+	Context("without a revision", func() {
+		BeforeEach(func() {
+			request.Revision = 0
+		})
+
+		It("doesn't bother the repository", func() {
+			Expect(err).To(HaveOccurred())
+			Expect(fakeRepo.RevertPhraseForUserWithUUIDCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the repository fails", func() {
+		BeforeEach(func() {
+			fakeRepo.RevertPhraseForUserWithUUIDReturns(api.Phrase{}, errors.New("whoops"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("whoops"))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/search"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const MaxSearchResults = 50
+
+type SearchResultResponse struct {
+	Uuid        string            `json:"uuid"`
+	Content     string            `json:"content"`
+	Translation string            `json:"translation"`
+	PhraseType  api.PhraseType    `json:"phraseType"`
+	Score       float64           `json:"score"`
+	Highlights  map[string]string `json:"highlights"`
+}
+
+// the language each side of a phrase is written in, so that words
+// are stemmed with the right rules. Decks we don't know about yet
+// still get accent folding and prefix matching, just no stemming
+var phraseLanguages = map[api.PhraseType][2]search.Language{
+	api.FRENCH_TO_ENGLISH: {search.FRENCH, search.ENGLISH},
+	api.ENGLISH_TO_FRENCH: {search.ENGLISH, search.FRENCH},
+}
+
+//go:generate counterfeiter . SearchPhrasesUseCase
+type SearchPhrasesUseCase interface {
+	Execute(context.Context, SearchPhrasesRequest) ([]SearchResultResponse, error)
+}
+
+func NewSearchPhrasesUseCase(
+	repository api.SearchRepository,
+) SearchPhrasesUseCase {
+	return searchPhrasesUseCase{
+		repository: repository,
+	}
+}
+
+type searchPhrasesUseCase struct {
+	repository api.SearchRepository
+}
+
+func (usecase searchPhrasesUseCase) Execute(ctx context.Context, request SearchPhrasesRequest) ([]SearchResultResponse, error) {
+	ctx, span := tracing.Start(ctx, "SearchPhrasesUseCase.Execute")
+	defer span.End()
+
+	phrases, err := usecase.repository.AllPhrasesForUserWithUUID(ctx, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return []SearchResultResponse{}, err
+	}
+
+	documents := []search.Document{}
+	phrasesByUuid := map[string]api.SearchablePhrase{}
+	for _, phrase := range phrases {
+		languages := phraseLanguages[phrase.PhraseType]
+		documents = append(documents, search.Document{
+			ID: phrase.Uuid,
+			Fields: []search.Field{
+				{Name: "content", Text: phrase.Content, Language: languages[0], Weight: 2},
+				{Name: "translation", Text: phrase.Translation, Language: languages[1], Weight: 1},
+			},
+		})
+		phrasesByUuid[phrase.Uuid] = phrase
+	}
+
+	_, indexSpan := tracing.Start(ctx, "search.Index")
+	indexSpan.SetAttributes(attribute.Int("search.documents", len(documents)))
+	results := search.NewIndex(documents).Search(request.Query)
+	indexSpan.End()
+
+	if len(results) > MaxSearchResults {
+		results = results[:MaxSearchResults]
+	}
+
+	response := []SearchResultResponse{}
+	for _, result := range results {
+		phrase := phrasesByUuid[result.ID]
+		response = append(response, SearchResultResponse{
+			Uuid:        phrase.Uuid,
+			Content:     phrase.Content,
+			Translation: phrase.Translation,
+			PhraseType:  phrase.PhraseType,
+			Score:       result.Score,
+			Highlights:  result.Highlights,
+		})
+	}
+
+	return response, nil
+}
+
+type SearchPhrasesRequest struct {
+	UserUUID uuid.UUID
+	Query    string
+}
@@ -0,0 +1,63 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+//go:generate counterfeiter . ShowAchievementsUseCase
+type ShowAchievementsUseCase interface {
+	Execute(context.Context, ShowAchievementsRequest) ([]AchievementResponse, error)
+}
+
+func NewShowAchievementsUseCase(
+	repository api.AchievementsRepository,
+	rules achievements.Rules,
+) ShowAchievementsUseCase {
+	return showAchievementsUseCase{
+		repository: repository,
+		rules:      rules,
+	}
+}
+
+type showAchievementsUseCase struct {
+	repository api.AchievementsRepository
+	rules      achievements.Rules
+}
+
+// Execute lists every badge there is, so the user can see what they have
+// left to earn. Badges that have since been taken out of the rules aren't shown
+func (usecase showAchievementsUseCase) Execute(ctx context.Context, request ShowAchievementsRequest) ([]AchievementResponse, error) {
+	ctx, span := tracing.Start(ctx, "ShowAchievementsUseCase.Execute")
+	defer span.End()
+
+	awarded, err := usecase.repository.AchievementsForUser(ctx, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return []AchievementResponse{}, err
+	}
+
+	awardedAt := map[string]api.AwardedAchievement{}
+	for _, achievement := range awarded {
+		awardedAt[achievement.ID] = achievement
+	}
+
+	response := []AchievementResponse{}
+	for _, badge := range usecase.rules.Badges {
+		if achievement, ok := awardedAt[badge.ID]; ok {
+			response = append(response, achievementResponse(badge, &achievement.AwardedAt))
+		} else {
+			response = append(response, achievementResponse(badge, nil))
+		}
+	}
+
+	return response, nil
+}
+
+type ShowAchievementsRequest struct {
+	UserUUID uuid.UUID
+}
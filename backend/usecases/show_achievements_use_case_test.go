@@ -0,0 +1,41 @@
+package usecases_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("ShowAchievementsUseCase", func() {
+	It("lists every badge, with when the user earned the ones they have", func() {
+		rules, err := achievements.ParseRules(`[
+			{"id": "first-phrase", "name": "First words", "description": "Add a phrase", "on": "phrase.added", "when": {"phrases": {"atLeast": 1}}},
+			{"id": "first-session", "name": "Warming up", "description": "Practice", "on": "session.completed", "when": {"sessions": {"atLeast": 1}}}
+		]`)
+		Expect(err).NotTo(HaveOccurred())
+
+		awardedAt := time.Date(2018, 3, 31, 18, 30, 0, 0, time.UTC)
+		fakeRepo := new(apifakes.FakeAchievementsRepository)
+		fakeRepo.AchievementsForUserReturns([]api.AwardedAchievement{
+			{ID: "first-session", AwardedAt: awardedAt},
+			{ID: "retired-badge", AwardedAt: awardedAt},
+		}, nil)
+
+		response, err := NewShowAchievementsUseCase(fakeRepo, rules).Execute(
+			context.Background(),
+			ShowAchievementsRequest{UserUUID: userUUID},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(Equal([]AchievementResponse{
+			{ID: "first-phrase", Name: "First words", Description: "Add a phrase"},
+			{ID: "first-session", Name: "Warming up", Description: "Practice", Earned: true, AwardedAt: &awardedAt},
+		}))
+	})
+})
@@ -0,0 +1,50 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+//go:generate counterfeiter . ShowAdminStatsUseCase
+type ShowAdminStatsUseCase interface {
+	Execute(context.Context, ShowAdminStatsRequest) (api.Stats, error)
+}
+
+func NewShowAdminStatsUseCase(
+	repository api.AdminRepository,
+	clock func() time.Time,
+) ShowAdminStatsUseCase {
+	return showAdminStatsUseCase{
+		repository: repository,
+		clock:      clock,
+	}
+}
+
+type showAdminStatsUseCase struct {
+	repository api.AdminRepository
+	clock      func() time.Time
+}
+
+// Execute summarises users and phrases, with the number
+// of active users for each of the last request.Days days
+func (usecase showAdminStatsUseCase) Execute(ctx context.Context, request ShowAdminStatsRequest) (api.Stats, error) {
+	ctx, span := tracing.Start(ctx, "ShowAdminStatsUseCase.Execute")
+	defer span.End()
+
+	// today counts as one of the days
+	since := usecase.clock().UTC().AddDate(0, 0, 1-request.Days)
+	stats, err := usecase.repository.Stats(ctx, since)
+	if err != nil {
+		span.RecordError(err)
+		return api.Stats{}, err
+	}
+
+	return stats, nil
+}
+
+type ShowAdminStatsRequest struct {
+	Days int
+}
@@ -0,0 +1,80 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("ShowAdminStatsUseCase", func() {
+	var subject ShowAdminStatsUseCase
+	var fakeRepo *apifakes.FakeAdminRepository
+	var days int
+
+	var response api.Stats
+	var err error
+
+	now := time.Date(2018, 3, 31, 18, 30, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeAdminRepository)
+		fakeRepo.StatsReturns(api.Stats{TotalUsers: 3, DisabledUsers: 1}, nil)
+		subject = NewShowAdminStatsUseCase(fakeRepo, func() time.Time { return now })
+		days = 30
+	})
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), ShowAdminStatsRequest{Days: days})
+	})
+
+	It("summarises the last 30 days, counting today", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(Equal(api.Stats{TotalUsers: 3, DisabledUsers: 1}))
+
+		_, since := fakeRepo.StatsArgsForCall(0)
+		Expect(since.Format("2006-01-02")).To(Equal("2018-03-02"))
+	})
+
+	Context("when asked about a week", func() {
+		BeforeEach(func() {
+			days = 7
+		})
+
+		It("starts six days ago", func() {
+			_, since := fakeRepo.StatsArgsForCall(0)
+			Expect(since.Format("2006-01-02")).To(Equal("2018-03-25"))
+		})
+	})
+
+	Context("when the clock is not in UTC", func() {
+		BeforeEach(func() {
+			days = 1
+			paris := time.FixedZone("Europe/Paris", 2*60*60)
+			subject = NewShowAdminStatsUseCase(fakeRepo, func() time.Time {
+				return time.Date(2018, 4, 1, 1, 0, 0, 0, paris)
+			})
+		})
+
+		It("counts days in UTC", func() {
+			_, since := fakeRepo.StatsArgsForCall(0)
+			Expect(since.Format("2006-01-02")).To(Equal("2018-03-31"))
+		})
+	})
+
+	Context("when the repository fails", func() {
+		BeforeEach(func() {
+			fakeRepo.StatsReturns(api.Stats{}, errors.New("too many connections"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("too many connections"))
+		})
+	})
+})
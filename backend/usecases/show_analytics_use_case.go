@@ -0,0 +1,102 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+const RETENTION_SERIES = "retention"
+
+var ErrRetentionByWeek = errors.New("retention cohorts are always by week")
+
+// UnknownSeriesError names the series nobody has written a query for
+type UnknownSeriesError struct {
+	Series string
+}
+
+func (err UnknownSeriesError) Error() string {
+	return fmt.Sprintf("there is no '%s' series", err.Series)
+}
+
+//go:generate counterfeiter . ShowAnalyticsUseCase
+type ShowAnalyticsUseCase interface {
+	Execute(context.Context, ShowAnalyticsRequest) (AnalyticsResponse, error)
+}
+
+type seriesFunc func(context.Context, api.SeriesQuery) ([]api.SeriesPoint, error)
+
+func NewShowAnalyticsUseCase(repository api.AnalyticsRepository) ShowAnalyticsUseCase {
+	return showAnalyticsUseCase{
+		repository: repository,
+		series: map[string]seriesFunc{
+			"new-phrases":       repository.NewPhrases,
+			"active-users":      repository.ActiveUsers,
+			"practice-sessions": repository.PracticeSessions,
+		},
+	}
+}
+
+type showAnalyticsUseCase struct {
+	repository api.AnalyticsRepository
+	series     map[string]seriesFunc
+}
+
+// Execute counts the series between request.From and request.To, a day
+// at a time unless asked otherwise. Retention is the exception, as its
+// cohorts are the users who signed up in the same week
+func (usecase showAnalyticsUseCase) Execute(ctx context.Context, request ShowAnalyticsRequest) (AnalyticsResponse, error) {
+	ctx, span := tracing.Start(ctx, "ShowAnalyticsUseCase.Execute")
+	defer span.End()
+
+	if request.Series == RETENTION_SERIES {
+		if request.Granularity != "" && request.Granularity != api.GRANULARITY_WEEK {
+			return AnalyticsResponse{}, ErrRetentionByWeek
+		}
+
+		cohorts, err := usecase.repository.RetentionCohorts(ctx, request.From, request.To)
+		if err != nil {
+			span.RecordError(err)
+			return AnalyticsResponse{}, err
+		}
+		return AnalyticsResponse{Cohorts: cohorts}, nil
+	}
+
+	series, ok := usecase.series[request.Series]
+	if !ok {
+		return AnalyticsResponse{}, UnknownSeriesError{Series: request.Series}
+	}
+
+	granularity := request.Granularity
+	if granularity == "" {
+		granularity = api.GRANULARITY_DAY
+	}
+	points, err := series(ctx, api.SeriesQuery{
+		From:        request.From,
+		To:          request.To,
+		Granularity: granularity,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return AnalyticsResponse{}, err
+	}
+
+	return AnalyticsResponse{Points: points}, nil
+}
+
+type ShowAnalyticsRequest struct {
+	Series      string
+	From        time.Time
+	To          time.Time
+	Granularity api.Granularity
+}
+
+// AnalyticsResponse has the Cohorts for retention, and the Points for every other series
+type AnalyticsResponse struct {
+	Points  []api.SeriesPoint
+	Cohorts []api.RetentionCohort
+}
@@ -0,0 +1,105 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("ShowAnalyticsUseCase", func() {
+	var subject ShowAnalyticsUseCase
+	var fakeRepo *apifakes.FakeAnalyticsRepository
+	var request ShowAnalyticsRequest
+
+	var response AnalyticsResponse
+	var err error
+
+	from := time.Date(2018, 3, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2018, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeAnalyticsRepository)
+		fakeRepo.NewPhrasesReturns([]api.SeriesPoint{{Period: "2018-03-30", Count: 12}}, nil)
+		fakeRepo.RetentionCohortsReturns([]api.RetentionCohort{{Week: "2018-03-19", Size: 10, Active: []uint{10, 4}}}, nil)
+		subject = NewShowAnalyticsUseCase(fakeRepo)
+		request = ShowAnalyticsRequest{Series: "new-phrases", From: from, To: to}
+	})
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	It("counts a day at a time by default", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response).To(Equal(AnalyticsResponse{Points: []api.SeriesPoint{{Period: "2018-03-30", Count: 12}}}))
+
+		_, query := fakeRepo.NewPhrasesArgsForCall(0)
+		Expect(query).To(Equal(api.SeriesQuery{From: from, To: to, Granularity: api.GRANULARITY_DAY}))
+	})
+
+	Context("when asked for weeks", func() {
+		BeforeEach(func() {
+			request.Series = "active-users"
+			request.Granularity = api.GRANULARITY_WEEK
+		})
+
+		It("passes it on", func() {
+			_, query := fakeRepo.ActiveUsersArgsForCall(0)
+			Expect(query).To(Equal(api.SeriesQuery{From: from, To: to, Granularity: api.GRANULARITY_WEEK}))
+		})
+	})
+
+	Describe("retention", func() {
+		BeforeEach(func() {
+			request.Series = RETENTION_SERIES
+		})
+
+		It("follows each weekly cohort", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(AnalyticsResponse{Cohorts: []api.RetentionCohort{{Week: "2018-03-19", Size: 10, Active: []uint{10, 4}}}}))
+
+			_, cohortsFrom, cohortsTo := fakeRepo.RetentionCohortsArgsForCall(0)
+			Expect(cohortsFrom).To(Equal(from))
+			Expect(cohortsTo).To(Equal(to))
+		})
+
+		Context("by month", func() {
+			BeforeEach(func() {
+				request.Granularity = api.GRANULARITY_MONTH
+			})
+
+			It("refuses", func() {
+				Expect(err).To(Equal(ErrRetentionByWeek))
+				Expect(fakeRepo.RetentionCohortsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("for a series we don't have", func() {
+		BeforeEach(func() {
+			request.Series = "revenue"
+		})
+
+		It("says which one", func() {
+			Expect(err).To(Equal(UnknownSeriesError{Series: "revenue"}))
+			Expect(err).To(MatchError("there is no 'revenue' series"))
+		})
+	})
+
+	Context("when the database fails", func() {
+		BeforeEach(func() {
+			fakeRepo.NewPhrasesReturns(nil, errors.New("database is on fire"))
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(MatchError("database is on fire"))
+		})
+	})
+})
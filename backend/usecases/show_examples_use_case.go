@@ -0,0 +1,48 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+type ExamplesResponse struct {
+	Examples []string `json:"examples"`
+}
+
+//go:generate counterfeiter . ShowExamplesUseCase
+type ShowExamplesUseCase interface {
+	Execute(context.Context, ShowExamplesRequest) (ExamplesResponse, error)
+}
+
+func NewShowExamplesUseCase(
+	repository api.ExamplesRepository,
+) ShowExamplesUseCase {
+	return showExamplesUseCase{
+		repository: repository,
+	}
+}
+
+type showExamplesUseCase struct {
+	repository api.ExamplesRepository
+}
+
+func (usecase showExamplesUseCase) Execute(ctx context.Context, request ShowExamplesRequest) (ExamplesResponse, error) {
+	ctx, span := tracing.Start(ctx, "ShowExamplesUseCase.Execute")
+	defer span.End()
+
+	examples, err := usecase.repository.ExamplesForPhrase(ctx, request.UUID, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return ExamplesResponse{}, err
+	}
+
+	return ExamplesResponse{Examples: examples}, nil
+}
+
+type ShowExamplesRequest struct {
+	UUID     uuid.UUID
+	UserUUID uuid.UUID
+}
@@ -1,15 +1,25 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
 )
 
+const MaxPhrasesPageSize = 500
+
 type PhrasesResponse []PhraseResponse
 
+type ShowPhrasesResponse struct {
+	Phrases    PhrasesResponse
+	NextCursor string
+}
+
 //go:generate counterfeiter . ShowPhrasesUseCase
 type ShowPhrasesUseCase interface {
-	Execute(ShowPhrasesRequest) (PhrasesResponse, error)
+	Execute(context.Context, ShowPhrasesRequest) (ShowPhrasesResponse, error)
 }
 
 func NewShowPhrasesUseCase(
@@ -24,14 +34,34 @@ type showPhrasesUseCase struct {
 	repository api.PhrasesRepository
 }
 
-func (usecase showPhrasesUseCase) Execute(request ShowPhrasesRequest) (PhrasesResponse, error) {
-	phrases, err := usecase.repository.PhrasesForUserWithUUID(request.UserUUID)
+func (usecase showPhrasesUseCase) Execute(ctx context.Context, request ShowPhrasesRequest) (ShowPhrasesResponse, error) {
+	ctx, span := tracing.Start(ctx, "ShowPhrasesUseCase.Execute")
+	defer span.End()
+
+	query := api.PhrasesQuery{
+		Sort:       request.Sort,
+		Descending: request.Descending,
+		Search:     request.Search,
+		Cursor:     request.Cursor,
+		Limit:      request.Limit,
+	}
+	if query.Sort == "" {
+		query.Sort = api.SORT_CREATED
+	}
+	// clients that predate paging ask for no limit and never follow
+	// the cursor, so they still get every phrase in one go
+	if query.Limit > MaxPhrasesPageSize {
+		query.Limit = MaxPhrasesPageSize
+	}
+
+	page, err := usecase.repository.PhrasesForUserWithUUID(ctx, request.UserUUID, query)
 	if err != nil {
-		return []PhraseResponse{}, err
+		span.RecordError(err)
+		return ShowPhrasesResponse{Phrases: []PhraseResponse{}}, err
 	}
 
 	response := []PhraseResponse{}
-	for _, phrase := range phrases {
+	for _, phrase := range page.Phrases {
 		response = append(response, PhraseResponse{
 			Content:     phrase.Content,
 			Uuid:        phrase.Uuid,
@@ -39,9 +69,17 @@ func (usecase showPhrasesUseCase) Execute(request ShowPhrasesRequest) (PhrasesRe
 		})
 	}
 
-	return response, nil
+	return ShowPhrasesResponse{
+		Phrases:    response,
+		NextCursor: page.NextCursor,
+	}, nil
 }
 
 type ShowPhrasesRequest struct {
-	UserUUID uuid.UUID
+	UserUUID   uuid.UUID
+	Sort       api.PhraseSort
+	Descending bool
+	Search     string
+	Cursor     string
+	Limit      int
 }
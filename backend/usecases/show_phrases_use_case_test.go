@@ -0,0 +1,106 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("ShowPhrasesUseCase", func() {
+	var subject ShowPhrasesUseCase
+	var fakeRepo *apifakes.FakePhrasesRepository
+	var request ShowPhrasesRequest
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakePhrasesRepository)
+		subject = NewShowPhrasesUseCase(fakeRepo)
+		request = ShowPhrasesRequest{UserUUID: userUUID}
+	})
+
+	var response ShowPhrasesResponse
+	var err error
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	Context("when the repository returns a page of phrases", func() {
+		BeforeEach(func() {
+			fakeRepo.PhrasesForUserWithUUIDReturns(api.PhrasesPage{
+				Phrases: []api.Phrase{{
+					Uuid:        phraseUUID.String(),
+					Content:     "le chat",
+					Translation: "the cat",
+				}},
+				NextCursor: "the-next-cursor",
+			}, nil)
+		})
+
+		It("returns the phrases along with the cursor for the next page", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Phrases).To(Equal(PhrasesResponse{{
+				Uuid:        phraseUUID.String(),
+				Content:     "le chat",
+				Translation: "the cat",
+			}}))
+			Expect(response.NextCursor).To(Equal("the-next-cursor"))
+		})
+
+		It("sorts by creation date and returns every phrase by default", func() {
+			Expect(fakeRepo.PhrasesForUserWithUUIDCallCount()).To(Equal(1))
+			_, userUuid, query := fakeRepo.PhrasesForUserWithUUIDArgsForCall(0)
+			Expect(userUuid).To(Equal(userUUID))
+			Expect(query).To(Equal(api.PhrasesQuery{
+				Sort: api.SORT_CREATED,
+			}))
+		})
+
+		Context("when paging options are requested", func() {
+			BeforeEach(func() {
+				request.Sort = api.SORT_UPDATED
+				request.Descending = true
+				request.Search = "chat"
+				request.Cursor = "the-cursor"
+				request.Limit = 10
+			})
+
+			It("passes them along to the repository", func() {
+				_, _, query := fakeRepo.PhrasesForUserWithUUIDArgsForCall(0)
+				Expect(query).To(Equal(api.PhrasesQuery{
+					Sort:       api.SORT_UPDATED,
+					Descending: true,
+					Search:     "chat",
+					Cursor:     "the-cursor",
+					Limit:      10,
+				}))
+			})
+		})
+
+		Context("when the requested page is too large", func() {
+			BeforeEach(func() {
+				request.Limit = MaxPhrasesPageSize + 1
+			})
+
+			It("caps the page size", func() {
+				_, _, query := fakeRepo.PhrasesForUserWithUUIDArgsForCall(0)
+				Expect(query.Limit).To(Equal(MaxPhrasesPageSize))
+			})
+		})
+	})
+
+	Context("when the repository returns an error", func() {
+		BeforeEach(func() {
+			fakeRepo.PhrasesForUserWithUUIDReturns(api.PhrasesPage{}, errors.New("RUH ROH"))
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,168 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+const DefaultCalendarDays = 365
+const MaxCalendarDays = 366
+
+type StatsResponse struct {
+	Timezone string                `json:"timezone"`
+	Today    string                `json:"today"`
+	Streak   StreakResponse        `json:"streak"`
+	Goal     GoalResponse          `json:"goal"`
+	Calendar []CalendarDayResponse `json:"calendar"`
+}
+
+// a day counts towards a streak when the user practiced or added
+// a phrase at all, whether or not they met their goal
+type StreakResponse struct {
+	Current        uint `json:"current"`
+	Longest        uint `json:"longest"`
+	PracticedToday bool `json:"practicedToday"`
+}
+
+type GoalResponse struct {
+	Type     api.GoalType `json:"type"`
+	Target   uint         `json:"target"`
+	Progress uint         `json:"progress"`
+	Met      bool         `json:"met"`
+}
+
+// the calendar only has the days the user did something on
+type CalendarDayResponse struct {
+	Day           string `json:"day"`
+	CardsReviewed uint   `json:"cardsReviewed"`
+	PhrasesAdded  uint   `json:"phrasesAdded"`
+	GoalMet       bool   `json:"goalMet"`
+}
+
+//go:generate counterfeiter . ShowStatsUseCase
+type ShowStatsUseCase interface {
+	Execute(context.Context, ShowStatsRequest) (StatsResponse, error)
+}
+
+func NewShowStatsUseCase(
+	repository api.ProgressRepository,
+	clock func() time.Time,
+) ShowStatsUseCase {
+	return showStatsUseCase{
+		repository: repository,
+		clock:      clock,
+	}
+}
+
+type showStatsUseCase struct {
+	repository api.ProgressRepository
+	clock      func() time.Time
+}
+
+func (usecase showStatsUseCase) Execute(ctx context.Context, request ShowStatsRequest) (StatsResponse, error) {
+	ctx, span := tracing.Start(ctx, "ShowStatsUseCase.Execute")
+	defer span.End()
+
+	settings, err := usecase.repository.SettingsForUser(ctx, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return StatsResponse{}, err
+	}
+	days, err := usecase.repository.ProgressForUser(ctx, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return StatsResponse{}, err
+	}
+
+	calendarDays := request.CalendarDays
+	if calendarDays <= 0 {
+		calendarDays = DefaultCalendarDays
+	}
+
+	now := usecase.clock()
+	today := api.LocalDay(now, settings.Timezone)
+	todayDate, _ := time.Parse("2006-01-02", today)
+	calendarStart := todayDate.AddDate(0, 0, 1-calendarDays).Format("2006-01-02")
+
+	response := StatsResponse{
+		Timezone: settings.Timezone,
+		Today:    today,
+		Streak:   streaks(days, todayDate),
+		Goal: GoalResponse{
+			Type:   settings.DailyGoal.Type,
+			Target: settings.DailyGoal.Target,
+		},
+		Calendar: []CalendarDayResponse{},
+	}
+	for _, day := range days {
+		if day.Day == today {
+			response.Goal.Progress = goalProgress(settings.DailyGoal, day)
+			response.Goal.Met = response.Goal.Progress >= settings.DailyGoal.Target
+		}
+		// the dates are formatted as "2006-01-02",
+		// so comparing them as strings orders them chronologically
+		if day.Day >= calendarStart && day.Day <= today {
+			response.Calendar = append(response.Calendar, CalendarDayResponse{
+				Day:           day.Day,
+				CardsReviewed: day.CardsReviewed,
+				PhrasesAdded:  day.PhrasesAdded,
+				GoalMet:       goalProgress(settings.DailyGoal, day) >= settings.DailyGoal.Target,
+			})
+		}
+	}
+
+	return response, nil
+}
+
+func goalProgress(goal api.DailyGoal, day api.DailyProgress) uint {
+	if goal.Type == api.GOAL_PHRASES {
+		return day.PhrasesAdded
+	}
+
+	return day.CardsReviewed
+}
+
+// streaks expects the days oldest first. A streak that ended yesterday
+// is still current, since there's time left to practice today
+func streaks(days []api.DailyProgress, today time.Time) StreakResponse {
+	response := StreakResponse{}
+
+	var run uint
+	var previous time.Time
+	for _, day := range days {
+		if day.CardsReviewed == 0 && day.PhrasesAdded == 0 {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", day.Day)
+		if err != nil || date.After(today) {
+			continue
+		}
+
+		if run > 0 && date.Equal(previous.AddDate(0, 0, 1)) {
+			run++
+		} else {
+			run = 1
+		}
+		previous = date
+
+		if run > response.Longest {
+			response.Longest = run
+		}
+	}
+
+	response.PracticedToday = run > 0 && previous.Equal(today)
+	if run > 0 && !previous.Before(today.AddDate(0, 0, -1)) {
+		response.Current = run
+	}
+
+	return response
+}
+
+type ShowStatsRequest struct {
+	UserUUID     uuid.UUID
+	CalendarDays int
+}
@@ -0,0 +1,119 @@
+package usecases_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("ShowStatsUseCase", func() {
+	var subject ShowStatsUseCase
+	var fakeRepo *apifakes.FakeProgressRepository
+	var request ShowStatsRequest
+	var now time.Time
+
+	var response StatsResponse
+	var err error
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeProgressRepository)
+		fakeRepo.SettingsForUserReturns(api.UserSettings{
+			Timezone:  "Europe/Paris",
+			DailyGoal: api.DailyGoal{Type: api.GOAL_CARDS, Target: 20},
+		}, nil)
+		fakeRepo.ProgressForUserReturns([]api.DailyProgress{
+			{Day: "2018-02-10", CardsReviewed: 5},
+			{Day: "2018-02-11", CardsReviewed: 30},
+			{Day: "2018-02-12", PhrasesAdded: 2},
+			{Day: "2018-02-13", CardsReviewed: 20},
+			{Day: "2018-03-29", CardsReviewed: 25},
+			{Day: "2018-03-30", PhrasesAdded: 1},
+			{Day: "2018-03-31", CardsReviewed: 12, PhrasesAdded: 3},
+		}, nil)
+		request = ShowStatsRequest{UserUUID: userUUID, CalendarDays: 7}
+
+		// already the 31st in Paris
+		now = time.Date(2018, 3, 30, 22, 30, 0, 0, time.UTC)
+	})
+
+	JustBeforeEach(func() {
+		subject = NewShowStatsUseCase(fakeRepo, func() time.Time { return now })
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	It("works out the days in the user's timezone", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.Timezone).To(Equal("Europe/Paris"))
+		Expect(response.Today).To(Equal("2018-03-31"))
+
+		_, userUuid := fakeRepo.SettingsForUserArgsForCall(0)
+		Expect(userUuid).To(Equal(userUUID))
+	})
+
+	It("counts the current and longest streaks", func() {
+		Expect(response.Streak).To(Equal(StreakResponse{
+			Current:        3,
+			Longest:        4,
+			PracticedToday: true,
+		}))
+	})
+
+	It("shows how far along today's goal the user is", func() {
+		Expect(response.Goal).To(Equal(GoalResponse{
+			Type:     api.GOAL_CARDS,
+			Target:   20,
+			Progress: 12,
+			Met:      false,
+		}))
+	})
+
+	It("fills in the calendar for the last few days", func() {
+		Expect(response.Calendar).To(Equal([]CalendarDayResponse{
+			{Day: "2018-03-29", CardsReviewed: 25, GoalMet: true},
+			{Day: "2018-03-30", PhrasesAdded: 1},
+			{Day: "2018-03-31", CardsReviewed: 12, PhrasesAdded: 3},
+		}))
+	})
+
+	Context("when the user hasn't practiced yet today", func() {
+		BeforeEach(func() {
+			now = time.Date(2018, 4, 1, 8, 0, 0, 0, time.UTC)
+		})
+
+		It("keeps yesterday's streak going", func() {
+			Expect(response.Streak).To(Equal(StreakResponse{Current: 3, Longest: 4}))
+			Expect(response.Goal.Progress).To(Equal(uint(0)))
+		})
+	})
+
+	Context("when the user missed a day", func() {
+		BeforeEach(func() {
+			now = time.Date(2018, 4, 2, 8, 0, 0, 0, time.UTC)
+		})
+
+		It("starts the streak over", func() {
+			Expect(response.Streak).To(Equal(StreakResponse{Current: 0, Longest: 4}))
+		})
+	})
+
+	Context("when the goal is to add phrases", func() {
+		BeforeEach(func() {
+			fakeRepo.SettingsForUserReturns(api.UserSettings{
+				Timezone:  "UTC",
+				DailyGoal: api.DailyGoal{Type: api.GOAL_PHRASES, Target: 3},
+			}, nil)
+			now = time.Date(2018, 3, 31, 12, 0, 0, 0, time.UTC)
+		})
+
+		It("counts the phrases added today", func() {
+			Expect(response.Goal.Progress).To(Equal(uint(3)))
+			Expect(response.Goal.Met).To(BeTrue())
+		})
+	})
+})
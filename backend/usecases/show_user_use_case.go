@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+type UserResponse struct {
+	User    api.User          `json:"user"`
+	Phrases []api.AdminPhrase `json:"phrases"`
+}
+
+//go:generate counterfeiter . ShowUserUseCase
+type ShowUserUseCase interface {
+	Execute(context.Context, ShowUserRequest) (UserResponse, error)
+}
+
+func NewShowUserUseCase(
+	repository api.AdminRepository,
+) ShowUserUseCase {
+	return showUserUseCase{
+		repository: repository,
+	}
+}
+
+type showUserUseCase struct {
+	repository api.AdminRepository
+}
+
+// Execute shows a user along with every one of their phrases
+func (usecase showUserUseCase) Execute(ctx context.Context, request ShowUserRequest) (UserResponse, error) {
+	ctx, span := tracing.Start(ctx, "ShowUserUseCase.Execute")
+	defer span.End()
+
+	user, err := usecase.repository.User(ctx, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return UserResponse{}, err
+	}
+
+	phrases, err := usecase.repository.PhrasesForUser(ctx, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return UserResponse{}, err
+	}
+
+	return UserResponse{User: user, Phrases: phrases}, nil
+}
+
+type ShowUserRequest struct {
+	UserUUID uuid.UUID
+}
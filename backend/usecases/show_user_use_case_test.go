@@ -0,0 +1,57 @@
+package usecases_test
+
+import (
+	"context"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("ShowUserUseCase", func() {
+	var subject ShowUserUseCase
+	var fakeRepo *apifakes.FakeAdminRepository
+
+	var response UserResponse
+	var err error
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeAdminRepository)
+		fakeRepo.UserReturns(api.User{Uuid: userUUID.String(), Role: api.ROLE_USER}, nil)
+		fakeRepo.PhrasesForUserReturns([]api.AdminPhrase{{
+			Uuid:        phraseUUID.String(),
+			Type:        api.ENGLISH_TO_FRENCH,
+			Content:     "hello",
+			Translation: "bonjour",
+		}}, nil)
+		subject = NewShowUserUseCase(fakeRepo)
+	})
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), ShowUserRequest{UserUUID: userUUID})
+	})
+
+	It("includes their phrases in both directions", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(response.User.Uuid).To(Equal(userUUID.String()))
+		Expect(response.Phrases).To(HaveLen(1))
+		Expect(response.Phrases[0].Type).To(Equal(api.ENGLISH_TO_FRENCH))
+
+		_, requested := fakeRepo.PhrasesForUserArgsForCall(0)
+		Expect(requested).To(Equal(userUUID))
+	})
+
+	Context("when there is no such user", func() {
+		BeforeEach(func() {
+			fakeRepo.UserReturns(api.User{}, api.ErrUserNotFound)
+		})
+
+		It("doesn't look for their phrases", func() {
+			Expect(err).To(Equal(api.ErrUserNotFound))
+			Expect(fakeRepo.PhrasesForUserCallCount()).To(Equal(0))
+		})
+	})
+})
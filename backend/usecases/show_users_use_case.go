@@ -0,0 +1,47 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+//go:generate counterfeiter . ShowUsersUseCase
+type ShowUsersUseCase interface {
+	Execute(context.Context, ShowUsersRequest) ([]api.User, error)
+}
+
+func NewShowUsersUseCase(
+	repository api.AdminRepository,
+) ShowUsersUseCase {
+	return showUsersUseCase{
+		repository: repository,
+	}
+}
+
+type showUsersUseCase struct {
+	repository api.AdminRepository
+}
+
+// Execute lists users, most recently active first
+func (usecase showUsersUseCase) Execute(ctx context.Context, request ShowUsersRequest) ([]api.User, error) {
+	ctx, span := tracing.Start(ctx, "ShowUsersUseCase.Execute")
+	defer span.End()
+
+	users, err := usecase.repository.Users(ctx, api.UsersQuery{
+		Limit:  request.Limit,
+		Offset: request.Offset,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return []api.User{}, err
+	}
+
+	return users, nil
+}
+
+type ShowUsersRequest struct {
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const MaxExamplesPerPhrase = 10
+
+//go:generate counterfeiter . UpdateExamplesUseCase
+type UpdateExamplesUseCase interface {
+	Execute(context.Context, UpdateExamplesRequest) (ExamplesResponse, error)
+}
+
+func NewUpdateExamplesUseCase(
+	repository api.ExamplesRepository,
+) UpdateExamplesUseCase {
+	return updateExamplesUseCase{
+		repository: repository,
+	}
+}
+
+type updateExamplesUseCase struct {
+	repository api.ExamplesRepository
+}
+
+// Execute replaces all of a phrase's examples, which are tidied up the same
+// way phrases are. Examples don't have to use the phrase word for word,
+// but the ones that don't can't be made into cloze items
+func (usecase updateExamplesUseCase) Execute(ctx context.Context, request UpdateExamplesRequest) (ExamplesResponse, error) {
+	ctx, span := tracing.Start(ctx, "UpdateExamplesUseCase.Execute")
+	span.SetAttributes(attribute.Int("examples.count", len(request.Examples)))
+	defer span.End()
+
+	examples := make([]string, len(request.Examples))
+	copy(examples, request.Examples)
+
+	fieldErrors := []FieldError{}
+	if len(examples) > MaxExamplesPerPhrase {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   "examples",
+			Message: fmt.Sprintf("must have at most %d examples", MaxExamplesPerPhrase),
+		})
+	}
+	for i := range examples {
+		fieldErrors = append(fieldErrors, validatePhraseText(fmt.Sprintf("examples[%d]", i), &examples[i], true)...)
+	}
+	if len(fieldErrors) > 0 {
+		err := ValidationError{Errors: fieldErrors}
+		span.RecordError(err)
+		return ExamplesResponse{}, err
+	}
+
+	saved, err := usecase.repository.ReplaceExamplesForPhrase(ctx, request.UUID, examples, request.UserUUID)
+	if err != nil {
+		span.RecordError(err)
+		return ExamplesResponse{}, err
+	}
+
+	return ExamplesResponse{Examples: saved}, nil
+}
+
+type UpdateExamplesRequest struct {
+	UUID     uuid.UUID
+	UserUUID uuid.UUID
+	Examples []string
+}
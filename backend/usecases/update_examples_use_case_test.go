@@ -0,0 +1,92 @@
+package usecases_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("UpdateExamplesUseCase", func() {
+	var subject UpdateExamplesUseCase
+	var fakeRepo *apifakes.FakeExamplesRepository
+	var request UpdateExamplesRequest
+
+	var response ExamplesResponse
+	var err error
+
+	phraseUUID := uuid.Must(uuid.Parse("5e0f4e8b-1d2c-4c7a-a0f4-3b8f2f1c9d6a"))
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeExamplesRepository)
+		fakeRepo.ReplaceExamplesForPhraseStub = func(_ context.Context, _ uuid.UUID, examples []string, _ uuid.UUID) ([]string, error) {
+			return examples, nil
+		}
+
+		subject = NewUpdateExamplesUseCase(fakeRepo)
+		request = UpdateExamplesRequest{
+			UUID:     phraseUUID,
+			UserUUID: userUUID,
+			Examples: []string{"  Mon cheval est blanc. ", "Les chevaux courent."},
+		}
+	})
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	It("tidies up and saves the examples", func() {
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeRepo.ReplaceExamplesForPhraseCallCount()).To(Equal(1))
+		_, phraseUuid, examples, userUuid := fakeRepo.ReplaceExamplesForPhraseArgsForCall(0)
+		Expect(phraseUuid).To(Equal(phraseUUID))
+		Expect(userUuid).To(Equal(userUUID))
+		Expect(examples).To(Equal([]string{"Mon cheval est blanc.", "Les chevaux courent."}))
+		Expect(response).To(Equal(ExamplesResponse{Examples: examples}))
+	})
+
+	Context("when an example is empty", func() {
+		BeforeEach(func() {
+			request.Examples = []string{"Mon cheval est blanc.", "   "}
+		})
+
+		It("returns a validation error for that example", func() {
+			Expect(err).To(Equal(ValidationError{Errors: []FieldError{
+				{Field: "examples[1]", Message: "must not be empty"},
+			}}))
+			Expect(fakeRepo.ReplaceExamplesForPhraseCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when there are too many examples", func() {
+		BeforeEach(func() {
+			request.Examples = strings.Split(strings.Repeat("Mon cheval est blanc.|", MaxExamplesPerPhrase), "|")
+		})
+
+		It("returns a validation error", func() {
+			Expect(err).To(BeAssignableToTypeOf(ValidationError{}))
+			Expect(err.(ValidationError).Errors).To(ContainElement(FieldError{
+				Field:   "examples",
+				Message: "must have at most 10 examples",
+			}))
+			Expect(fakeRepo.ReplaceExamplesForPhraseCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the phrase doesn't exist", func() {
+		BeforeEach(func() {
+			fakeRepo.ReplaceExamplesForPhraseReturns(nil, api.ErrPhraseNotFound)
+			fakeRepo.ReplaceExamplesForPhraseStub = nil
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(Equal(api.ErrPhraseNotFound))
+		})
+	})
+})
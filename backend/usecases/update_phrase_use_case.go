@@ -1,39 +1,63 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
 )
 
 type UpdatePhraseUseCase interface {
-	Execute(UpdatePhraseRequest) (PhraseResponse, error)
+	Execute(context.Context, UpdatePhraseRequest) (PhraseResponse, error)
 }
 
 func NewUpdatePhraseUseCase(
 	repository api.PhrasesRepository,
+	metrics PhraseMetrics,
 ) UpdatePhraseUseCase {
 	return updatePhraseUseCase{
 		repository: repository,
+		metrics:    metrics,
 	}
 }
 
 type updatePhraseUseCase struct {
 	repository api.PhrasesRepository
+	metrics    PhraseMetrics
 }
 
-func (usecase updatePhraseUseCase) Execute(request UpdatePhraseRequest) (PhraseResponse, error) {
-	phrase, err := usecase.repository.UpdatePhraseForUserWithUUID(
-		request.Content,
-		request.Translation,
+func (usecase updatePhraseUseCase) Execute(ctx context.Context, request UpdatePhraseRequest) (PhraseResponse, error) {
+	ctx, span := tracing.Start(ctx, "UpdatePhraseUseCase.Execute")
+	defer span.End()
+
+	patch := api.PhrasePatch{
+		Content:     request.Content,
+		Translation: request.Translation,
+	}
+	if err := ValidatePhrasePatch(&patch); err != nil {
+		span.RecordError(err)
+		return PhraseResponse{}, err
+	}
+
+	// fields missing from the request are left as they are
+	phrase, err := usecase.repository.PatchPhraseForUserWithUUID(
+		ctx,
+		patch,
 		request.UUID,
 		request.UserUUID,
 	)
+	if err == nil {
+		usecase.metrics.PhraseUpdated()
+	}
+	span.RecordError(err)
+
 	return PhraseResponse(phrase), err
 }
 
 type UpdatePhraseRequest struct {
-	Content     string
-	Translation string
+	Content     *string
+	Translation *string
 	UUID        uuid.UUID
 	UserUUID    uuid.UUID
 }
@@ -0,0 +1,105 @@
+package usecases_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases/usecasesfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("UpdatePhraseUseCase", func() {
+	var subject UpdatePhraseUseCase
+	var fakeRepo *apifakes.FakePhrasesRepository
+	var fakeMetrics *usecasesfakes.FakePhraseMetrics
+	var request UpdatePhraseRequest
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakePhrasesRepository)
+		fakeRepo.PatchPhraseForUserWithUUIDReturns(api.Phrase{
+			Uuid:        phraseUUID.String(),
+			Content:     "le chat",
+			Translation: "the kitty",
+		}, nil)
+
+		fakeMetrics = new(usecasesfakes.FakePhraseMetrics)
+		subject = NewUpdatePhraseUseCase(fakeRepo, fakeMetrics)
+		request = UpdatePhraseRequest{UUID: phraseUUID, UserUUID: userUUID}
+	})
+
+	var response PhraseResponse
+	var err error
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	Context("when only the translation is provided", func() {
+		BeforeEach(func() {
+			translation := " the kitty "
+			request.Translation = &translation
+		})
+
+		It("only patches the translation", func() {
+			Expect(fakeRepo.PatchPhraseForUserWithUUIDCallCount()).To(Equal(1))
+			_, patch, phraseUuid, userUuid := fakeRepo.PatchPhraseForUserWithUUIDArgsForCall(0)
+			Expect(patch.Content).To(BeNil())
+			Expect(*patch.Translation).To(Equal("the kitty"))
+			Expect(phraseUuid).To(Equal(phraseUUID))
+			Expect(userUuid).To(Equal(userUUID))
+		})
+
+		It("returns the phrase as it was stored", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(PhraseResponse{
+				Uuid:        phraseUUID.String(),
+				Content:     "le chat",
+				Translation: "the kitty",
+			}))
+		})
+
+		It("counts the update", func() {
+			Expect(fakeMetrics.PhraseUpdatedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the content is provided but empty", func() {
+		BeforeEach(func() {
+			content := ""
+			request.Content = &content
+		})
+
+		It("refuses to wipe the phrase", func() {
+			Expect(err).To(Equal(ValidationError{
+				Errors: []FieldError{{Field: "content", Message: "must not be empty"}},
+			}))
+			Expect(fakeRepo.PatchPhraseForUserWithUUIDCallCount()).To(Equal(0))
+			Expect(fakeMetrics.PhraseUpdatedCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the phrase does not exist", func() {
+		BeforeEach(func() {
+			fakeRepo.PatchPhraseForUserWithUUIDReturns(api.Phrase{}, api.ErrPhraseNotFound)
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(Equal(api.ErrPhraseNotFound))
+		})
+	})
+
+	Context("when the repository returns an error", func() {
+		BeforeEach(func() {
+			fakeRepo.PatchPhraseForUserWithUUIDReturns(api.Phrase{}, errors.New("RUH ROH"))
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,87 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/tracing"
+)
+
+const MaxDailyGoal = 1000
+
+type SettingsResponse struct {
+	Timezone  string            `json:"timezone"`
+	DailyGoal DailyGoalResponse `json:"dailyGoal"`
+}
+
+type DailyGoalResponse struct {
+	Type   api.GoalType `json:"type"`
+	Target uint         `json:"target"`
+}
+
+//go:generate counterfeiter . UpdateSettingsUseCase
+type UpdateSettingsUseCase interface {
+	Execute(context.Context, UpdateSettingsRequest) (SettingsResponse, error)
+}
+
+func NewUpdateSettingsUseCase(
+	repository api.ProgressRepository,
+) UpdateSettingsUseCase {
+	return updateSettingsUseCase{
+		repository: repository,
+	}
+}
+
+type updateSettingsUseCase struct {
+	repository api.ProgressRepository
+}
+
+func (usecase updateSettingsUseCase) Execute(ctx context.Context, request UpdateSettingsRequest) (SettingsResponse, error) {
+	ctx, span := tracing.Start(ctx, "UpdateSettingsUseCase.Execute")
+	defer span.End()
+
+	fieldErrors := []FieldError{}
+	if request.Timezone != nil {
+		// "Local" is wherever the server happens to be
+		if _, err := time.LoadLocation(*request.Timezone); err != nil || *request.Timezone == "" || *request.Timezone == "Local" {
+			fieldErrors = append(fieldErrors, FieldError{Field: "timezone", Message: "must be a timezone like Europe/Paris"})
+		}
+	}
+	if request.DailyGoal != nil {
+		if request.DailyGoal.Type != api.GOAL_CARDS && request.DailyGoal.Type != api.GOAL_PHRASES {
+			fieldErrors = append(fieldErrors, FieldError{Field: "dailyGoal.type", Message: "must be either cards or phrases"})
+		}
+		if request.DailyGoal.Target == 0 || request.DailyGoal.Target > MaxDailyGoal {
+			fieldErrors = append(fieldErrors, FieldError{
+				Field:   "dailyGoal.target",
+				Message: fmt.Sprintf("must be between 1 and %d", MaxDailyGoal),
+			})
+		}
+	}
+	if len(fieldErrors) > 0 {
+		err := ValidationError{Errors: fieldErrors}
+		span.RecordError(err)
+		return SettingsResponse{}, err
+	}
+
+	settings, err := usecase.repository.UpdateSettingsForUser(ctx, api.SettingsPatch{
+		Timezone:  request.Timezone,
+		DailyGoal: request.DailyGoal,
+	}, request.UserUUID)
+	span.RecordError(err)
+
+	return SettingsResponse{
+		Timezone:  settings.Timezone,
+		DailyGoal: DailyGoalResponse(settings.DailyGoal),
+	}, err
+}
+
+// UpdateSettingsRequest leaves nil fields as they are
+type UpdateSettingsRequest struct {
+	UserUUID  uuid.UUID
+	Timezone  *string
+	DailyGoal *api.DailyGoal
+}
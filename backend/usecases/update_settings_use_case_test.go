@@ -0,0 +1,80 @@
+package usecases_test
+
+import (
+	"context"
+
+	"github.com/tjarratt/doit-etre-rad/backend/api"
+	"github.com/tjarratt/doit-etre-rad/backend/api/apifakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+var _ = Describe("UpdateSettingsUseCase", func() {
+	var subject UpdateSettingsUseCase
+	var fakeRepo *apifakes.FakeProgressRepository
+	var request UpdateSettingsRequest
+
+	var response SettingsResponse
+	var err error
+
+	timezone := func(name string) *string { return &name }
+
+	BeforeEach(func() {
+		fakeRepo = new(apifakes.FakeProgressRepository)
+		fakeRepo.UpdateSettingsForUserReturns(api.UserSettings{
+			Timezone:  "America/Montreal",
+			DailyGoal: api.DailyGoal{Type: api.GOAL_PHRASES, Target: 5},
+		}, nil)
+		subject = NewUpdateSettingsUseCase(fakeRepo)
+		request = UpdateSettingsRequest{
+			UserUUID:  userUUID,
+			Timezone:  timezone("America/Montreal"),
+			DailyGoal: &api.DailyGoal{Type: api.GOAL_PHRASES, Target: 5},
+		}
+	})
+
+	JustBeforeEach(func() {
+		response, err = subject.Execute(context.Background(), request)
+	})
+
+	It("saves the user's settings and returns them", func() {
+		Expect(err).NotTo(HaveOccurred())
+		_, patch, userUuid := fakeRepo.UpdateSettingsForUserArgsForCall(0)
+		Expect(userUuid).To(Equal(userUUID))
+		Expect(*patch.Timezone).To(Equal("America/Montreal"))
+		Expect(*patch.DailyGoal).To(Equal(api.DailyGoal{Type: api.GOAL_PHRASES, Target: 5}))
+
+		Expect(response).To(Equal(SettingsResponse{
+			Timezone:  "America/Montreal",
+			DailyGoal: DailyGoalResponse{Type: api.GOAL_PHRASES, Target: 5},
+		}))
+	})
+
+	Context("when the settings make no sense", func() {
+		BeforeEach(func() {
+			request.Timezone = timezone("Mars/Olympus_Mons")
+			request.DailyGoal = &api.DailyGoal{Type: "minutes", Target: 0}
+		})
+
+		It("says what is wrong, without saving anything", func() {
+			Expect(err).To(Equal(ValidationError{Errors: []FieldError{
+				{Field: "timezone", Message: "must be a timezone like Europe/Paris"},
+				{Field: "dailyGoal.type", Message: "must be either cards or phrases"},
+				{Field: "dailyGoal.target", Message: "must be between 1 and 1000"},
+			}}))
+			Expect(fakeRepo.UpdateSettingsForUserCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("with the server's own timezone", func() {
+		BeforeEach(func() {
+			request.Timezone = timezone("Local")
+		})
+
+		It("is refused", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,106 @@
+// This file was generated by counterfeiter
+package usecasesfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tjarratt/doit-etre-rad/backend/achievements"
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+type FakeAchievementAwarder struct {
+	AwardStub        func(context.Context, uuid.UUID, achievements.Event) ([]usecases.AchievementResponse, error)
+	awardMutex       sync.RWMutex
+	awardArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 achievements.Event
+	}
+	awardReturns struct {
+		result1 []usecases.AchievementResponse
+		result2 error
+	}
+	awardReturnsOnCall map[int]struct {
+		result1 []usecases.AchievementResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAchievementAwarder) Award(arg1 context.Context, arg2 uuid.UUID, arg3 achievements.Event) ([]usecases.AchievementResponse, error) {
+	fake.awardMutex.Lock()
+	ret, specificReturn := fake.awardReturnsOnCall[len(fake.awardArgsForCall)]
+	fake.awardArgsForCall = append(fake.awardArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 achievements.Event
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Award", []interface{}{arg1, arg2, arg3})
+	fake.awardMutex.Unlock()
+	if fake.AwardStub != nil {
+		return fake.AwardStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.awardReturns.result1, fake.awardReturns.result2
+}
+
+func (fake *FakeAchievementAwarder) AwardCallCount() int {
+	fake.awardMutex.RLock()
+	defer fake.awardMutex.RUnlock()
+	return len(fake.awardArgsForCall)
+}
+
+func (fake *FakeAchievementAwarder) AwardArgsForCall(i int) (context.Context, uuid.UUID, achievements.Event) {
+	fake.awardMutex.RLock()
+	defer fake.awardMutex.RUnlock()
+	return fake.awardArgsForCall[i].arg1, fake.awardArgsForCall[i].arg2, fake.awardArgsForCall[i].arg3
+}
+
+func (fake *FakeAchievementAwarder) AwardReturns(result1 []usecases.AchievementResponse, result2 error) {
+	fake.AwardStub = nil
+	fake.awardReturns = struct {
+		result1 []usecases.AchievementResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAchievementAwarder) AwardReturnsOnCall(i int, result1 []usecases.AchievementResponse, result2 error) {
+	fake.AwardStub = nil
+	if fake.awardReturnsOnCall == nil {
+		fake.awardReturnsOnCall = make(map[int]struct {
+			result1 []usecases.AchievementResponse
+			result2 error
+		})
+	}
+	fake.awardReturnsOnCall[i] = struct {
+		result1 []usecases.AchievementResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAchievementAwarder) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.awardMutex.RLock()
+	defer fake.awardMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeAchievementAwarder) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ usecases.AchievementAwarder = new(FakeAchievementAwarder)
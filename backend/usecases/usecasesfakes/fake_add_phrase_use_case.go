@@ -2,39 +2,42 @@
 package usecasesfakes
 
 import (
+	"context"
 	"sync"
 
 	"github.com/tjarratt/doit-etre-rad/backend/usecases"
 )
 
 type FakeAddPhraseUseCase struct {
-	ExecuteStub        func(usecases.AddPhraseRequest) ([]usecases.PhraseResponse, error)
+	ExecuteStub        func(context.Context, usecases.AddPhraseRequest) ([]usecases.SyncedPhraseResponse, error)
 	executeMutex       sync.RWMutex
 	executeArgsForCall []struct {
-		arg1 usecases.AddPhraseRequest
+		arg1 context.Context
+		arg2 usecases.AddPhraseRequest
 	}
 	executeReturns struct {
-		result1 []usecases.PhraseResponse
+		result1 []usecases.SyncedPhraseResponse
 		result2 error
 	}
 	executeReturnsOnCall map[int]struct {
-		result1 []usecases.PhraseResponse
+		result1 []usecases.SyncedPhraseResponse
 		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAddPhraseUseCase) Execute(arg1 usecases.AddPhraseRequest) ([]usecases.PhraseResponse, error) {
+func (fake *FakeAddPhraseUseCase) Execute(arg1 context.Context, arg2 usecases.AddPhraseRequest) ([]usecases.SyncedPhraseResponse, error) {
 	fake.executeMutex.Lock()
 	ret, specificReturn := fake.executeReturnsOnCall[len(fake.executeArgsForCall)]
 	fake.executeArgsForCall = append(fake.executeArgsForCall, struct {
-		arg1 usecases.AddPhraseRequest
-	}{arg1})
-	fake.recordInvocation("Execute", []interface{}{arg1})
+		arg1 context.Context
+		arg2 usecases.AddPhraseRequest
+	}{arg1, arg2})
+	fake.recordInvocation("Execute", []interface{}{arg1, arg2})
 	fake.executeMutex.Unlock()
 	if fake.ExecuteStub != nil {
-		return fake.ExecuteStub(arg1)
+		return fake.ExecuteStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -48,30 +51,30 @@ func (fake *FakeAddPhraseUseCase) ExecuteCallCount() int {
 	return len(fake.executeArgsForCall)
 }
 
-func (fake *FakeAddPhraseUseCase) ExecuteArgsForCall(i int) usecases.AddPhraseRequest {
+func (fake *FakeAddPhraseUseCase) ExecuteArgsForCall(i int) (context.Context, usecases.AddPhraseRequest) {
 	fake.executeMutex.RLock()
 	defer fake.executeMutex.RUnlock()
-	return fake.executeArgsForCall[i].arg1
+	return fake.executeArgsForCall[i].arg1, fake.executeArgsForCall[i].arg2
 }
 
-func (fake *FakeAddPhraseUseCase) ExecuteReturns(result1 []usecases.PhraseResponse, result2 error) {
+func (fake *FakeAddPhraseUseCase) ExecuteReturns(result1 []usecases.SyncedPhraseResponse, result2 error) {
 	fake.ExecuteStub = nil
 	fake.executeReturns = struct {
-		result1 []usecases.PhraseResponse
+		result1 []usecases.SyncedPhraseResponse
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeAddPhraseUseCase) ExecuteReturnsOnCall(i int, result1 []usecases.PhraseResponse, result2 error) {
+func (fake *FakeAddPhraseUseCase) ExecuteReturnsOnCall(i int, result1 []usecases.SyncedPhraseResponse, result2 error) {
 	fake.ExecuteStub = nil
 	if fake.executeReturnsOnCall == nil {
 		fake.executeReturnsOnCall = make(map[int]struct {
-			result1 []usecases.PhraseResponse
+			result1 []usecases.SyncedPhraseResponse
 			result2 error
 		})
 	}
 	fake.executeReturnsOnCall[i] = struct {
-		result1 []usecases.PhraseResponse
+		result1 []usecases.SyncedPhraseResponse
 		result2 error
 	}{result1, result2}
 }
@@ -0,0 +1,97 @@
+// This file was generated by counterfeiter
+package usecasesfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+type FakeChangeAccountUseCase struct {
+	ExecuteStub        func(context.Context, usecases.ChangeAccountRequest) error
+	executeMutex       sync.RWMutex
+	executeArgsForCall []struct {
+		arg1 context.Context
+		arg2 usecases.ChangeAccountRequest
+	}
+	executeReturns struct {
+		result1 error
+	}
+	executeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeChangeAccountUseCase) Execute(arg1 context.Context, arg2 usecases.ChangeAccountRequest) error {
+	fake.executeMutex.Lock()
+	ret, specificReturn := fake.executeReturnsOnCall[len(fake.executeArgsForCall)]
+	fake.executeArgsForCall = append(fake.executeArgsForCall, struct {
+		arg1 context.Context
+		arg2 usecases.ChangeAccountRequest
+	}{arg1, arg2})
+	fake.recordInvocation("Execute", []interface{}{arg1, arg2})
+	fake.executeMutex.Unlock()
+	if fake.ExecuteStub != nil {
+		return fake.ExecuteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.executeReturns.result1
+}
+
+func (fake *FakeChangeAccountUseCase) ExecuteCallCount() int {
+	fake.executeMutex.RLock()
+	defer fake.executeMutex.RUnlock()
+	return len(fake.executeArgsForCall)
+}
+
+func (fake *FakeChangeAccountUseCase) ExecuteArgsForCall(i int) (context.Context, usecases.ChangeAccountRequest) {
+	fake.executeMutex.RLock()
+	defer fake.executeMutex.RUnlock()
+	return fake.executeArgsForCall[i].arg1, fake.executeArgsForCall[i].arg2
+}
+
+func (fake *FakeChangeAccountUseCase) ExecuteReturns(result1 error) {
+	fake.ExecuteStub = nil
+	fake.executeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeChangeAccountUseCase) ExecuteReturnsOnCall(i int, result1 error) {
+	fake.ExecuteStub = nil
+	if fake.executeReturnsOnCall == nil {
+		fake.executeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeChangeAccountUseCase) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.executeMutex.RLock()
+	defer fake.executeMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeChangeAccountUseCase) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ usecases.ChangeAccountUseCase = new(FakeChangeAccountUseCase)
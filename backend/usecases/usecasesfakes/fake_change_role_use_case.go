@@ -0,0 +1,102 @@
+// This file was generated by counterfeiter
+package usecasesfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+type FakeChangeRoleUseCase struct {
+	ExecuteStub        func(context.Context, usecases.ChangeRoleRequest) (usecases.StaffKeyResponse, error)
+	executeMutex       sync.RWMutex
+	executeArgsForCall []struct {
+		arg1 context.Context
+		arg2 usecases.ChangeRoleRequest
+	}
+	executeReturns struct {
+		result1 usecases.StaffKeyResponse
+		result2 error
+	}
+	executeReturnsOnCall map[int]struct {
+		result1 usecases.StaffKeyResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeChangeRoleUseCase) Execute(arg1 context.Context, arg2 usecases.ChangeRoleRequest) (usecases.StaffKeyResponse, error) {
+	fake.executeMutex.Lock()
+	ret, specificReturn := fake.executeReturnsOnCall[len(fake.executeArgsForCall)]
+	fake.executeArgsForCall = append(fake.executeArgsForCall, struct {
+		arg1 context.Context
+		arg2 usecases.ChangeRoleRequest
+	}{arg1, arg2})
+	fake.recordInvocation("Execute", []interface{}{arg1, arg2})
+	fake.executeMutex.Unlock()
+	if fake.ExecuteStub != nil {
+		return fake.ExecuteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.executeReturns.result1, fake.executeReturns.result2
+}
+
+func (fake *FakeChangeRoleUseCase) ExecuteCallCount() int {
+	fake.executeMutex.RLock()
+	defer fake.executeMutex.RUnlock()
+	return len(fake.executeArgsForCall)
+}
+
+func (fake *FakeChangeRoleUseCase) ExecuteArgsForCall(i int) (context.Context, usecases.ChangeRoleRequest) {
+	fake.executeMutex.RLock()
+	defer fake.executeMutex.RUnlock()
+	return fake.executeArgsForCall[i].arg1, fake.executeArgsForCall[i].arg2
+}
+
+func (fake *FakeChangeRoleUseCase) ExecuteReturns(result1 usecases.StaffKeyResponse, result2 error) {
+	fake.ExecuteStub = nil
+	fake.executeReturns = struct {
+		result1 usecases.StaffKeyResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeChangeRoleUseCase) ExecuteReturnsOnCall(i int, result1 usecases.StaffKeyResponse, result2 error) {
+	fake.ExecuteStub = nil
+	if fake.executeReturnsOnCall == nil {
+		fake.executeReturnsOnCall = make(map[int]struct {
+			result1 usecases.StaffKeyResponse
+			result2 error
+		})
+	}
+	fake.executeReturnsOnCall[i] = struct {
+		result1 usecases.StaffKeyResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeChangeRoleUseCase) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.executeMutex.RLock()
+	defer fake.executeMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeChangeRoleUseCase) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ usecases.ChangeRoleUseCase = new(FakeChangeRoleUseCase)
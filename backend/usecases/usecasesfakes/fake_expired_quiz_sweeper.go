@@ -0,0 +1,65 @@
+// This file was generated by counterfeiter
+package usecasesfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+type FakeExpiredQuizSweeper struct {
+	SweepStub        func(context.Context)
+	sweepMutex       sync.RWMutex
+	sweepArgsForCall []struct {
+		arg1 context.Context
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeExpiredQuizSweeper) Sweep(arg1 context.Context) {
+	fake.sweepMutex.Lock()
+	fake.sweepArgsForCall = append(fake.sweepArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	fake.recordInvocation("Sweep", []interface{}{arg1})
+	fake.sweepMutex.Unlock()
+	if fake.SweepStub != nil {
+		fake.SweepStub(arg1)
+	}
+}
+
+func (fake *FakeExpiredQuizSweeper) SweepCallCount() int {
+	fake.sweepMutex.RLock()
+	defer fake.sweepMutex.RUnlock()
+	return len(fake.sweepArgsForCall)
+}
+
+func (fake *FakeExpiredQuizSweeper) SweepArgsForCall(i int) context.Context {
+	fake.sweepMutex.RLock()
+	defer fake.sweepMutex.RUnlock()
+	return fake.sweepArgsForCall[i].arg1
+}
+
+func (fake *FakeExpiredQuizSweeper) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.sweepMutex.RLock()
+	defer fake.sweepMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeExpiredQuizSweeper) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ usecases.ExpiredQuizSweeper = new(FakeExpiredQuizSweeper)
@@ -0,0 +1,96 @@
+// This file was generated by counterfeiter
+package usecasesfakes
+
+import (
+	"sync"
+
+	"github.com/tjarratt/doit-etre-rad/backend/usecases"
+)
+
+type FakePhraseMetrics struct {
+	PhraseCreatedStub        func()
+	phraseCreatedMutex       sync.RWMutex
+	phraseCreatedArgsForCall []struct{}
+	PhraseUpdatedStub        func()
+	phraseUpdatedMutex       sync.RWMutex
+	phraseUpdatedArgsForCall []struct{}
+	SyncPerformedStub        func()
+	syncPerformedMutex       sync.RWMutex
+	syncPerformedArgsForCall []struct{}
+	invocations              map[string][][]interface{}
+	invocationsMutex         sync.RWMutex
+}
+
+func (fake *FakePhraseMetrics) PhraseCreated() {
+	fake.phraseCreatedMutex.Lock()
+	fake.phraseCreatedArgsForCall = append(fake.phraseCreatedArgsForCall, struct{}{})
+	fake.recordInvocation("PhraseCreated", []interface{}{})
+	fake.phraseCreatedMutex.Unlock()
+	if fake.PhraseCreatedStub != nil {
+		fake.PhraseCreatedStub()
+	}
+}
+
+func (fake *FakePhraseMetrics) PhraseCreatedCallCount() int {
+	fake.phraseCreatedMutex.RLock()
+	defer fake.phraseCreatedMutex.RUnlock()
+	return len(fake.phraseCreatedArgsForCall)
+}
+
+func (fake *FakePhraseMetrics) PhraseUpdated() {
+	fake.phraseUpdatedMutex.Lock()
+	fake.phraseUpdatedArgsForCall = append(fake.phraseUpdatedArgsForCall, struct{}{})
+	fake.recordInvocation("PhraseUpdated", []interface{}{})
+	fake.phraseUpdatedMutex.Unlock()
+	if fake.PhraseUpdatedStub != nil {
+		fake.PhraseUpdatedStub()
+	}
+}
+
+func (fake *FakePhraseMetrics) PhraseUpdatedCallCount() int {
+	fake.phraseUpdatedMutex.RLock()
+	defer fake.phraseUpdatedMutex.RUnlock()
+	return len(fake.phraseUpdatedArgsForCall)
+}
+
+func (fake *FakePhraseMetrics) SyncPerformed() {
+	fake.syncPerformedMutex.Lock()
+	fake.syncPerformedArgsForCall = append(fake.syncPerformedArgsForCall, struct{}{})
+	fake.recordInvocation("SyncPerformed", []interface{}{})
+	fake.syncPerformedMutex.Unlock()
+	if fake.SyncPerformedStub != nil {
+		fake.SyncPerformedStub()
+	}
+}
+
+func (fake *FakePhraseMetrics) SyncPerformedCallCount() int {
+	fake.syncPerformedMutex.RLock()
+	defer fake.syncPerformedMutex.RUnlock()
+	return len(fake.syncPerformedArgsForCall)
+}
+
+func (fake *FakePhraseMetrics) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.phraseCreatedMutex.RLock()
+	defer fake.phraseCreatedMutex.RUnlock()
+	fake.phraseUpdatedMutex.RLock()
+	defer fake.phraseUpdatedMutex.RUnlock()
+	fake.syncPerformedMutex.RLock()
+	defer fake.syncPerformedMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePhraseMetrics) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ usecases.PhraseMetrics = new(FakePhraseMetrics)
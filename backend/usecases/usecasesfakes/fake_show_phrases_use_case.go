@@ -2,39 +2,42 @@
 package usecasesfakes
 
 import (
+	"context"
 	"sync"
 
 	"github.com/tjarratt/doit-etre-rad/backend/usecases"
 )
 
 type FakeShowPhrasesUseCase struct {
-	ExecuteStub        func(usecases.ShowPhrasesRequest) (usecases.PhrasesResponse, error)
+	ExecuteStub        func(context.Context, usecases.ShowPhrasesRequest) (usecases.ShowPhrasesResponse, error)
 	executeMutex       sync.RWMutex
 	executeArgsForCall []struct {
-		arg1 usecases.ShowPhrasesRequest
+		arg1 context.Context
+		arg2 usecases.ShowPhrasesRequest
 	}
 	executeReturns struct {
-		result1 usecases.PhrasesResponse
+		result1 usecases.ShowPhrasesResponse
 		result2 error
 	}
 	executeReturnsOnCall map[int]struct {
-		result1 usecases.PhrasesResponse
+		result1 usecases.ShowPhrasesResponse
 		result2 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeShowPhrasesUseCase) Execute(arg1 usecases.ShowPhrasesRequest) (usecases.PhrasesResponse, error) {
+func (fake *FakeShowPhrasesUseCase) Execute(arg1 context.Context, arg2 usecases.ShowPhrasesRequest) (usecases.ShowPhrasesResponse, error) {
 	fake.executeMutex.Lock()
 	ret, specificReturn := fake.executeReturnsOnCall[len(fake.executeArgsForCall)]
 	fake.executeArgsForCall = append(fake.executeArgsForCall, struct {
-		arg1 usecases.ShowPhrasesRequest
-	}{arg1})
-	fake.recordInvocation("Execute", []interface{}{arg1})
+		arg1 context.Context
+		arg2 usecases.ShowPhrasesRequest
+	}{arg1, arg2})
+	fake.recordInvocation("Execute", []interface{}{arg1, arg2})
 	fake.executeMutex.Unlock()
 	if fake.ExecuteStub != nil {
-		return fake.ExecuteStub(arg1)
+		return fake.ExecuteStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -48,30 +51,30 @@ func (fake *FakeShowPhrasesUseCase) ExecuteCallCount() int {
 	return len(fake.executeArgsForCall)
 }
 
-func (fake *FakeShowPhrasesUseCase) ExecuteArgsForCall(i int) usecases.ShowPhrasesRequest {
+func (fake *FakeShowPhrasesUseCase) ExecuteArgsForCall(i int) (context.Context, usecases.ShowPhrasesRequest) {
 	fake.executeMutex.RLock()
 	defer fake.executeMutex.RUnlock()
-	return fake.executeArgsForCall[i].arg1
+	return fake.executeArgsForCall[i].arg1, fake.executeArgsForCall[i].arg2
 }
 
-func (fake *FakeShowPhrasesUseCase) ExecuteReturns(result1 usecases.PhrasesResponse, result2 error) {
+func (fake *FakeShowPhrasesUseCase) ExecuteReturns(result1 usecases.ShowPhrasesResponse, result2 error) {
 	fake.ExecuteStub = nil
 	fake.executeReturns = struct {
-		result1 usecases.PhrasesResponse
+		result1 usecases.ShowPhrasesResponse
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeShowPhrasesUseCase) ExecuteReturnsOnCall(i int, result1 usecases.PhrasesResponse, result2 error) {
+func (fake *FakeShowPhrasesUseCase) ExecuteReturnsOnCall(i int, result1 usecases.ShowPhrasesResponse, result2 error) {
 	fake.ExecuteStub = nil
 	if fake.executeReturnsOnCall == nil {
 		fake.executeReturnsOnCall = make(map[int]struct {
-			result1 usecases.PhrasesResponse
+			result1 usecases.ShowPhrasesResponse
 			result2 error
 		})
 	}
 	fake.executeReturnsOnCall[i] = struct {
-		result1 usecases.PhrasesResponse
+		result1 usecases.ShowPhrasesResponse
 		result2 error
 	}{result1, result2}
 }
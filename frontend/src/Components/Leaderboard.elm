@@ -90,7 +90,7 @@ requestLeaderboardFromBackend model =
     let
         config =
             { method = "GET"
-            , headers = [ Http.header "X-Password" model.typedPassword ]
+            , headers = [ Http.header "X-Staff-Key" model.typedPassword ]
             , url = adminApiUrl
             , body = Http.emptyBody
             , expect = Http.expectJson <| decoder
@@ -153,7 +153,7 @@ passwordFieldView =
         [ Html.input
             [ id IndexCss.PasswordField
             , Html.Events.onInput TypePassword
-            , Html.Attributes.placeholder "<secret password>"
+            , Html.Attributes.placeholder "<staff key>"
             , Html.Attributes.class "form-control"
             ]
             []
@@ -152,23 +152,25 @@ update msg model =
         DidSaveToLocalStorage encodedPhrase ->
             didSaveToLocalStorage model encodedPhrase
 
-        ReceivePhrasesFromBackend mode (Ok response) ->
-            let
-                newPhrases =
-                    List.map (\p -> Phrases.Saved p) response
-
-                updatedModel =
-                    mergePhraseViewModels model newPhrases
-            in
-                ( { updatedModel | errorSyncing = False }, Bootstrap.showTooltips () )
-
-        ReceivePhrasesFromBackend mode _ ->
-            case mode of
-                Syncing ->
-                    ( { model | errorSyncing = True }, Cmd.none )
-
-                NotSyncing ->
-                    ( model, Cmd.none )
+        ReceivePhrasesFromBackend mode response ->
+            case phrasesFromResponse response of
+                Ok phrases ->
+                    let
+                        newPhrases =
+                            List.map (\p -> Phrases.Saved p) phrases
+
+                        updatedModel =
+                            mergePhraseViewModels model newPhrases
+                    in
+                        ( { updatedModel | errorSyncing = False }, Bootstrap.showTooltips () )
+
+                Err _ ->
+                    case mode of
+                        Syncing ->
+                            ( { model | errorSyncing = True }, Cmd.none )
+
+                        NotSyncing ->
+                            ( model, Cmd.none )
 
         ReceivePhraseFromBackend (Ok phrase) ->
             let
@@ -468,6 +470,24 @@ baseUrlForCurrentActivity activity =
             "/api/phrases/whoops"
 
 
+{-| A conflict means the user already had some of the phrases we sent.
+The rest were still saved, and the body has the phrases they already had
+in place of the ones we sent
+-}
+phrasesFromResponse : Result Http.Error (List Phrases.SavedPhrase) -> Result Http.Error (List Phrases.SavedPhrase)
+phrasesFromResponse response =
+    case response of
+        Err (Http.BadStatus conflict) ->
+            if conflict.status.code == 409 then
+                JD.decodeString (JD.list savedPhraseDecoder) conflict.body
+                    |> Result.mapError (always <| Http.BadStatus conflict)
+            else
+                response
+
+        _ ->
+            response
+
+
 savedPhraseDecoder : JD.Decoder Phrases.SavedPhrase
 savedPhraseDecoder =
     JD.map3 Phrases.SavedPhrase
@@ -108,7 +108,7 @@ leaderboardTests =
                     |> Event.click
                     |> Markup.target "#AdminSection #PasswordField"
                     |> Markup.expect elementExists
-        , test "it should submit the password to the backend" <|
+        , test "it should submit the staff key to the backend" <|
             \() ->
                 Elmer.given loggedInUser App.view App.update
                     |> Spy.use adminSpies
@@ -121,7 +121,7 @@ leaderboardTests =
                     |> Event.click
                     |> Elmer.Http.expectThat
                         (Elmer.Http.Route.get "/api/admin")
-                        (Elmer.each <| hasHeader ( "X-Password", "super secret password" ))
+                        (Elmer.each <| hasHeader ( "X-Staff-Key", "super secret password" ))
         , test "it should display the results in a list" <|
             \() ->
                 Elmer.given loggedInUser App.view App.update
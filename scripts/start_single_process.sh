@@ -0,0 +1,14 @@
+#!/usr/bin/env bash
+
+# runs the frontend and the API as one process on port 8080,
+# without nginx or a separate static server
+
+set -ex
+cd $(dirname $0)/..
+
+./scripts/stop.sh
+
+(cd frontend && ./scripts/build.sh)
+
+export STATIC_DIR=$(pwd)/frontend
+./backend/scripts/start.sh